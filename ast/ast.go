@@ -0,0 +1,601 @@
+// Package ast declares the syntax tree of Bo programs, which the parser
+// builds and the checker and runner work on.
+package ast
+
+import "fmt"
+
+// Pos is a position in the source: a line, counted from 1, and a column,
+// counted from 0.
+type Pos struct {
+	Line, Column int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Span is the source a node was parsed from, from the position of its
+// first character to the position just after its last one.
+type Span struct {
+	From, To Pos
+}
+
+func (s Span) Pos() Pos {
+	return s.From
+}
+
+func (s Span) End() Pos {
+	return s.To
+}
+
+// Node is a node of the syntax tree.
+type Node interface {
+	Pos() Pos
+	End() Pos
+}
+
+// Stmt is a statement.
+type Stmt interface {
+	Node
+	stmtNode()
+}
+
+// Expr is an expression.
+type Expr interface {
+	Node
+	exprNode()
+}
+
+// Pattern is what a match arm, switch arm, for loop or destructuring
+// declaration matches values against.
+type Pattern interface {
+	Node
+	patternNode()
+}
+
+// TypeExpr is a type specifier, like int? or map[string]int.
+type TypeExpr interface {
+	Node
+	typeNode()
+}
+
+// SelectArm is an arm of a select statement.
+type SelectArm interface {
+	Node
+	selectArmNode()
+}
+
+// Program is a parsed source file.
+type Program struct {
+	Span
+	Stmts []Stmt
+}
+
+type Block struct {
+	Span
+	Stmts []Stmt
+}
+
+// Statements
+
+// Require is require <bo/fmt>, where Std is set, or require "file.bo".
+type Require struct {
+	Span
+	Path string
+	Std  bool
+}
+
+type EnumDecl struct {
+	Span
+	Name  *Ident
+	Cases []*EnumCase
+}
+
+// EnumCase is a case of an enum and the types of the values it carries,
+// like Rgb(int, int, int).
+type EnumCase struct {
+	Span
+	Name   *Ident
+	Fields []TypeExpr
+}
+
+type StructDecl struct {
+	Span
+	Name   *Ident
+	Fields []*Field
+}
+
+type Field struct {
+	Span
+	Type TypeExpr
+	Name *Ident
+}
+
+// FuncDecl is a function declaration. A function with a receiver is a
+// method, and an operator method's name is its symbol, like + or [].
+type FuncDecl struct {
+	Span
+	Async    bool
+	Recv     *Receiver
+	Name     *Ident
+	Operator bool
+	Params   []*Param
+	Result   TypeExpr // nil when the function returns no value
+	Body     *Block
+}
+
+// Receiver is the (Point p) of a method of Point.
+type Receiver struct {
+	Span
+	Type *TypeName
+	Name *Ident
+}
+
+// Param is a parameter, with a default value or collecting the arguments
+// left over in a list if variadic.
+type Param struct {
+	Span
+	Type     TypeExpr
+	Name     *Ident
+	Default  Expr
+	Variadic bool
+}
+
+type Return struct {
+	Span
+	Results []Expr
+}
+
+type Defer struct {
+	Span
+	Call *Call
+}
+
+type Yield struct {
+	Span
+	Value Expr
+}
+
+type For struct {
+	Span
+	Pattern Pattern
+	X       Expr
+	Body    *Block
+}
+
+// VarDecl declares one variable, or several that receive the elements of
+// a tuple.
+type VarDecl struct {
+	Span
+	Vars  []*Var
+	Value Expr
+}
+
+type Var struct {
+	Span
+	Type TypeExpr
+	Name *Ident
+}
+
+// Destructure declares the variables a pattern binds, like
+// [a, b, ...rest] = list.
+type Destructure struct {
+	Span
+	Pattern Pattern
+	Value   Expr
+}
+
+type Switch struct {
+	Span
+	X    Expr
+	Arms []*SwitchArm
+}
+
+type SwitchArm struct {
+	Span
+	Pattern Pattern
+	Guard   Expr // nil without an if
+	Body    *Block
+}
+
+type Spawn struct {
+	Span
+	Call *Call
+}
+
+// Send is ch <- value.
+type Send struct {
+	Span
+	Chan  Expr
+	Value Expr
+}
+
+type Select struct {
+	Span
+	Arms []SelectArm
+}
+
+// ReceiveArm is case v = <-ch { ... }, where Name may be nil.
+type ReceiveArm struct {
+	Span
+	Name *Ident
+	Chan Expr
+	Body *Block
+}
+
+// SendArm is case ch <- value { ... }.
+type SendArm struct {
+	Span
+	Chan  Expr
+	Value Expr
+	Body  *Block
+}
+
+type DefaultArm struct {
+	Span
+	Body *Block
+}
+
+// AwaitStmt waits for a future whose value is not needed.
+type AwaitStmt struct {
+	Span
+	X Expr
+}
+
+// CallStmt is a call whose result, if any, is discarded. It calls a
+// function by name or a method, maybe of nil with ?.
+type CallStmt struct {
+	Span
+	Call *Call
+}
+
+// Expressions
+
+type Ident struct {
+	Span
+	Name string
+}
+
+// LitKind is the kind of a BasicLit.
+type LitKind int
+
+const (
+	Int LitKind = iota
+	Float
+	BigInt
+	Decimal
+	Bool
+	Nil
+)
+
+// BasicLit is a literal other than a string, as written.
+type BasicLit struct {
+	Span
+	Kind  LitKind
+	Value string
+}
+
+// StringLit is a string literal, as written, and its parts.
+type StringLit struct {
+	Span
+	Value string
+	Parts []*StringPart
+}
+
+// StringPart is a piece of a string literal: either literal text, with
+// its escape sequences decoded, or an expression embedded with ${...}.
+type StringPart struct {
+	Text string
+	Expr Expr
+}
+
+type Paren struct {
+	Span
+	X Expr
+}
+
+type ListLit struct {
+	Span
+	Elems []Expr
+}
+
+type MapLit struct {
+	Span
+	Entries []*Entry
+}
+
+type Entry struct {
+	Span
+	Key, Value Expr
+}
+
+type TupleLit struct {
+	Span
+	Elems []Expr
+}
+
+type StructLit struct {
+	Span
+	Type   *Ident
+	Fields []*FieldValue
+}
+
+type FieldValue struct {
+	Span
+	Name  *Ident
+	Value Expr
+}
+
+type Match struct {
+	Span
+	X    Expr
+	Arms []*MatchArm
+}
+
+type MatchArm struct {
+	Span
+	Pattern Pattern
+	Guard   Expr // nil without an if
+	Value   Expr
+}
+
+// Conversion is T(x).
+type Conversion struct {
+	Span
+	Type TypeExpr
+	X    Expr
+}
+
+// Member is x.name, or x?.name if Safe.
+type Member struct {
+	Span
+	X    Expr
+	Name *Ident
+	Safe bool
+}
+
+type Call struct {
+	Span
+	Fun  Expr
+	Args []*Arg
+}
+
+// Arg is an argument of a call, given by name if Name is not nil.
+type Arg struct {
+	Span
+	Name  *Ident
+	Value Expr
+}
+
+type Index struct {
+	Span
+	X, Index Expr
+}
+
+// Unary is -x or !x.
+type Unary struct {
+	Span
+	Op string
+	X  Expr
+}
+
+// Receive is <-ch.
+type Receive struct {
+	Span
+	Chan Expr
+}
+
+type Await struct {
+	Span
+	X Expr
+}
+
+// Binary is an arithmetic, comparison, logical or ?? operation.
+type Binary struct {
+	Span
+	Op   string
+	X, Y Expr
+}
+
+// Range is From..To, or From..<To if Exclusive.
+type Range struct {
+	Span
+	From, To  Expr
+	Exclusive bool
+}
+
+// Step is x step n.
+type Step struct {
+	Span
+	X, Step Expr
+}
+
+// Patterns
+
+type WildcardPattern struct {
+	Span
+}
+
+// LiteralPattern matches the value of a literal, negated if Neg. Value is
+// a *BasicLit or *StringLit.
+type LiteralPattern struct {
+	Span
+	Neg   bool
+	Value Expr
+}
+
+// CasePattern matches a case of an enum, like Color.Rgb(r, _, _) or .Red.
+// Without parentheses the values of the case are not matched.
+type CasePattern struct {
+	Span
+	Enum   *Ident // nil when left out
+	Case   *Ident
+	Parens bool
+	Fields []Pattern
+}
+
+type ListPattern struct {
+	Span
+	Elems []Pattern
+}
+
+// RestPattern is ...rest, the end of a list pattern. Name may be nil.
+type RestPattern struct {
+	Span
+	Name *Ident
+}
+
+type MapPattern struct {
+	Span
+	Entries []*EntryPattern
+}
+
+type EntryPattern struct {
+	Span
+	Key, Value Pattern
+}
+
+type TuplePattern struct {
+	Span
+	Elems []Pattern
+}
+
+type StructPattern struct {
+	Span
+	Type   *Ident
+	Fields []*FieldPattern
+}
+
+// FieldPattern is x, which binds the field x, or x: pattern.
+type FieldPattern struct {
+	Span
+	Name    *Ident
+	Pattern Pattern // nil for a binding
+}
+
+type BindingPattern struct {
+	Span
+	Name *Ident
+}
+
+// Types
+
+type BasicType struct {
+	Span
+	Name string
+}
+
+// TypeName is a declared type, like Point, or one of a module, like
+// sync.WaitGroup.
+type TypeName struct {
+	Span
+	Module *Ident // nil when not qualified
+	Name   *Ident
+}
+
+type ListType struct {
+	Span
+	Elem TypeExpr
+}
+
+type MapType struct {
+	Span
+	Key, Value TypeExpr
+}
+
+type TupleType struct {
+	Span
+	Elems []TypeExpr
+}
+
+type ChanType struct {
+	Span
+	Elem TypeExpr
+}
+
+type FutureType struct {
+	Span
+	Elem TypeExpr
+}
+
+type IteratorType struct {
+	Span
+	Elem TypeExpr
+}
+
+type OptionalType struct {
+	Span
+	Elem TypeExpr
+}
+
+func (*Require) stmtNode()     {}
+func (*EnumDecl) stmtNode()    {}
+func (*StructDecl) stmtNode()  {}
+func (*FuncDecl) stmtNode()    {}
+func (*Return) stmtNode()      {}
+func (*Defer) stmtNode()       {}
+func (*Yield) stmtNode()       {}
+func (*For) stmtNode()         {}
+func (*VarDecl) stmtNode()     {}
+func (*Destructure) stmtNode() {}
+func (*Switch) stmtNode()      {}
+func (*Spawn) stmtNode()       {}
+func (*Send) stmtNode()        {}
+func (*Select) stmtNode()      {}
+func (*AwaitStmt) stmtNode()   {}
+func (*CallStmt) stmtNode()    {}
+
+func (*ReceiveArm) selectArmNode() {}
+func (*SendArm) selectArmNode()    {}
+func (*DefaultArm) selectArmNode() {}
+
+func (*Ident) exprNode()      {}
+func (*BasicLit) exprNode()   {}
+func (*StringLit) exprNode()  {}
+func (*Paren) exprNode()      {}
+func (*ListLit) exprNode()    {}
+func (*MapLit) exprNode()     {}
+func (*TupleLit) exprNode()   {}
+func (*StructLit) exprNode()  {}
+func (*Match) exprNode()      {}
+func (*Conversion) exprNode() {}
+func (*Member) exprNode()     {}
+func (*Call) exprNode()       {}
+func (*Index) exprNode()      {}
+func (*Unary) exprNode()      {}
+func (*Receive) exprNode()    {}
+func (*Await) exprNode()      {}
+func (*Binary) exprNode()     {}
+func (*Range) exprNode()      {}
+func (*Step) exprNode()       {}
+
+func (*WildcardPattern) patternNode() {}
+func (*LiteralPattern) patternNode()  {}
+func (*CasePattern) patternNode()     {}
+func (*ListPattern) patternNode()     {}
+func (*RestPattern) patternNode()     {}
+func (*MapPattern) patternNode()      {}
+func (*TuplePattern) patternNode()    {}
+func (*StructPattern) patternNode()   {}
+func (*BindingPattern) patternNode()  {}
+
+func (*BasicType) typeNode()    {}
+func (*TypeName) typeNode()     {}
+func (*ListType) typeNode()     {}
+func (*MapType) typeNode()      {}
+func (*TupleType) typeNode()    {}
+func (*ChanType) typeNode()     {}
+func (*FutureType) typeNode()   {}
+func (*IteratorType) typeNode() {}
+func (*OptionalType) typeNode() {}
+
+// Unparen returns e without the parentheses around it.
+func Unparen(e Expr) Expr {
+	for {
+		p, ok := e.(*Paren)
+		if !ok {
+			return e
+		}
+		e = p.X
+	}
+}
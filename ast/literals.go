@@ -0,0 +1,111 @@
+package ast
+
+import (
+	"bo/decimal"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// IntLiteral returns the value of an INT literal, negated when it is the
+// operand of a unary minus so that the smallest int64 can be written.
+func IntLiteral(text string, negative bool) (int64, error) {
+	digits, base := intDigits(text)
+	if negative {
+		digits = "-" + digits
+	}
+
+	val, err := strconv.ParseInt(digits, base, 64)
+	if err != nil {
+		if negative {
+			text = "-" + text
+		}
+		return 0, fmt.Errorf("integer literal %s overflows int", text)
+	}
+
+	return val, nil
+}
+
+// UintLiteral returns the value of an INT literal as an unsigned integer, so
+// that literals beyond the range of int64 can be given type uint64.
+func UintLiteral(text string) (uint64, error) {
+	digits, base := intDigits(text)
+
+	val, err := strconv.ParseUint(digits, base, 64)
+	if err != nil {
+		return 0, fmt.Errorf("integer literal %s overflows uint64", text)
+	}
+
+	return val, nil
+}
+
+// FloatLiteral returns the value of a FLOAT literal.
+func FloatLiteral(text string) (float64, error) {
+	val, err := strconv.ParseFloat(strings.ReplaceAll(text, "_", ""), 64)
+	if err != nil {
+		return 0, fmt.Errorf("float literal %s overflows float", text)
+	}
+
+	return val, nil
+}
+
+// BigIntLiteral returns the exact value of an INT or BIGINT literal.
+func BigIntLiteral(text string) *big.Int {
+	digits, base := intDigits(strings.TrimSuffix(text, "n"))
+
+	val, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		panic(fmt.Sprintf("BigIntLiteral -> invalid literal: %s", text))
+	}
+
+	return val
+}
+
+// DecimalLiteral returns the exact value of a FLOAT or DECIMAL literal
+// written without an exponent.
+func DecimalLiteral(text string) (decimal.Decimal, error) {
+	return decimal.Parse(strings.ReplaceAll(strings.TrimSuffix(text, "m"), "_", ""))
+}
+
+// NumericLiteral returns the INT or FLOAT literal an expression is, maybe
+// in parentheses, and whether it is negated by a unary minus.
+func NumericLiteral(e Expr) (*BasicLit, bool, bool) {
+	e = Unparen(e)
+	neg := false
+	if u, ok := e.(*Unary); ok && u.Op == "-" {
+		neg, e = true, Unparen(u.X)
+	}
+	lit, ok := e.(*BasicLit)
+	if !ok || lit.Kind != Int && lit.Kind != Float {
+		return nil, false, false
+	}
+	return lit, neg, true
+}
+
+// FloatValue returns the value of an INT or FLOAT literal as a float, the
+// nearest one to an INT literal.
+func FloatValue(lit *BasicLit) float64 {
+	if lit.Kind == Float {
+		f, _ := FloatLiteral(lit.Value)
+		return f
+	}
+	f, _ := new(big.Float).SetInt(BigIntLiteral(lit.Value)).Float64()
+	return f
+}
+
+// intDigits strips the digit separators and base prefix of an INT literal.
+func intDigits(text string) (string, int) {
+	digits := strings.ReplaceAll(text, "_", "")
+	if len(digits) > 2 && digits[0] == '0' {
+		switch digits[1] {
+		case 'x', 'X':
+			return digits[2:], 16
+		case 'o', 'O':
+			return digits[2:], 8
+		case 'b', 'B':
+			return digits[2:], 2
+		}
+	}
+	return digits, 10
+}
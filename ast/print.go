@@ -0,0 +1,355 @@
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// String formats node as Bo source. Statements go on lines of their own,
+// indented with tabs in blocks, expressions and patterns on one line.
+func String(node Node) string {
+	var p printer
+	p.print(node)
+	return p.String()
+}
+
+// Kind returns what a node is, as error messages name it: "var decl" for
+// a *VarDecl.
+func Kind(node Node) string {
+	name := strings.TrimPrefix(fmt.Sprintf("%T", node), "*ast.")
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte(' ')
+		}
+		b.WriteString(strings.ToLower(string(r)))
+	}
+	return b.String()
+}
+
+type printer struct {
+	strings.Builder
+	indent int
+}
+
+func (p *printer) write(args ...interface{}) {
+	for _, arg := range args {
+		switch arg := arg.(type) {
+		case string:
+			p.WriteString(arg)
+		case Node:
+			p.print(arg)
+		default:
+			panic(fmt.Sprintf("write -> unhandled argument type: %T", arg))
+		}
+	}
+}
+
+func (p *printer) newline() {
+	p.WriteString("\n" + strings.Repeat("\t", p.indent))
+}
+
+// list writes nodes separated by sep.
+func list[N Node](p *printer, nodes []N, sep string) {
+	for i, node := range nodes {
+		if i > 0 {
+			p.WriteString(sep)
+		}
+		p.print(node)
+	}
+}
+
+func (p *printer) print(node Node) {
+	switch n := node.(type) {
+	case *Program:
+		for i, stmt := range n.Stmts {
+			if i > 0 {
+				p.newline()
+			}
+			p.print(stmt)
+		}
+	case *Block:
+		if len(n.Stmts) == 0 {
+			p.write("{}")
+			return
+		}
+		p.write("{")
+		p.indent++
+		for _, stmt := range n.Stmts {
+			p.newline()
+			p.print(stmt)
+		}
+		p.indent--
+		p.newline()
+		p.write("}")
+
+	// Statements
+	case *Require:
+		if n.Std {
+			p.write("require <", n.Path, ">")
+		} else {
+			p.write("require ", strconv.Quote(n.Path))
+		}
+	case *EnumDecl:
+		p.write("enum ", n.Name, " { ")
+		list(p, n.Cases, ", ")
+		p.write(" }")
+	case *EnumCase:
+		p.write(n.Name)
+		if len(n.Fields) > 0 {
+			p.write("(")
+			list(p, n.Fields, ", ")
+			p.write(")")
+		}
+	case *StructDecl:
+		if len(n.Fields) == 0 {
+			p.write("struct ", n.Name, " {}")
+			return
+		}
+		p.write("struct ", n.Name, " { ")
+		list(p, n.Fields, ", ")
+		p.write(" }")
+	case *Field:
+		p.write(n.Type, " ", n.Name)
+	case *FuncDecl:
+		if n.Async {
+			p.write("async ")
+		}
+		p.write("func ")
+		if n.Recv != nil {
+			p.write("(", n.Recv, ") ")
+		}
+		p.write(n.Name)
+		if n.Operator {
+			p.write(" ")
+		}
+		p.write("(")
+		list(p, n.Params, ", ")
+		p.write(") ")
+		if n.Result != nil {
+			p.write(n.Result, " ")
+		}
+		p.write(n.Body)
+	case *Receiver:
+		p.write(n.Type, " ", n.Name)
+	case *Param:
+		if n.Variadic {
+			p.write("...")
+		}
+		p.write(n.Type, " ", n.Name)
+		if n.Default != nil {
+			p.write(" = ", n.Default)
+		}
+	case *Return:
+		p.write("return")
+		if len(n.Results) > 0 {
+			p.write(" ")
+			list(p, n.Results, ", ")
+		}
+	case *Defer:
+		p.write("defer ", n.Call)
+	case *Yield:
+		p.write("yield ", n.Value)
+	case *For:
+		p.write("for ", n.Pattern, " in ", n.X, " ", n.Body)
+	case *VarDecl:
+		list(p, n.Vars, ", ")
+		p.write(" = ", n.Value)
+	case *Var:
+		p.write(n.Type, " ", n.Name)
+	case *Destructure:
+		p.write(n.Pattern, " = ", n.Value)
+	case *Switch:
+		p.write("switch ", n.X, " {")
+		for _, arm := range n.Arms {
+			p.newline()
+			p.print(arm)
+		}
+		p.newline()
+		p.write("}")
+	case *SwitchArm:
+		p.write("case ", n.Pattern)
+		if n.Guard != nil {
+			p.write(" if ", n.Guard)
+		}
+		p.write(" ", n.Body)
+	case *Spawn:
+		p.write("spawn ", n.Call)
+	case *Send:
+		p.write(n.Chan, " <- ", n.Value)
+	case *Select:
+		p.write("select {")
+		for _, arm := range n.Arms {
+			p.newline()
+			p.print(arm)
+		}
+		p.newline()
+		p.write("}")
+	case *ReceiveArm:
+		p.write("case ")
+		if n.Name != nil {
+			p.write(n.Name, " = ")
+		}
+		p.write("<-", n.Chan, " ", n.Body)
+	case *SendArm:
+		p.write("case ", n.Chan, " <- ", n.Value, " ", n.Body)
+	case *DefaultArm:
+		p.write("default ", n.Body)
+	case *AwaitStmt:
+		p.write("await ", n.X)
+	case *CallStmt:
+		p.write(n.Call)
+
+	// Expressions
+	case *Ident:
+		p.write(n.Name)
+	case *BasicLit:
+		p.write(n.Value)
+	case *StringLit:
+		p.write(n.Value)
+	case *Paren:
+		p.write("(", n.X, ")")
+	case *ListLit:
+		p.write("[")
+		list(p, n.Elems, ", ")
+		p.write("]")
+	case *MapLit:
+		p.write("{")
+		list(p, n.Entries, ", ")
+		p.write("}")
+	case *Entry:
+		p.write(n.Key, ": ", n.Value)
+	case *TupleLit:
+		p.write("(")
+		list(p, n.Elems, ", ")
+		p.write(")")
+	case *StructLit:
+		p.write(n.Type, "{")
+		list(p, n.Fields, ", ")
+		p.write("}")
+	case *FieldValue:
+		p.write(n.Name, ": ", n.Value)
+	case *Match:
+		p.write("match ", n.X, " { ")
+		list(p, n.Arms, ", ")
+		p.write(" }")
+	case *MatchArm:
+		p.write(n.Pattern)
+		if n.Guard != nil {
+			p.write(" if ", n.Guard)
+		}
+		p.write(" => ", n.Value)
+	case *Conversion:
+		p.write(n.Type, "(", n.X, ")")
+	case *Member:
+		if n.Safe {
+			p.write(n.X, "?.", n.Name)
+		} else {
+			p.write(n.X, ".", n.Name)
+		}
+	case *Call:
+		p.write(n.Fun, "(")
+		list(p, n.Args, ", ")
+		p.write(")")
+	case *Arg:
+		if n.Name != nil {
+			p.write(n.Name, ": ")
+		}
+		p.write(n.Value)
+	case *Index:
+		p.write(n.X, "[", n.Index, "]")
+	case *Unary:
+		p.write(n.Op, n.X)
+	case *Receive:
+		p.write("<-", n.Chan)
+	case *Await:
+		p.write("await ", n.X)
+	case *Binary:
+		p.write(n.X, " ", n.Op, " ", n.Y)
+	case *Range:
+		if n.Exclusive {
+			p.write(n.From, "..<", n.To)
+		} else {
+			p.write(n.From, "..", n.To)
+		}
+	case *Step:
+		p.write(n.X, " step ", n.Step)
+
+	// Patterns
+	case *WildcardPattern:
+		p.write("_")
+	case *LiteralPattern:
+		if n.Neg {
+			p.write("-")
+		}
+		p.write(n.Value)
+	case *CasePattern:
+		if n.Enum != nil {
+			p.write(n.Enum)
+		}
+		p.write(".", n.Case)
+		if n.Parens {
+			p.write("(")
+			list(p, n.Fields, ", ")
+			p.write(")")
+		}
+	case *ListPattern:
+		p.write("[")
+		list(p, n.Elems, ", ")
+		p.write("]")
+	case *RestPattern:
+		p.write("...")
+		if n.Name != nil {
+			p.write(n.Name)
+		}
+	case *MapPattern:
+		p.write("{")
+		list(p, n.Entries, ", ")
+		p.write("}")
+	case *EntryPattern:
+		p.write(n.Key, ": ", n.Value)
+	case *TuplePattern:
+		p.write("(")
+		list(p, n.Elems, ", ")
+		p.write(")")
+	case *StructPattern:
+		p.write(n.Type, "{")
+		list(p, n.Fields, ", ")
+		p.write("}")
+	case *FieldPattern:
+		p.write(n.Name)
+		if n.Pattern != nil {
+			p.write(": ", n.Pattern)
+		}
+	case *BindingPattern:
+		p.write(n.Name)
+
+	// Types
+	case *BasicType:
+		p.write(n.Name)
+	case *TypeName:
+		if n.Module != nil {
+			p.write(n.Module, ".")
+		}
+		p.write(n.Name)
+	case *ListType:
+		p.write("[]", n.Elem)
+	case *MapType:
+		p.write("map[", n.Key, "]", n.Value)
+	case *TupleType:
+		p.write("(")
+		list(p, n.Elems, ", ")
+		p.write(")")
+	case *ChanType:
+		p.write("chan[", n.Elem, "]")
+	case *FutureType:
+		p.write("Future[", n.Elem, "]")
+	case *IteratorType:
+		p.write("Iterator[", n.Elem, "]")
+	case *OptionalType:
+		p.write(n.Elem, "?")
+	default:
+		panic(fmt.Sprintf("print -> unhandled node type: %T", n))
+	}
+}
@@ -0,0 +1,273 @@
+package ast
+
+import "fmt"
+
+// Visitor's Visit is called for each node Walk comes across. If it returns
+// a visitor w, Walk visits the children of the node with w, then calls
+// w.Visit(nil).
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk goes through the tree rooted at node depth-first, in source order.
+func Walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+
+	switch n := node.(type) {
+	case *Program:
+		walkList(v, n.Stmts)
+	case *Block:
+		walkList(v, n.Stmts)
+
+	// Statements
+	case *Require:
+	case *EnumDecl:
+		Walk(v, n.Name)
+		walkList(v, n.Cases)
+	case *EnumCase:
+		Walk(v, n.Name)
+		walkList(v, n.Fields)
+	case *StructDecl:
+		Walk(v, n.Name)
+		walkList(v, n.Fields)
+	case *Field:
+		Walk(v, n.Type)
+		Walk(v, n.Name)
+	case *FuncDecl:
+		if n.Recv != nil {
+			Walk(v, n.Recv)
+		}
+		Walk(v, n.Name)
+		walkList(v, n.Params)
+		if n.Result != nil {
+			Walk(v, n.Result)
+		}
+		Walk(v, n.Body)
+	case *Receiver:
+		Walk(v, n.Type)
+		Walk(v, n.Name)
+	case *Param:
+		Walk(v, n.Type)
+		Walk(v, n.Name)
+		if n.Default != nil {
+			Walk(v, n.Default)
+		}
+	case *Return:
+		walkList(v, n.Results)
+	case *Defer:
+		Walk(v, n.Call)
+	case *Yield:
+		Walk(v, n.Value)
+	case *For:
+		Walk(v, n.Pattern)
+		Walk(v, n.X)
+		Walk(v, n.Body)
+	case *VarDecl:
+		walkList(v, n.Vars)
+		Walk(v, n.Value)
+	case *Var:
+		Walk(v, n.Type)
+		Walk(v, n.Name)
+	case *Destructure:
+		Walk(v, n.Pattern)
+		Walk(v, n.Value)
+	case *Switch:
+		Walk(v, n.X)
+		walkList(v, n.Arms)
+	case *SwitchArm:
+		Walk(v, n.Pattern)
+		if n.Guard != nil {
+			Walk(v, n.Guard)
+		}
+		Walk(v, n.Body)
+	case *Spawn:
+		Walk(v, n.Call)
+	case *Send:
+		Walk(v, n.Chan)
+		Walk(v, n.Value)
+	case *Select:
+		walkList(v, n.Arms)
+	case *ReceiveArm:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		Walk(v, n.Chan)
+		Walk(v, n.Body)
+	case *SendArm:
+		Walk(v, n.Chan)
+		Walk(v, n.Value)
+		Walk(v, n.Body)
+	case *DefaultArm:
+		Walk(v, n.Body)
+	case *AwaitStmt:
+		Walk(v, n.X)
+	case *CallStmt:
+		Walk(v, n.Call)
+
+	// Expressions
+	case *Ident, *BasicLit:
+	case *StringLit:
+		for _, part := range n.Parts {
+			if part.Expr != nil {
+				Walk(v, part.Expr)
+			}
+		}
+	case *Paren:
+		Walk(v, n.X)
+	case *ListLit:
+		walkList(v, n.Elems)
+	case *MapLit:
+		walkList(v, n.Entries)
+	case *Entry:
+		Walk(v, n.Key)
+		Walk(v, n.Value)
+	case *TupleLit:
+		walkList(v, n.Elems)
+	case *StructLit:
+		Walk(v, n.Type)
+		walkList(v, n.Fields)
+	case *FieldValue:
+		Walk(v, n.Name)
+		Walk(v, n.Value)
+	case *Match:
+		Walk(v, n.X)
+		walkList(v, n.Arms)
+	case *MatchArm:
+		Walk(v, n.Pattern)
+		if n.Guard != nil {
+			Walk(v, n.Guard)
+		}
+		Walk(v, n.Value)
+	case *Conversion:
+		Walk(v, n.Type)
+		Walk(v, n.X)
+	case *Member:
+		Walk(v, n.X)
+		Walk(v, n.Name)
+	case *Call:
+		Walk(v, n.Fun)
+		walkList(v, n.Args)
+	case *Arg:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		Walk(v, n.Value)
+	case *Index:
+		Walk(v, n.X)
+		Walk(v, n.Index)
+	case *Unary:
+		Walk(v, n.X)
+	case *Receive:
+		Walk(v, n.Chan)
+	case *Await:
+		Walk(v, n.X)
+	case *Binary:
+		Walk(v, n.X)
+		Walk(v, n.Y)
+	case *Range:
+		Walk(v, n.From)
+		Walk(v, n.To)
+	case *Step:
+		Walk(v, n.X)
+		Walk(v, n.Step)
+
+	// Patterns
+	case *WildcardPattern:
+	case *LiteralPattern:
+		Walk(v, n.Value)
+	case *CasePattern:
+		if n.Enum != nil {
+			Walk(v, n.Enum)
+		}
+		Walk(v, n.Case)
+		walkList(v, n.Fields)
+	case *ListPattern:
+		walkList(v, n.Elems)
+	case *RestPattern:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+	case *MapPattern:
+		walkList(v, n.Entries)
+	case *EntryPattern:
+		Walk(v, n.Key)
+		Walk(v, n.Value)
+	case *TuplePattern:
+		walkList(v, n.Elems)
+	case *StructPattern:
+		Walk(v, n.Type)
+		walkList(v, n.Fields)
+	case *FieldPattern:
+		Walk(v, n.Name)
+		if n.Pattern != nil {
+			Walk(v, n.Pattern)
+		}
+	case *BindingPattern:
+		Walk(v, n.Name)
+
+	// Types
+	case *BasicType:
+	case *TypeName:
+		if n.Module != nil {
+			Walk(v, n.Module)
+		}
+		Walk(v, n.Name)
+	case *ListType:
+		Walk(v, n.Elem)
+	case *MapType:
+		Walk(v, n.Key)
+		Walk(v, n.Value)
+	case *TupleType:
+		walkList(v, n.Elems)
+	case *ChanType:
+		Walk(v, n.Elem)
+	case *FutureType:
+		Walk(v, n.Elem)
+	case *IteratorType:
+		Walk(v, n.Elem)
+	case *OptionalType:
+		Walk(v, n.Elem)
+	default:
+		panic(fmt.Sprintf("Walk -> unhandled node type: %T", n))
+	}
+
+	v.Visit(nil)
+}
+
+func walkList[N Node](v Visitor, nodes []N) {
+	for _, node := range nodes {
+		Walk(v, node)
+	}
+}
+
+type inspector func(Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect goes through the tree rooted at node depth-first, calling f for
+// each node and then f(nil) once its children are done. The children of a
+// node for which f returns false are skipped.
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
+
+// IsConstant reports whether an expression refers to no variable and calls
+// no function, so that it has the same value wherever it is computed.
+func IsConstant(e Expr) bool {
+	ok := true
+	Inspect(e, func(n Node) bool {
+		switch n.(type) {
+		case *Ident, *Call:
+			ok = false
+		}
+		return ok
+	})
+	return ok
+}
@@ -0,0 +1,187 @@
+/*
+ * bo.h is the runtime of the C programs bo cgen writes: checked int
+ * arithmetic, which panics where Bo's does, and printing values as Bo
+ * prints them. It needs nothing but the C99 standard library.
+ */
+#ifndef BO_H
+#define BO_H
+
+#include <inttypes.h>
+#include <math.h>
+#include <stdbool.h>
+#include <stdint.h>
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+
+static inline void bo_write_int(FILE *f, int64_t n) {
+	fprintf(f, "%" PRId64, n);
+}
+
+/* Floats are written in the fewest digits that read back as them, with an
+ * exponent below 1e-4 and from 1e6 on. */
+static inline void bo_write_float(FILE *f, double x) {
+	char buf[32];
+	int digits, exp;
+
+	if (isnan(x)) {
+		fputs("NaN", f);
+		return;
+	}
+	if (isinf(x)) {
+		fputs(x > 0 ? "+Inf" : "-Inf", f);
+		return;
+	}
+	if (x == 0) {
+		fputs(signbit(x) ? "-0" : "0", f);
+		return;
+	}
+
+	for (digits = 1; digits < 17; digits++) {
+		snprintf(buf, sizeof buf, "%.*e", digits - 1, x);
+		if (strtod(buf, NULL) == x) {
+			break;
+		}
+	}
+	snprintf(buf, sizeof buf, "%.*e", digits - 1, x);
+	exp = atoi(strchr(buf, 'e') + 1);
+	if (exp < -4 || exp >= 6) {
+		fputs(buf, f);
+	} else {
+		fprintf(f, "%.*f", digits - 1 - exp > 0 ? digits - 1 - exp : 0, x);
+	}
+}
+
+static inline void bo_write_bool(FILE *f, bool b) {
+	fputs(b ? "true" : "false", f);
+}
+
+/* A panic writes its message after bo_panic_begin, and ends the program
+ * with bo_panic_end. */
+static inline void bo_panic_begin(void) {
+	fflush(stdout);
+	fputs("Runtime error: ", stderr);
+}
+
+static inline void bo_panic_end(void) {
+	fputc('\n', stderr);
+	exit(1);
+}
+
+static inline void bo_overflow(int64_t a, const char *op, int64_t b) {
+	bo_panic_begin();
+	fprintf(stderr, "Binary -> integer overflow: %" PRId64 " %s %" PRId64, a, op, b);
+	bo_panic_end();
+}
+
+static inline void bo_check_divisor(int64_t b) {
+	if (b == 0) {
+		bo_panic_begin();
+		fputs("Binary -> integer division by zero", stderr);
+		bo_panic_end();
+	}
+}
+
+/* The arithmetic wraps around in unsigned ints, and the overflow check
+ * then tells whether the result is the one of Bo. */
+static inline int64_t bo_add(int64_t a, int64_t b) {
+	int64_t c = (int64_t)((uint64_t)a + (uint64_t)b);
+	if ((c > a) != (b > 0)) {
+		bo_overflow(a, "+", b);
+	}
+	return c;
+}
+
+static inline int64_t bo_sub(int64_t a, int64_t b) {
+	int64_t c = (int64_t)((uint64_t)a - (uint64_t)b);
+	if ((c < a) != (b > 0)) {
+		bo_overflow(a, "-", b);
+	}
+	return c;
+}
+
+static inline int64_t bo_mul(int64_t a, int64_t b) {
+	int64_t c;
+	if (a == 0 || b == 0) {
+		return 0;
+	}
+	if (a == INT64_MIN && b == -1) {
+		bo_overflow(a, "*", b);
+	}
+	c = (int64_t)((uint64_t)a * (uint64_t)b);
+	if (c / b != a) {
+		bo_overflow(a, "*", b);
+	}
+	return c;
+}
+
+static inline int64_t bo_div(int64_t a, int64_t b) {
+	bo_check_divisor(b);
+	if (a == INT64_MIN && b == -1) {
+		bo_overflow(a, "/", b);
+	}
+	return a / b;
+}
+
+static inline int64_t bo_rem(int64_t a, int64_t b) {
+	bo_check_divisor(b);
+	return b == -1 ? 0 : a % b;
+}
+
+static inline int64_t bo_neg(int64_t a) {
+	if (a == INT64_MIN) {
+		bo_panic_begin();
+		fprintf(stderr, "Neg -> integer overflow: -(%" PRId64 ")", a);
+		bo_panic_end();
+	}
+	return -a;
+}
+
+/* The wrapping operators +%, -% and *%. */
+static inline int64_t bo_add_wrap(int64_t a, int64_t b) {
+	return (int64_t)((uint64_t)a + (uint64_t)b);
+}
+
+static inline int64_t bo_sub_wrap(int64_t a, int64_t b) {
+	return (int64_t)((uint64_t)a - (uint64_t)b);
+}
+
+static inline int64_t bo_mul_wrap(int64_t a, int64_t b) {
+	return (int64_t)((uint64_t)a * (uint64_t)b);
+}
+
+/* A float converts to the int it truncates to, if there is one. */
+static inline int64_t bo_to_int(double x) {
+	if (!(x >= -9223372036854775808.0 && x < 9223372036854775808.0)) {
+		bo_panic_begin();
+		fputs("convert -> value ", stderr);
+		bo_write_float(stderr, x);
+		fputs(" out of range for int", stderr);
+		bo_panic_end();
+	}
+	return (int64_t)x;
+}
+
+/* A match none of whose arms matches its value. */
+static inline void bo_no_match_int(int64_t v) {
+	bo_panic_begin();
+	fputs("match -> no arm matched ", stderr);
+	bo_write_int(stderr, v);
+	bo_panic_end();
+}
+
+static inline void bo_no_match_float(double v) {
+	bo_panic_begin();
+	fputs("match -> no arm matched ", stderr);
+	bo_write_float(stderr, v);
+	bo_panic_end();
+}
+
+static inline void bo_no_match_bool(bool v) {
+	bo_panic_begin();
+	fputs("match -> no arm matched ", stderr);
+	bo_write_bool(stderr, v);
+	bo_panic_end();
+}
+
+#endif
@@ -0,0 +1,162 @@
+// Package cgen translates a program in SSA form, as the ir package builds
+// it, to a C99 program. The program includes bo.h, the runtime Header,
+// which checks int arithmetic and prints values as Bo does.
+//
+// Each function the program calls becomes a static function, its first
+// one the C main, and each global a static variable. The values of a
+// function become its locals, each computed by a statement of its own in
+// the order of its blocks, so that operands are evaluated in Bo's order.
+// A block is a label that the blocks before it jump to, and a phi is
+// assigned on each edge into its block. Constants are written where they
+// are used.
+package cgen
+
+import (
+	"bo/checker"
+	"bo/ir"
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Header is bo.h, which the generated program includes.
+//
+//go:embed bo.h
+var Header []byte
+
+// HeaderName is the name the generated program includes Header by.
+const HeaderName = "bo.h"
+
+// Generate returns the source of a C program that does what a program
+// does.
+func Generate(p *ir.Program) []byte {
+	g := &generator{
+		funcs:   make(map[*ir.Func]string),
+		globals: make(map[*ir.Global]string),
+		names:   make(map[string]bool),
+	}
+
+	funcs := called(p)
+	for i, fn := range funcs {
+		if i == 0 {
+			g.funcs[fn] = "main"
+		} else {
+			g.funcs[fn] = g.name(fn.Name, nil)
+		}
+	}
+	for _, global := range p.Globals {
+		g.globals[global] = g.name(global.Name, nil)
+	}
+
+	var b strings.Builder
+	b.WriteString("// Code generated by bo cgen. DO NOT EDIT.\n\n")
+	b.WriteString("#include \"" + HeaderName + "\"\n")
+	if len(p.Globals) > 0 {
+		b.WriteString("\n")
+		for _, global := range p.Globals {
+			fmt.Fprintf(&b, "static %s %s;\n", cType(global.Type), g.globals[global])
+		}
+	}
+
+	// Functions are declared first, as they may call each other
+	fns := make([]*funcGen, len(funcs))
+	for i, fn := range funcs {
+		fns[i] = g.function(fn)
+	}
+	if len(funcs) > 1 {
+		b.WriteString("\n")
+		for _, f := range fns[1:] {
+			b.WriteString(f.signature() + ";\n")
+		}
+	}
+	for _, f := range fns[1:] {
+		b.WriteString("\n" + f.String())
+	}
+	b.WriteString("\n" + fns[0].String())
+	return []byte(b.String())
+}
+
+type generator struct {
+	// The C names of the functions and globals
+	funcs   map[*ir.Func]string
+	globals map[*ir.Global]string
+
+	// The names given to the functions and globals
+	names map[string]bool
+}
+
+// called returns the functions of a program main calls, directly or not,
+// main first. C warns of a static function that nothing calls.
+func called(p *ir.Program) []*ir.Func {
+	funcs := []*ir.Func{p.Funcs[0]}
+	seen := map[*ir.Func]bool{p.Funcs[0]: true}
+	for i := 0; i < len(funcs); i++ {
+		for _, b := range funcs[i].Blocks {
+			for _, v := range b.Values {
+				if fn, ok := v.Aux.(*ir.Func); ok && !seen[fn] {
+					seen[fn] = true
+					funcs = append(funcs, fn)
+				}
+			}
+		}
+	}
+	return funcs
+}
+
+// reserved holds the names C and the headers bo.h includes take.
+var reserved = make(map[string]bool)
+
+func init() {
+	for _, name := range strings.Fields(`
+		auto break case char const continue default do double else enum
+		extern float for goto if inline int long register restrict return
+		short signed sizeof static struct switch typedef union unsigned void
+		volatile while _Bool _Complex _Imaginary bool true false main
+		abort abs atoi exit fabs fflush fprintf fputc fputs free isinf isnan
+		malloc printf putchar puts signbit snprintf stderr stdout strchr
+		strtod errno assert int64_t uint64_t INT64_MIN PRId64 HUGE_VAL NAN`) {
+		reserved[name] = true
+	}
+}
+
+// valueName matches the names of the locals values are computed into.
+var valueName = regexp.MustCompile(`^v[0-9]+$`)
+
+// name returns a C name for a Bo name, which neither names nor local, if
+// not nil, has yet, and adds it to them.
+func (g *generator) name(name string, local map[string]bool) string {
+	// The names of the runtime start with bo_
+	if strings.HasPrefix(name, "bo_") || valueName.MatchString(name) {
+		name = "v" + name
+	}
+	n := name
+	for i := 2; reserved[n] || g.names[n] || local[n]; i++ {
+		n = fmt.Sprintf("%s_%d", name, i)
+	}
+	if local != nil {
+		local[n] = true
+	} else {
+		g.names[n] = true
+	}
+	return n
+}
+
+// cType returns the C type of the values of type t.
+func cType(t checker.Type) string {
+	switch t {
+	case checker.Int:
+		return "int64_t"
+	case checker.Float:
+		return "double"
+	case checker.Bool:
+		return "bool"
+	}
+	panic(fmt.Sprintf("cType -> unhandled type %s", t))
+}
+
+// typeName returns the name of the runtime functions for values of type
+// t: int, float or bool.
+func typeName(t checker.Type) string {
+	return t.String()
+}
@@ -0,0 +1,59 @@
+package cgen_test
+
+import (
+	"bo/cgen"
+	"bo/internal/botest"
+	"bo/ir"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerate translates the SSA form of the programs of parser/testdata
+// and testdata, which it verifies first, to C, builds each with the C
+// compiler as strict C99, any warning an error, and checks that it prints
+// what bo run prints. The programs the scalar subset leaves out are
+// skipped.
+func TestGenerate(t *testing.T) {
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler")
+	}
+
+	generated := 0
+	for _, p := range botest.Programs(t, "testdata") {
+		prog, err := ir.Build(p.Prog, p.Info)
+		if err != nil {
+			if !botest.Unsupported(err) {
+				t.Errorf("%s: %v", p.Name, err)
+			}
+			continue
+		}
+		if err := ir.Verify(prog); err != nil {
+			t.Errorf("%s: %v", p.Name, err)
+			continue
+		}
+		generated++
+		t.Run(p.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			src := filepath.Join(dir, p.Name+".c")
+			if err := os.WriteFile(src, cgen.Generate(prog), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, cgen.HeaderName), cgen.Header, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			bin := filepath.Join(dir, p.Name)
+			cmd := exec.Command(cc, "-std=c99", "-Wall", "-Wextra", "-pedantic", "-Werror", "-o", bin, src, "-lm")
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("cc: %v\n%s", err, out)
+			}
+			botest.Compare(t, botest.Exec(t, bin), botest.Run(t, p.File))
+		})
+	}
+	if generated == 0 {
+		t.Error("no program generated")
+	}
+}
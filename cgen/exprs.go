@@ -0,0 +1,138 @@
+package cgen
+
+import (
+	"bo/checker"
+	"bo/ir"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// The operators of the float arithmetic and of the comparisons, and the
+// runtime functions of the int arithmetic, which C does not check.
+var (
+	floatOps   = map[ir.Op]string{ir.OpAdd: "+", ir.OpSub: "-", ir.OpMul: "*", ir.OpDiv: "/"}
+	compareOps = map[ir.Op]string{
+		ir.OpEq: "==", ir.OpNe: "!=", ir.OpLt: "<", ir.OpLe: "<=", ir.OpGt: ">", ir.OpGe: ">=",
+	}
+	intFuncs = map[ir.Op]string{
+		ir.OpAdd: "bo_add", ir.OpSub: "bo_sub", ir.OpMul: "bo_mul", ir.OpDiv: "bo_div", ir.OpRem: "bo_rem",
+		ir.OpAddWrap: "bo_add_wrap", ir.OpSubWrap: "bo_sub_wrap", ir.OpMulWrap: "bo_mul_wrap",
+	}
+)
+
+// value returns the statement that computes a value, "" if it needs none:
+// if it is a constant, a parameter or a phi, or its result, which nothing
+// uses, is all it computes.
+func (f *funcGen) value(v *ir.Value) string {
+	args := make([]string, len(v.Args))
+	for i, arg := range v.Args {
+		args[i] = f.operand(arg)
+	}
+
+	var code string
+	switch v.Op {
+	case ir.OpConst, ir.OpParam, ir.OpPhi:
+		return ""
+	case ir.OpAdd, ir.OpSub, ir.OpMul, ir.OpDiv, ir.OpRem, ir.OpAddWrap, ir.OpSubWrap, ir.OpMulWrap:
+		if v.Type == checker.Float {
+			code = args[0] + " " + floatOps[v.Op] + " " + args[1]
+		} else {
+			code = intFuncs[v.Op] + "(" + args[0] + ", " + args[1] + ")"
+		}
+	case ir.OpNeg:
+		if v.Type == checker.Float {
+			code = "-" + args[0]
+			if strings.HasPrefix(args[0], "-") {
+				code = "-(" + args[0] + ")"
+			}
+		} else {
+			code = "bo_neg(" + args[0] + ")"
+		}
+	case ir.OpNot:
+		code = "!" + args[0]
+	case ir.OpEq, ir.OpNe, ir.OpLt, ir.OpLe, ir.OpGt, ir.OpGe:
+		code = args[0] + " " + compareOps[v.Op] + " " + args[1]
+	case ir.OpIntToFloat:
+		code = "(double)" + args[0]
+	case ir.OpFloatToInt:
+		code = "bo_to_int(" + args[0] + ")"
+	case ir.OpCall:
+		code = f.funcs[v.Aux.(*ir.Func)] + "(" + strings.Join(args, ", ") + ")"
+	case ir.OpLoad:
+		code = f.globals[v.Aux.(*ir.Global)]
+	case ir.OpStore:
+		code = f.globals[v.Aux.(*ir.Global)] + " = " + args[0]
+	case ir.OpWrite:
+		code = "bo_write_" + typeName(v.Args[0].Type) + "(stdout, " + args[0] + ")"
+	case ir.OpWriteString:
+		code = "fputs(" + quote(v.Aux.(string)) + ", stdout)"
+	case ir.OpPrintln:
+		code = "putchar('\\n')"
+	case ir.OpNoMatch:
+		code = "bo_no_match_" + typeName(v.Args[0].Type) + "(" + args[0] + ")"
+	default:
+		panic(fmt.Sprintf("value -> unhandled operation %s", v.Op))
+	}
+
+	switch {
+	case f.assigned(v):
+		return fmt.Sprintf("%s = %s;", v, code)
+	case v.Type == nil || effect(v):
+		return code + ";"
+	}
+	return ""
+}
+
+// effect reports whether computing a value may do more than give its
+// result: call a function or panic.
+func effect(v *ir.Value) bool {
+	switch v.Op {
+	case ir.OpCall, ir.OpFloatToInt:
+		return true
+	case ir.OpAdd, ir.OpSub, ir.OpMul, ir.OpDiv, ir.OpRem, ir.OpNeg:
+		return v.Type == checker.Int
+	}
+	return false
+}
+
+// operand returns the code of a value used by another: a constant, or the
+// name of the parameter or local that holds it.
+func (f *funcGen) operand(v *ir.Value) string {
+	switch v.Op {
+	case ir.OpConst:
+		return constant(v.Aux)
+	case ir.OpParam:
+		return f.params[v]
+	}
+	return v.String()
+}
+
+// constant returns the code of a constant.
+func constant(c interface{}) string {
+	switch c := c.(type) {
+	case bool:
+		return strconv.FormatBool(c)
+	case int64:
+		if c == math.MinInt64 {
+			return "INT64_MIN"
+		}
+		return strconv.FormatInt(c, 10)
+	case float64:
+		switch {
+		case math.IsInf(c, 1):
+			return "HUGE_VAL"
+		case math.IsInf(c, -1):
+			return "-HUGE_VAL"
+		case math.IsNaN(c):
+			return "NAN"
+		}
+		code := strconv.FormatFloat(c, 'g', -1, 64)
+		if !strings.ContainsAny(code, ".e") {
+			code += ".0"
+		}
+		return code
+	}
+	panic(fmt.Sprintf("constant -> unhandled constant %v", c))
+}
@@ -0,0 +1,265 @@
+package cgen
+
+import (
+	"bo/ir"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// funcGen is a function being generated.
+type funcGen struct {
+	*generator
+	fn *ir.Func
+
+	// The names of the parameters, and those given to them
+	params map[*ir.Value]string
+	names  map[string]bool
+
+	// How many values and block ends use each value
+	uses map[*ir.Value]int
+
+	// The code of each block, the blocks a goto jumps to, which need a
+	// label, and the temporaries the code assigns, declared with the
+	// values
+	code  map[*ir.Block][]string
+	gotos map[*ir.Block]bool
+	temps []*ir.Value
+
+	// The ID the next temporary takes
+	nextID int
+}
+
+// function generates the code of a function.
+func (g *generator) function(fn *ir.Func) *funcGen {
+	f := &funcGen{
+		generator: g,
+		fn:        fn,
+		params:    make(map[*ir.Value]string),
+		names:     make(map[string]bool),
+		uses:      make(map[*ir.Value]int),
+		code:      make(map[*ir.Block][]string),
+		gotos:     make(map[*ir.Block]bool),
+	}
+	for _, param := range fn.Params {
+		f.params[param] = g.name(param.Aux.(string), f.names)
+	}
+	for _, b := range fn.Blocks {
+		for _, v := range b.Values {
+			for _, arg := range v.Args {
+				f.uses[arg]++
+			}
+			f.nextID = max(f.nextID, v.ID+1)
+		}
+		if b.Control != nil {
+			f.uses[b.Control]++
+		}
+	}
+
+	for i, b := range fn.Blocks {
+		var next *ir.Block
+		if i+1 < len(fn.Blocks) {
+			next = fn.Blocks[i+1]
+		}
+		f.code[b] = f.block(b, next)
+	}
+	return f
+}
+
+func (f *funcGen) main() bool {
+	return f.funcs[f.fn] == "main"
+}
+
+// signature returns the head of the C function.
+func (f *funcGen) signature() string {
+	if f.main() {
+		return "int main(void)"
+	}
+	ret := "void"
+	if f.fn.Result != nil {
+		ret = cType(f.fn.Result)
+	}
+	var params []string
+	for _, param := range f.fn.Params {
+		params = append(params, cType(param.Type)+" "+f.params[param])
+	}
+	if len(params) == 0 {
+		params = []string{"void"}
+	}
+	return fmt.Sprintf("static %s %s(%s)", ret, f.funcs[f.fn], strings.Join(params, ", "))
+}
+
+// String returns the definition of the function: the locals of its values,
+// then the code of its blocks.
+func (f *funcGen) String() string {
+	var b strings.Builder
+	b.WriteString(f.signature() + " {\n")
+	for _, param := range f.fn.Params {
+		if f.uses[param] == 0 {
+			fmt.Fprintf(&b, "\t(void)%s;\n", f.params[param])
+		}
+	}
+
+	var locals []*ir.Value
+	for _, block := range f.fn.Blocks {
+		for _, v := range block.Values {
+			if f.assigned(v) {
+				locals = append(locals, v)
+			}
+		}
+	}
+	for _, v := range append(locals, f.temps...) {
+		fmt.Fprintf(&b, "\t%s %s;\n", cType(v.Type), v)
+	}
+	if len(locals)+len(f.temps) > 0 {
+		b.WriteString("\n")
+	}
+
+	for _, block := range f.fn.Blocks {
+		if f.gotos[block] {
+			b.WriteString("\n" + block.String() + ":\n")
+		}
+		for _, line := range f.code[block] {
+			b.WriteString("\t" + line + "\n")
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// assigned reports whether a value is computed into a local: whether it
+// has one, is used and is not a constant or a parameter.
+func (f *funcGen) assigned(v *ir.Value) bool {
+	return v.Type != nil && f.uses[v] > 0 && v.Op != ir.OpConst && v.Op != ir.OpParam
+}
+
+// block returns the code of a block, which next follows, nil if none.
+func (f *funcGen) block(b *ir.Block, next *ir.Block) []string {
+	var code []string
+	for i := 0; i < len(b.Values); i++ {
+		// A line of a single string is written by puts
+		v := b.Values[i]
+		if v.Op == ir.OpWriteString && i+1 < len(b.Values) && b.Values[i+1].Op == ir.OpPrintln &&
+			(i == 0 || b.Values[i-1].Op != ir.OpWrite && b.Values[i-1].Op != ir.OpWriteString) {
+			code = append(code, "puts("+quote(v.Aux.(string))+");")
+			i++
+			continue
+		}
+		if line := f.value(v); line != "" {
+			code = append(code, line)
+		}
+	}
+
+	switch b.Kind {
+	case ir.Plain:
+		code = append(code, f.jump(b, 0, next)...)
+	case ir.If:
+		cond := f.operand(b.Control)
+		if b.Succs[0] == next && !f.assigns(b, 0) {
+			code = append(code, "if (!"+cond+") {")
+			code = append(code, indent(f.jump(b, 1, nil))...)
+			code = append(code, "}")
+			break
+		}
+		code = append(code, "if ("+cond+") {")
+		code = append(code, indent(f.jump(b, 0, nil))...)
+		code = append(code, "}")
+		code = append(code, f.jump(b, 1, next)...)
+	case ir.Return:
+		switch {
+		case f.main():
+			code = append(code, "return 0;")
+		case b.Control == nil:
+			code = append(code, "return;")
+		default:
+			code = append(code, "return "+f.operand(b.Control)+";")
+		}
+	case ir.Unreachable:
+		code = append(code, "abort();")
+	}
+	return code
+}
+
+// jump returns the code that goes from a block to its successor i: the
+// assignments to the phis of the successor, then a goto, unless the
+// successor is next.
+func (f *funcGen) jump(b *ir.Block, i int, next *ir.Block) []string {
+	code := f.moves(b, i)
+	to := b.Succs[i]
+	if to != next {
+		f.gotos[to] = true
+		code = append(code, "goto "+to.String()+";")
+	}
+	return code
+}
+
+// moves returns the assignments to the phis of the successor i of a block
+// of their operands on that edge. An operand that is another of the phis
+// is copied to a temporary first, as it is assigned too.
+func (f *funcGen) moves(b *ir.Block, i int) []string {
+	to, e := b.Succs[i], b.PredIndex(i)
+	var temps, code []string
+	copied := make(map[*ir.Value]string)
+	for _, phi := range to.Values {
+		if phi.Op != ir.OpPhi || f.uses[phi] == 0 || phi.Args[e] == phi {
+			continue
+		}
+		arg := phi.Args[e]
+		src := f.operand(arg)
+		if arg.Op == ir.OpPhi && arg.Block == to {
+			if _, ok := copied[arg]; !ok {
+				tmp := &ir.Value{ID: f.nextID, Type: arg.Type}
+				f.nextID++
+				f.temps = append(f.temps, tmp)
+				temps = append(temps, fmt.Sprintf("%s = %s;", tmp, src))
+				copied[arg] = tmp.String()
+			}
+			src = copied[arg]
+		}
+		code = append(code, fmt.Sprintf("%s = %s;", phi, src))
+	}
+	return append(temps, code...)
+}
+
+// assigns reports whether going from a block to its successor i assigns
+// any phi.
+func (f *funcGen) assigns(b *ir.Block, i int) bool {
+	to, e := b.Succs[i], b.PredIndex(i)
+	return slices.ContainsFunc(to.Values, func(phi *ir.Value) bool {
+		return phi.Op == ir.OpPhi && f.uses[phi] > 0 && phi.Args[e] != phi
+	})
+}
+
+func indent(code []string) []string {
+	for i, line := range code {
+		code[i] = "\t" + line
+	}
+	return code
+}
+
+// quote returns a C string literal. Bytes other than printable ASCII are
+// written as octal escapes.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c == '\n':
+			b.WriteString("\\n")
+		case c == '\t':
+			b.WriteString("\\t")
+		case c < 0x20 || c >= 0x7f:
+			fmt.Fprintf(&b, "\\%03o", c)
+		case c == '?' && i > 0 && s[i-1] == '?':
+			// Not the end of a trigraph
+			b.WriteString(`\?`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
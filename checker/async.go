@@ -0,0 +1,83 @@
+package checker
+
+import (
+	"bo/ast"
+	"fmt"
+)
+
+// futures is the type of Future, whose members combine futures:
+//
+//	Future.all(f, g, ...)   is a future of the list of their values
+//	Future.any(f, g, ...)   is a future of the value of the first to finish
+//	Future.timeout(f, ms)   is f, failing if it takes longer than ms
+var futures = &Module{Path: "Future", Members: map[string]Type{
+	"all": &Generic{Name: "Future.all", Check: func(args []Type) (Type, error) {
+		elem, err := sameFutures(args)
+		if err != nil {
+			return nil, err
+		}
+		return FutureOf(ListOf(elem)), nil
+	}},
+	"any": &Generic{Name: "Future.any", Check: func(args []Type) (Type, error) {
+		elem, err := sameFutures(args)
+		if err != nil {
+			return nil, err
+		}
+		return FutureOf(elem), nil
+	}},
+	"timeout": &Generic{Name: "Future.timeout", Check: func(args []Type) (Type, error) {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("wrong number of arguments: have %d, want 2", len(args))
+		}
+		if _, ok := args[0].(*Future); !ok {
+			return nil, fmt.Errorf("%s is not a future", args[0])
+		}
+		if args[1] != Int {
+			return nil, fmt.Errorf("cannot use %s value as int in argument 2", args[1])
+		}
+		return args[0], nil
+	}},
+}}
+
+// sameFutures returns the type of the values of futures, which must all
+// have the same type.
+func sameFutures(futures []Type) (Type, error) {
+	if len(futures) == 0 {
+		return nil, fmt.Errorf("not enough arguments: need a future")
+	}
+
+	var elem Type
+	for _, t := range futures {
+		f, ok := t.(*Future)
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("%s is not a future", t)
+		case elem != nil && f.Elem != elem:
+			return nil, fmt.Errorf("mismatched futures: %s and %s", FutureOf(elem), f)
+		}
+		elem = f.Elem
+	}
+	return elem, nil
+}
+
+// VisitAwaitExpression checks await f, which waits for the future f and is
+// its value. If the future failed, the error is raised where it is awaited.
+func (c *Checker) VisitAwaitExpression(expr *ast.Await) interface{} {
+	return c.future(expr.X).Elem
+}
+
+func (c *Checker) VisitAwaitStatement(stmt *ast.AwaitStmt) interface{} {
+	c.future(stmt.X)
+
+	return nil
+}
+
+// future checks an expression that must be a future.
+func (c *Checker) future(expr ast.Expr) *Future {
+	t := c.typeOf(expr)
+	f, ok := t.(*Future)
+	if !ok {
+		errorf(expr, "%s is not a future", t)
+	}
+	return f
+}
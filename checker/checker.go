@@ -0,0 +1,944 @@
+package checker
+
+import (
+	"bo/ast"
+	"fmt"
+	"maps"
+	"path"
+)
+
+// Info holds the results of type checking that the runner relies on.
+type Info struct {
+	// Types maps each expression to its type after implicit conversions,
+	// and each type specifier to the type it names.
+	Types map[ast.Node]Type
+
+	// Calls maps each call to how its arguments are passed to the
+	// parameters.
+	Calls map[*ast.Call]*Call
+
+	// Generators holds the functions that yield values.
+	Generators map[*ast.FuncDecl]bool
+
+	// Operators maps the expressions that call an operator method to it.
+	Operators map[ast.Node]*Overload
+}
+
+// Call is how the arguments of a call are passed to the parameters of the
+// function called.
+type Call struct {
+	// Args holds the argument given for each parameter other than the
+	// variadic one, nil where the parameter takes its default value.
+	Args []ast.Expr
+
+	// Rest holds the arguments a variadic function collects in a list.
+	Rest []ast.Expr
+
+	// Order holds the index in Args of each argument in the order it is
+	// written, which is the order arguments are evaluated in, when named
+	// arguments are not written in the order of their parameters.
+	Order []int
+
+	Variadic bool
+}
+
+// Written returns the indexes in Args of the arguments given, in the order
+// they are written.
+func (c *Call) Written() []int {
+	if c.Order != nil {
+		return c.Order
+	}
+
+	var order []int
+	for i, arg := range c.Args {
+		if arg != nil {
+			order = append(order, i)
+		}
+	}
+	return order
+}
+
+// Check verifies that a parsed program is well typed before it is run.
+func Check(prog *ast.Program) (info *Info, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rErr, ok := r.(*typeError); ok {
+				info, err = nil, rErr
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	checker := NewChecker()
+	checker.Visit(prog)
+
+	return checker.info, nil
+}
+
+type Checker struct {
+	symbolTable map[string]Type
+	info        *Info
+
+	// Names declared in the innermost block
+	scope map[string]bool
+
+	// Literals whose final type is known once their statement is checked
+	constants []ast.Expr
+
+	// Optional variables that a nil check proved non-nil where the
+	// expression being checked is evaluated
+	nonNil map[string]bool
+
+	// The types of the elements of list, map and tuple literals before they
+	// adapt to the type the literal is used as
+	elems map[ast.Expr][]Type
+
+	// The function whose body is being checked, nil at the top level, and
+	// whether it is a generator
+	function  *Func
+	generator bool
+
+	// Switch statements whose arms match every value
+	exhaustive map[*ast.Switch]bool
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		symbolTable: maps.Clone(builtins),
+		scope:       make(map[string]bool),
+		nonNil:      make(map[string]bool),
+		elems:       make(map[ast.Expr][]Type),
+		exhaustive:  make(map[*ast.Switch]bool),
+		info: &Info{
+			Types: make(map[ast.Node]Type),
+			Calls: make(map[*ast.Call]*Call),
+
+			Generators: make(map[*ast.FuncDecl]bool),
+			Operators:  make(map[ast.Node]*Overload),
+		},
+	}
+}
+
+func (c *Checker) Visit(node ast.Node) interface{} {
+	switch node := node.(type) {
+	case *ast.Program:
+		return c.VisitProgram(node)
+	case *ast.Block:
+		return c.VisitBlock(node)
+	case ast.Stmt:
+		return c.VisitStatement(node)
+	case ast.TypeExpr:
+		return c.VisitTypeSpec(node)
+	case *ast.Ident:
+		return c.VisitIdent(node)
+	case *ast.BasicLit:
+		return c.VisitBasicLit(node)
+	case *ast.StringLit:
+		return c.VisitStringLit(node)
+	case *ast.Paren:
+		return c.typeOf(node.X)
+	case *ast.ListLit:
+		return c.VisitListLiteral(node)
+	case *ast.MapLit:
+		return c.VisitMapLiteral(node)
+	case *ast.TupleLit:
+		return c.VisitTupleLiteral(node)
+	case *ast.StructLit:
+		return c.VisitStructLiteral(node)
+	case *ast.Match:
+		return c.VisitMatchExpression(node)
+	case *ast.Conversion:
+		return c.VisitConversionExpression(node)
+	case *ast.Member:
+		return c.VisitMemberExpression(node)
+	case *ast.Call:
+		return c.VisitCallExpression(node)
+	case *ast.Index:
+		return c.VisitIndexExpression(node)
+	case *ast.Unary:
+		return c.VisitUnaryExpression(node)
+	case *ast.Receive:
+		return c.VisitReceiveExpression(node)
+	case *ast.Await:
+		return c.VisitAwaitExpression(node)
+	case *ast.Range:
+		return c.VisitRangeExpression(node)
+	case *ast.Step:
+		return c.VisitStepExpression(node)
+	case *ast.Binary:
+		switch node.Op {
+		case "*", "/", "%", "*%":
+			return c.VisitMultiplicativeExpression(node)
+		case "+", "-", "+%", "-%":
+			return c.VisitAdditiveExpression(node)
+		case "<", "<=", ">", ">=":
+			return c.VisitRelationalExpression(node)
+		case "==", "!=":
+			return c.VisitEqualityExpression(node)
+		case "&&":
+			return c.VisitAndExpression(node)
+		case "||":
+			return c.VisitOrExpression(node)
+		case "??":
+			return c.VisitCoalesceExpression(node)
+		}
+	}
+
+	panic(fmt.Sprintf("Visit -> unhandled type: %T", node))
+}
+
+// typeOf checks an expression or type specifier and records its type.
+func (c *Checker) typeOf(node ast.Node) Type {
+	t := c.calleeType(node)
+
+	// Functions are only called, they are not values
+	switch t.(type) {
+	case *Func, *Generic:
+		errorf(node, "%s cannot be used as a value", ast.String(node))
+	}
+
+	return t
+}
+
+// calleeType returns the type of an expression in call position, which
+// may be a function.
+func (c *Checker) calleeType(node ast.Node) Type {
+	t := c.Visit(node).(Type)
+	c.info.Types[node] = t
+
+	return t
+}
+
+func (c *Checker) VisitProgram(prog *ast.Program) interface{} {
+	for _, stmt := range prog.Stmts {
+		c.VisitStatement(stmt)
+		c.checkConstants()
+	}
+
+	return nil
+}
+
+func (c *Checker) VisitStatement(stmt ast.Stmt) interface{} {
+	switch stmt := stmt.(type) {
+	case *ast.Require:
+		return c.VisitRequireStatement(stmt)
+	case *ast.EnumDecl:
+		return c.VisitEnumDeclaration(stmt)
+	case *ast.StructDecl:
+		return c.VisitStructDeclaration(stmt)
+	case *ast.FuncDecl:
+		return c.VisitFunctionDeclaration(stmt)
+	case *ast.Return:
+		return c.VisitReturnStatement(stmt)
+	case *ast.Defer:
+		return c.VisitDeferStatement(stmt)
+	case *ast.Yield:
+		return c.VisitYieldStatement(stmt)
+	case *ast.For:
+		return c.VisitForStatement(stmt)
+	case *ast.VarDecl:
+		return c.VisitVariableDeclaration(stmt)
+	case *ast.Destructure:
+		// The pattern declares its variables in the enclosing block
+		c.bindPattern(stmt.Pattern, c.typeOf(stmt.Value))
+
+		return nil
+	case *ast.Switch:
+		return c.VisitSwitchStatement(stmt)
+	case *ast.Spawn:
+		return c.VisitSpawnStatement(stmt)
+	case *ast.Send:
+		return c.VisitSendStatement(stmt)
+	case *ast.Select:
+		return c.VisitSelectStatement(stmt)
+	case *ast.AwaitStmt:
+		return c.VisitAwaitStatement(stmt)
+	case *ast.CallStmt:
+		return c.VisitFunctionCall(stmt.Call)
+	default:
+		panic(fmt.Sprintf("VisitStatement -> unhandled statement type: %T", stmt))
+	}
+}
+
+// VisitRequireStatement binds a standard library module to the last element
+// of its path. Other modules are not checked.
+func (c *Checker) VisitRequireStatement(req *ast.Require) interface{} {
+	module, ok := stdModules[req.Path]
+	if !ok || !req.Std {
+		return nil
+	}
+
+	c.declare(req, path.Base(req.Path), module)
+
+	return nil
+}
+
+// VisitEnumDeclaration declares an enum and binds its name, so that cases
+// are written Color.Red. Cases may carry values of the enum itself.
+func (c *Checker) VisitEnumDeclaration(decl *ast.EnumDecl) interface{} {
+	enum := &Enum{Name: decl.Name.Name}
+	c.declare(decl, enum.Name, &TypeName{Type: enum})
+
+	for _, enumCase := range decl.Cases {
+		name := enumCase.Name.Name
+		if enum.Case(name) != nil {
+			errorf(enumCase, "duplicate case %s in enum %s", name, enum.Name)
+		}
+
+		var fields []Type
+		for _, field := range enumCase.Fields {
+			fields = append(fields, c.typeOf(field))
+		}
+		enum.Cases = append(enum.Cases, &EnumCase{Name: name, Fields: fields})
+	}
+
+	return nil
+}
+
+// declare binds a name in the innermost block.
+func (c *Checker) declare(node ast.Node, name string, t Type) {
+	if c.scope[name] {
+		errorf(node, "%s redeclared", name)
+	}
+
+	c.symbolTable[name] = t
+	c.scope[name] = true
+
+	// A nil check on a shadowed variable says nothing about this one
+	delete(c.nonNil, name)
+}
+
+// block checks what check does in a new block, whose declarations shadow
+// and then go out of scope.
+func (c *Checker) block(check func()) {
+	symbolTable, scope, nonNil := c.symbolTable, c.scope, c.nonNil
+	c.symbolTable, c.scope, c.nonNil = maps.Clone(symbolTable), make(map[string]bool), maps.Clone(nonNil)
+
+	check()
+
+	c.symbolTable, c.scope, c.nonNil = symbolTable, scope, nonNil
+}
+
+func (c *Checker) VisitBlock(block *ast.Block) interface{} {
+	c.block(func() {
+		for _, stmt := range block.Stmts {
+			c.VisitStatement(stmt)
+		}
+	})
+
+	return nil
+}
+
+// typeName returns the type a name declared. A type of a module is
+// qualified with the module's name.
+func (c *Checker) typeName(n *ast.TypeName) Type {
+	var name *TypeName
+	ok := false
+	if n.Module == nil {
+		name, ok = c.symbolTable[n.Name.Name].(*TypeName)
+	} else if module, isModule := c.symbolTable[n.Module.Name].(*Module); isModule {
+		name, ok = module.Members[n.Name.Name].(*TypeName)
+	}
+	if !ok {
+		errorf(n, "%s is not a type", ast.String(n))
+	}
+
+	return name.Type
+}
+
+func (c *Checker) VisitTypeSpec(spec ast.TypeExpr) interface{} {
+	switch spec := spec.(type) {
+	case *ast.BasicType:
+		return basicTypes[spec.Name]
+	case *ast.TypeName:
+		return c.typeName(spec)
+	case *ast.ListType:
+		return ListOf(c.typeOf(spec.Elem))
+	case *ast.MapType:
+		key := c.typeOf(spec.Key)
+		if b, ok := key.(*Basic); !ok || b == Nil || b == Any {
+			errorf(spec, "invalid map key type %s", key)
+		}
+		return MapOf(key, c.typeOf(spec.Value))
+	case *ast.ChanType:
+		return ChanOf(c.typeOf(spec.Elem))
+	case *ast.FutureType:
+		return FutureOf(c.typeOf(spec.Elem))
+	case *ast.IteratorType:
+		return IteratorOf(c.typeOf(spec.Elem))
+	case *ast.TupleType:
+		var elems []Type
+		for _, elem := range spec.Elems {
+			elems = append(elems, c.typeOf(elem))
+		}
+		return TupleOf(elems...)
+	case *ast.OptionalType:
+		return OptionalOf(c.typeOf(spec.Elem))
+	default:
+		panic(fmt.Sprintf("VisitTypeSpec -> unhandled type: %T", spec))
+	}
+}
+
+func (c *Checker) VisitIdent(id *ast.Ident) interface{} {
+	varType, ok := c.symbolTable[id.Name]
+	if !ok {
+		errorf(id, "undefined: %s", id.Name)
+	}
+
+	// A nil check makes the value usable as its element type
+	if optional, ok := varType.(*Optional); ok && c.nonNil[id.Name] {
+		return optional.Elem
+	}
+	return varType
+}
+
+func (c *Checker) VisitBasicLit(lit *ast.BasicLit) interface{} {
+	if value, ok := c.constant(lit); ok {
+		c.constants = append(c.constants, lit)
+		if _, ok := value.(float64); ok {
+			return Float
+		}
+		return Int
+	}
+
+	switch lit.Kind {
+	case ast.BigInt:
+		return BigInt
+	case ast.Decimal:
+		return Decimal
+	case ast.Bool:
+		return Bool
+	case ast.Nil:
+		return Nil
+	default:
+		panic(fmt.Sprintf("VisitBasicLit -> unhandled literal kind: %d", lit.Kind))
+	}
+}
+
+// VisitStringLit checks the expressions a string literal embeds. Every type
+// has a canonical string form, so any well-typed expression may be
+// interpolated.
+func (c *Checker) VisitStringLit(lit *ast.StringLit) interface{} {
+	for _, part := range lit.Parts {
+		if part.Expr != nil {
+			c.typeOf(part.Expr)
+		}
+	}
+
+	return String
+}
+
+func (c *Checker) VisitConversionExpression(conv *ast.Conversion) interface{} {
+	target := c.typeOf(conv.Type)
+	operand := c.typeOf(conv.X)
+
+	// string(v) calls the string operator of v's struct, if it has one
+	if target == String {
+		if fn := c.overload(conv, "string", operand, nil, nil); fn != nil {
+			return fn.Result
+		}
+	}
+
+	// chan[T](n) makes a channel that buffers n values
+	if _, ok := target.(*Chan); ok {
+		if !c.assign(conv.X, operand, Int) {
+			errorf(conv.X, "invalid channel buffer size: %s value", operand)
+		}
+		return target
+	}
+
+	// Numeric types convert to each other, any value to its optional type
+	elem := target
+	if optional, ok := target.(*Optional); ok {
+		elem = optional.Elem
+	}
+	if operand != target && !(isNumeric(operand) && isNumeric(elem)) && !widens(operand, target) && operand != Nil {
+		errorf(conv, "cannot convert %s value to %s", operand, target)
+	}
+	if operand == Nil && elem == target {
+		errorf(conv, "cannot convert nil to %s", target)
+	}
+
+	// Constants are converted at compile time and must fit the target
+	if _, ok := c.constant(conv.X); ok {
+		c.assign(conv.X, operand, target)
+	}
+
+	return target
+}
+
+func (c *Checker) VisitMemberExpression(expr *ast.Member) interface{} {
+	objType := c.typeOf(expr.X)
+
+	// Safe navigation yields nil instead of accessing a field of nil
+	if expr.Safe {
+		return OptionalOf(c.member(expr, objType, expr.Name.Name, true))
+	}
+
+	return c.member(expr, objType, expr.Name.Name, false)
+}
+
+// member returns the type of the field name of a value of type objType,
+// which may be optional when the field is accessed with ?. (safe).
+func (c *Checker) member(node ast.Node, objType Type, name string, safe bool) Type {
+	if optional, ok := objType.(*Optional); ok {
+		if !safe {
+			errorf(node, "cannot access field %s of %s value without a nil check", name, objType)
+		}
+		objType = optional.Elem
+	}
+
+	switch obj := objType.(type) {
+	case *Module:
+		if member, ok := obj.Members[name]; ok {
+			return member
+		}
+	case *Struct:
+		if field := obj.Field(name); field != nil {
+			return field.Type
+		}
+		if method, ok := obj.Methods[name]; ok {
+			return method
+		}
+	case *Iterator:
+		switch name {
+		case "next":
+			// nil once the iterator is exhausted
+			return &Func{Result: OptionalOf(obj.Elem)}
+		case "hasNext":
+			return &Func{Result: Bool}
+		}
+	case *Chan:
+		// Receivers see nil once a closed channel is drained
+		if name == "close" {
+			return &Func{}
+		}
+	case *Opaque:
+		if method, ok := obj.Methods[name]; ok {
+			return method
+		}
+	case *TypeName:
+		// Cases without values are values of the enum, the others
+		// construct one
+		if enum, ok := obj.Type.(*Enum); ok && enum.Case(name) != nil {
+			if fields := enum.Case(name).Fields; len(fields) > 0 {
+				return &Func{Params: fields, Result: enum}
+			}
+			return enum
+		}
+	}
+
+	errorf(node, "%s has no field %s", objType, name)
+
+	return nil
+}
+
+func (c *Checker) VisitCallExpression(call *ast.Call) interface{} {
+	// x?.m(args) calls the method of x unless x is nil, and is nil then
+	member, safe := call.Fun.(*ast.Member)
+	safe = safe && member.Safe
+
+	var result Type
+	if safe {
+		callee := c.member(member, c.typeOf(member.X), member.Name.Name, true)
+		c.info.Types[member] = callee
+		result = c.call(call, callee)
+	} else {
+		result = c.call(call, c.calleeType(call.Fun))
+	}
+	if result == nil {
+		errorf(call, "%s (no value) used as value", ast.String(call.Fun))
+	}
+
+	if safe {
+		return OptionalOf(result)
+	}
+	return result
+}
+
+// call checks the arguments of a call to a value of type callee and returns
+// the type of its result.
+func (c *Checker) call(call *ast.Call, callee Type) Type {
+	// Opaque types made by the runner are called to make a value
+	if name, ok := callee.(*TypeName); ok {
+		if opaque, ok := name.Type.(*Opaque); ok && opaque.New != nil {
+			callee = opaque.New
+		}
+	}
+
+	if generic, ok := callee.(*Generic); ok {
+		return c.generic(call, generic)
+	}
+
+	fn, ok := callee.(*Func)
+	if !ok {
+		errorf(call, "cannot call non-function %s value", callee)
+	}
+
+	c.info.Calls[call] = c.arguments(call, fn)
+
+	return fn.Result
+}
+
+func (c *Checker) VisitUnaryExpression(expr *ast.Unary) interface{} {
+	// A negated literal is a constant of its own so that the smallest int
+	// can be written without overflowing
+	if value, ok := c.constant(expr); ok {
+		c.constants = append(c.constants, expr)
+		if _, ok := value.(float64); ok {
+			return Float
+		}
+		return Int
+	}
+
+	operand := c.typeOf(expr.X)
+	if expr.Op == "-" {
+		if fn := c.overload(expr, "-", operand, nil, nil); fn != nil {
+			return fn.Result
+		}
+	}
+
+	switch {
+	case expr.Op == "-" && isNumeric(operand) && !isUnsigned(operand):
+		return operand
+	case expr.Op == "!" && operand == Bool:
+		return Bool
+	}
+
+	errorf(expr, "invalid operation: %s%s", expr.Op, operand)
+
+	return nil
+}
+
+func (c *Checker) VisitMultiplicativeExpression(expr *ast.Binary) interface{} {
+	left, right := c.typeOf(expr.X), c.typeOf(expr.Y)
+	if result := c.binaryOverload(expr, left, right); result != nil {
+		return result
+	}
+	operands := c.binaryOperands(expr, left, right)
+
+	// Remainder is only defined on integers and decimals, wrapping
+	// multiplication on fixed-width integers
+	if expr.Op == "%" && (isFloat(operands) || !isNumeric(operands)) {
+		c.invalidOperation(expr, operands)
+	}
+	if expr.Op == "*%" && !isInteger(operands) || !isNumeric(operands) {
+		c.invalidOperation(expr, operands)
+	}
+
+	return operands
+}
+
+func (c *Checker) VisitAdditiveExpression(expr *ast.Binary) interface{} {
+	left, right := c.typeOf(expr.X), c.typeOf(expr.Y)
+	if result := c.binaryOverload(expr, left, right); result != nil {
+		return result
+	}
+	operands := c.binaryOperands(expr, left, right)
+
+	// Strings concatenate with +, wrapping operators need integers
+	wrapping := expr.Op == "+%" || expr.Op == "-%"
+	if wrapping && !isInteger(operands) || !isNumeric(operands) && (expr.Op != "+" || operands != String) {
+		c.invalidOperation(expr, operands)
+	}
+
+	return operands
+}
+
+func (c *Checker) VisitRelationalExpression(expr *ast.Binary) interface{} {
+	left, right := c.typeOf(expr.X), c.typeOf(expr.Y)
+	if result := c.binaryOverload(expr, left, right); result != nil {
+		return result
+	}
+	operands := c.binaryOperands(expr, left, right)
+
+	if !isNumeric(operands) && operands != String {
+		c.invalidOperation(expr, operands)
+	}
+
+	return Bool
+}
+
+func (c *Checker) VisitEqualityExpression(expr *ast.Binary) interface{} {
+	left, right := c.typeOf(expr.X), c.typeOf(expr.Y)
+	if result := c.binaryOverload(expr, left, right); result != nil {
+		return result
+	}
+	c.binaryOperands(expr, left, right)
+
+	return Bool
+}
+
+func (c *Checker) VisitAndExpression(expr *ast.Binary) interface{} {
+	c.logicalOperands(expr, true)
+
+	return Bool
+}
+
+func (c *Checker) VisitOrExpression(expr *ast.Binary) interface{} {
+	c.logicalOperands(expr, false)
+
+	return Bool
+}
+
+// logicalOperands checks the operands of && (when true) or || (when false).
+// The right operand is only evaluated when the left one has value when, so
+// the nil checks that outcome implies hold while checking it.
+func (c *Checker) logicalOperands(expr *ast.Binary, when bool) {
+	left := c.typeOf(expr.X)
+
+	var right Type
+	c.withNonNil(c.nilChecks(expr.X, when), func() {
+		right = c.typeOf(expr.Y)
+	})
+
+	if left != right {
+		errorf(expr, "invalid operation: mismatched types %s and %s", left, right)
+	}
+	if left != Bool {
+		c.invalidOperation(expr, left)
+	}
+}
+
+// nilChecks returns the optional variables that are known not to be nil
+// when the boolean expression expr evaluates to when.
+func (c *Checker) nilChecks(expr ast.Expr, when bool) []string {
+	switch expr := expr.(type) {
+	case *ast.Paren:
+		return c.nilChecks(expr.X, when)
+	case *ast.Unary:
+		if expr.Op == "!" {
+			return c.nilChecks(expr.X, !when)
+		}
+	case *ast.Binary:
+		switch expr.Op {
+		case "&&":
+			if when {
+				return append(c.nilChecks(expr.X, true), c.nilChecks(expr.Y, true)...)
+			}
+		case "||":
+			if !when {
+				return append(c.nilChecks(expr.X, false), c.nilChecks(expr.Y, false)...)
+			}
+		case "==", "!=":
+			// x != nil is true, or x == nil false, exactly when x is not nil
+			if (expr.Op == "!=") != when {
+				return nil
+			}
+			if name := variable(expr.X); name != "" && isNil(expr.Y) {
+				return []string{name}
+			}
+			if name := variable(expr.Y); name != "" && isNil(expr.X) {
+				return []string{name}
+			}
+		}
+	}
+
+	return nil
+}
+
+// withNonNil checks what check does while the given variables are known
+// not to be nil.
+func (c *Checker) withNonNil(names []string, check func()) {
+	var added []string
+	for _, name := range names {
+		if !c.nonNil[name] {
+			c.nonNil[name] = true
+			added = append(added, name)
+		}
+	}
+
+	check()
+
+	for _, name := range added {
+		delete(c.nonNil, name)
+	}
+}
+
+// variable returns the name of an expression that is a bare identifier, or
+// "" otherwise.
+func variable(expr ast.Expr) string {
+	if id, ok := expr.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+func isNil(expr ast.Expr) bool {
+	lit, ok := expr.(*ast.BasicLit)
+	return ok && lit.Kind == ast.Nil
+}
+
+// VisitCoalesceExpression checks x ?? y, which is x unless it is nil and y
+// otherwise. The result is only optional when y is.
+func (c *Checker) VisitCoalesceExpression(expr *ast.Binary) interface{} {
+	left, right := c.typeOf(expr.X), c.typeOf(expr.Y)
+
+	elem := left
+	if optional, ok := left.(*Optional); ok {
+		elem = optional.Elem
+	}
+
+	switch {
+	case left == Nil:
+		return right
+	case right == Nil:
+		return OptionalOf(left)
+	case c.assign(expr.Y, right, elem):
+		return elem
+	case c.assign(expr.X, left, OptionalOf(right)):
+		return right
+	}
+
+	errorf(expr, "invalid operation: mismatched types %s and %s", left, right)
+
+	return nil
+}
+
+// binaryOperands returns the type both operands of a binary expression,
+// of types left and right, share. Constants adapt to the other operand and
+// an operand may be widened without loss, any other mix needs an explicit
+// conversion.
+func (c *Checker) binaryOperands(expr *ast.Binary, left, right Type) Type {
+	if left == right {
+		return left
+	}
+
+	x, y := expr.X, expr.Y
+	_, xConst := c.constant(x)
+	_, yConst := c.constant(y)
+
+	switch {
+	case yConst && c.assign(y, right, left):
+		return left
+	case xConst && c.assign(x, left, right):
+		return right
+	case c.assign(y, right, left):
+		return left
+	case c.assign(x, left, right):
+		return right
+	}
+
+	errorf(expr, "invalid operation: mismatched types %s and %s", left, right)
+
+	return nil
+}
+
+func (c *Checker) invalidOperation(expr *ast.Binary, operands Type) {
+	if _, ok := operands.(*Optional); ok {
+		errorf(expr, "invalid operation: operator %s not defined on %s without a nil check", expr.Op, operands)
+	}
+	errorf(expr, "invalid operation: operator %s not defined on %s", expr.Op, operands)
+}
+
+// assign reports whether expr, of type from, may be used as a value of type
+// to, and records the implicit conversion. Numeric constants may take any
+// numeric type that can represent their kind of value, which is verified
+// once the statement has been checked.
+func (c *Checker) assign(expr ast.Expr, from, to Type) bool {
+	// Any value may be used as any, values keep their type
+	if from == to || to == Any {
+		return true
+	}
+
+	// Values and nil may be used as optionals
+	target := to
+	if optional, ok := to.(*Optional); ok {
+		target = optional.Elem
+	}
+
+	if value, ok := c.constant(expr); ok {
+		if _, isFloat := value.(float64); !isNumeric(target) || isFloat && (isInteger(target) || target == BigInt) {
+			return false
+		}
+	} else if literal := compositeLiteral(expr); literal != nil {
+		if !c.adapt(literal, target) {
+			return false
+		}
+	} else if from == Nil && target == to || from != Nil && !widens(from, to) {
+		return false
+	}
+
+	c.info.Types[expr] = to
+
+	return true
+}
+
+// constant returns the value of an expression that is an untyped numeric
+// literal, possibly negated, as a *big.Int or float64. Bigint and decimal
+// literals are typed and not constants.
+func (c *Checker) constant(expr ast.Expr) (interface{}, bool) {
+	negative := false
+	if unary, ok := expr.(*ast.Unary); ok && unary.Op == "-" {
+		negative, expr = true, unary.X
+	}
+
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok {
+		return nil, false
+	}
+
+	switch lit.Kind {
+	case ast.Int:
+		val := ast.BigIntLiteral(lit.Value)
+		if negative {
+			val.Neg(val)
+		}
+		return val, true
+	case ast.Float:
+		val, err := ast.FloatLiteral(lit.Value)
+		if err != nil {
+			errorf(lit, "%s", err)
+		}
+		if negative {
+			val = -val
+		}
+		return val, true
+	}
+
+	return nil, false
+}
+
+// checkConstants verifies that the literals of the last statement fit the
+// types they ended up with.
+func (c *Checker) checkConstants() {
+	for _, expr := range c.constants {
+		value, _ := c.constant(expr)
+		t := c.info.Types[expr]
+		if !representable(value, t) {
+			kind := "integer"
+			if _, ok := value.(float64); ok {
+				kind = "float"
+			}
+			errorf(expr, "%s literal %s overflows %s", kind, ast.String(expr), t)
+		}
+
+		// The operand of a negated literal is evaluated in the same type
+		if unary, ok := expr.(*ast.Unary); ok {
+			c.info.Types[unary.X] = t
+		}
+	}
+
+	c.constants = c.constants[:0]
+}
+
+// VisitFunctionCall checks a call whose result, if any, is discarded: of a
+// function by name, or of a method, which may be of nil with ?.
+func (c *Checker) VisitFunctionCall(call *ast.Call) interface{} {
+	if member, ok := call.Fun.(*ast.Member); ok {
+		recvType := c.typeOf(member.X)
+		c.call(call, c.member(call, recvType, member.Name.Name, member.Safe))
+
+		return nil
+	}
+
+	name := call.Fun.(*ast.Ident).Name
+	fn, ok := c.symbolTable[name]
+	if !ok {
+		errorf(call, "undefined function: %s", name)
+	}
+	c.call(call, fn)
+
+	return nil
+}
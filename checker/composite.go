@@ -0,0 +1,188 @@
+package checker
+
+import "bo/ast"
+
+// VisitStructDeclaration declares a struct and binds its name. Fields may be
+// of the struct itself, through an optional.
+func (c *Checker) VisitStructDeclaration(decl *ast.StructDecl) interface{} {
+	s := &Struct{Name: decl.Name.Name, Methods: make(map[string]*Func), Operators: make(map[string][]*Operator)}
+	c.declare(decl, s.Name, &TypeName{Type: s})
+
+	for _, field := range decl.Fields {
+		name := field.Name.Name
+		if s.Field(name) != nil {
+			errorf(field, "duplicate field %s in struct %s", name, s.Name)
+		}
+		s.Fields = append(s.Fields, &Field{Name: name, Type: c.typeOf(field.Type)})
+	}
+
+	return nil
+}
+
+// VisitListLiteral checks a list literal, whose elements share a type. An
+// empty list has elements of type nil until it is used as a typed list.
+func (c *Checker) VisitListLiteral(lit *ast.ListLit) interface{} {
+	if len(lit.Elems) == 0 {
+		return ListOf(Nil)
+	}
+
+	return ListOf(c.unify(lit, lit.Elems, c.elemTypes(lit, lit.Elems), "list"))
+}
+
+func (c *Checker) VisitMapLiteral(lit *ast.MapLit) interface{} {
+	if len(lit.Entries) == 0 {
+		return MapOf(Nil, Nil)
+	}
+
+	keys, values := mapEntries(lit)
+	types := c.elemTypes(lit, append(keys, values...))
+
+	key := c.unify(lit, keys, types[:len(keys)], "map keys")
+	if b, ok := key.(*Basic); !ok || b == Nil || b == Any {
+		errorf(lit, "invalid map key type %s", key)
+	}
+
+	return MapOf(key, c.unify(lit, values, types[len(keys):], "map values"))
+}
+
+func mapEntries(lit *ast.MapLit) (keys, values []ast.Expr) {
+	for _, entry := range lit.Entries {
+		keys = append(keys, entry.Key)
+		values = append(values, entry.Value)
+	}
+	return keys, values
+}
+
+func (c *Checker) VisitTupleLiteral(lit *ast.TupleLit) interface{} {
+	return TupleOf(c.elemTypes(lit, lit.Elems)...)
+}
+
+// VisitStructLiteral checks a struct literal. Optional fields may be left
+// out and are nil, every other field must be given.
+func (c *Checker) VisitStructLiteral(lit *ast.StructLit) interface{} {
+	var st *Struct
+	if name, ok := c.symbolTable[lit.Type.Name].(*TypeName); ok {
+		st, _ = name.Type.(*Struct)
+	}
+	if st == nil {
+		errorf(lit, "%s is not a struct type", lit.Type.Name)
+	}
+
+	given := make(map[string]bool)
+	for _, value := range lit.Fields {
+		fieldName := value.Name.Name
+		field := st.Field(fieldName)
+		switch {
+		case field == nil:
+			errorf(value, "%s has no field %s", st, fieldName)
+		case given[fieldName]:
+			errorf(value, "duplicate field %s in %s literal", fieldName, st)
+		}
+		given[fieldName] = true
+
+		valueType := c.typeOf(value.Value)
+		if !c.assign(value.Value, valueType, field.Type) {
+			errorf(value.Value, "cannot use %s value as %s in field %s", valueType, field.Type, fieldName)
+		}
+	}
+
+	for _, field := range st.Fields {
+		if _, optional := field.Type.(*Optional); !given[field.Name] && !optional {
+			errorf(lit, "missing field %s in %s literal", field.Name, st)
+		}
+	}
+
+	return st
+}
+
+// elemTypes checks the elements of a list, map or tuple literal and keeps
+// their types so that the literal can adapt to the type it is used as.
+func (c *Checker) elemTypes(literal ast.Expr, elems []ast.Expr) []Type {
+	types := make([]Type, len(elems))
+	for i, elem := range elems {
+		types[i] = c.typeOf(elem)
+	}
+	c.elems[literal] = types
+
+	return types
+}
+
+// compositeLiteral returns the list, map or tuple literal expr is, or nil.
+func compositeLiteral(expr ast.Expr) ast.Expr {
+	switch expr.(type) {
+	case *ast.ListLit, *ast.MapLit, *ast.TupleLit:
+		return expr
+	}
+	return nil
+}
+
+// adapt reports whether the elements of a composite literal can be used as
+// the elements of type to, like constants adapt to the type of their
+// context, and records their implicit conversions.
+func (c *Checker) adapt(literal ast.Expr, to Type) bool {
+	switch literal := literal.(type) {
+	case *ast.ListLit:
+		list, ok := to.(*List)
+		return ok && c.assignAll(literal.Elems, c.elems[literal], list.Elem)
+	case *ast.MapLit:
+		m, ok := to.(*Map)
+		if !ok {
+			return false
+		}
+
+		keys, values := mapEntries(literal)
+		types := c.elems[literal]
+		return c.assignAll(keys, types[:len(keys)], m.Key) && c.assignAll(values, types[len(keys):], m.Value)
+	case *ast.TupleLit:
+		tuple, ok := to.(*Tuple)
+		if !ok || len(tuple.Elems) != len(literal.Elems) {
+			return false
+		}
+
+		types := c.elems[literal]
+		for i, elem := range literal.Elems {
+			c.info.Types[elem] = types[i]
+			if !c.assign(elem, types[i], tuple.Elems[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// VisitIndexExpression checks list[i], and map[k] which is nil when the map
+// has no key k.
+func (c *Checker) VisitIndexExpression(expr *ast.Index) interface{} {
+	objType := c.typeOf(expr.X)
+	index := expr.Index
+	indexType := c.typeOf(index)
+
+	switch obj := objType.(type) {
+	case *List:
+		// Indexing with a range slices the list
+		if indexType == Range {
+			return obj
+		}
+		if !c.assign(index, indexType, Int) {
+			errorf(index, "invalid index: %s value", indexType)
+		}
+		return obj.Elem
+	case *Map:
+		if !c.assign(index, indexType, obj.Key) {
+			errorf(index, "cannot use %s value as %s key", indexType, obj.Key)
+		}
+		return OptionalOf(obj.Value)
+	case *Struct:
+		if fn := c.overload(expr, "[]", obj, []ast.Expr{index}, []Type{indexType}); fn != nil {
+			return fn.Result
+		}
+	case *Optional:
+		errorf(expr, "cannot index %s value without a nil check", objType)
+	}
+
+	errorf(expr, "cannot index %s value", objType)
+
+	return nil
+}
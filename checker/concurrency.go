@@ -0,0 +1,69 @@
+package checker
+
+import "bo/ast"
+
+// VisitSpawnStatement checks a call run as a task of its own. Its result,
+// if any, is discarded.
+func (c *Checker) VisitSpawnStatement(stmt *ast.Spawn) interface{} {
+	return c.VisitFunctionCall(stmt.Call)
+}
+
+// VisitReceiveExpression checks <-ch, which is nil once ch is closed and
+// drained.
+func (c *Checker) VisitReceiveExpression(expr *ast.Receive) interface{} {
+	return OptionalOf(c.channel(expr.Chan).Elem)
+}
+
+func (c *Checker) VisitSendStatement(stmt *ast.Send) interface{} {
+	c.send(stmt.Chan, stmt.Value)
+
+	return nil
+}
+
+// send checks sending value on channel.
+func (c *Checker) send(channel, value ast.Expr) {
+	ch := c.channel(channel)
+	t := c.typeOf(value)
+	if !c.assign(value, t, ch.Elem) {
+		errorf(value, "cannot send %s value on %s", t, ch)
+	}
+}
+
+// channel checks an expression that must be a channel.
+func (c *Checker) channel(expr ast.Expr) *Chan {
+	t := c.typeOf(expr)
+	ch, ok := t.(*Chan)
+	if !ok {
+		errorf(expr, "%s is not a channel", t)
+	}
+	return ch
+}
+
+// VisitSelectStatement checks a select statement, which runs the arm of
+// whichever send or receive can go ahead first, or the default arm if none
+// can right away.
+func (c *Checker) VisitSelectStatement(stmt *ast.Select) interface{} {
+	defaults := 0
+	for _, arm := range stmt.Arms {
+		switch arm := arm.(type) {
+		case *ast.ReceiveArm:
+			ch := c.channel(arm.Chan)
+			c.block(func() {
+				if arm.Name != nil {
+					c.declare(arm, arm.Name.Name, OptionalOf(ch.Elem))
+				}
+				c.Visit(arm.Body)
+			})
+		case *ast.SendArm:
+			c.send(arm.Chan, arm.Value)
+			c.Visit(arm.Body)
+		case *ast.DefaultArm:
+			if defaults++; defaults > 1 {
+				errorf(arm, "multiple defaults in select")
+			}
+			c.Visit(arm.Body)
+		}
+	}
+
+	return nil
+}
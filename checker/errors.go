@@ -0,0 +1,21 @@
+package checker
+
+import (
+	"bo/ast"
+	"fmt"
+)
+
+type typeError struct {
+	line   int
+	column int
+	msg    string
+}
+
+func (e *typeError) Error() string {
+	return fmt.Sprintf("Type error at line %d:%d: %s", e.line, e.column, e.msg)
+}
+
+func errorf(node ast.Node, format string, args ...interface{}) {
+	pos := node.Pos()
+	panic(&typeError{line: pos.Line, column: pos.Column, msg: fmt.Sprintf(format, args...)})
+}
@@ -0,0 +1,305 @@
+package checker
+
+import (
+	"bo/ast"
+	"slices"
+)
+
+// VisitFunctionDeclaration declares a function, then checks its body with
+// the parameters in scope. The function is in scope in its own body, so it
+// may call itself. A function with a receiver is a method of a struct.
+func (c *Checker) VisitFunctionDeclaration(decl *ast.FuncDecl) interface{} {
+	fn := &Func{}
+	name := decl.Name.Name
+	for i, param := range decl.Params {
+		t := c.typeOf(param.Type)
+		switch {
+		case param.Variadic:
+			if i != len(decl.Params)-1 {
+				errorf(param, "variadic parameter %s must be last", param.Name.Name)
+			}
+			t = ListOf(t)
+			fn.Variadic = true
+		case param.Default != nil:
+			fn.Defaults++
+		case fn.Defaults > 0:
+			errorf(param, "parameter %s without a default value follows one with a default value", param.Name.Name)
+		}
+
+		fn.Params = append(fn.Params, t)
+		fn.Names = append(fn.Names, param.Name.Name)
+	}
+	if decl.Result != nil {
+		fn.Result = c.typeOf(decl.Result)
+	}
+
+	// A generator returns an iterator right away, whose values it yields
+	// when they are asked for
+	generator := containsYield(decl.Body)
+	if generator {
+		if _, ok := fn.Result.(*Iterator); !ok || decl.Async {
+			errorf(decl, "generator %s must return an Iterator", name)
+		}
+		c.info.Generators[decl] = true
+	}
+
+	// An async function returns a future of its result right away
+	declared := fn
+	if decl.Async {
+		async := *fn
+		async.Result = FutureOf(Nil)
+		if fn.Result != nil {
+			async.Result = FutureOf(fn.Result)
+		}
+		declared = &async
+	}
+
+	var receiver *Struct
+	if decl.Recv != nil {
+		receiver, _ = c.typeName(decl.Recv.Type).(*Struct)
+		switch {
+		case receiver == nil:
+			errorf(decl.Recv, "invalid receiver type %s", ast.String(decl.Recv.Type))
+		case decl.Operator:
+			c.declareOperator(decl, receiver, declared)
+		case receiver.Field(name) != nil || receiver.Methods[name] != nil:
+			errorf(decl, "%s already has a field or method %s", receiver, name)
+		default:
+			receiver.Methods[name] = declared
+		}
+	} else if decl.Operator {
+		errorf(decl, "operator %s must be a method", name)
+	} else {
+		c.declare(decl, name, declared)
+	}
+
+	function, isGenerator := c.function, c.generator
+	c.function, c.generator = fn, generator
+
+	c.block(func() {
+		// The body runs when the function is called, after the nil
+		// checks around its declaration may no longer hold
+		c.nonNil = make(map[string]bool)
+
+		if receiver != nil {
+			c.declare(decl.Recv, decl.Recv.Name.Name, receiver)
+		}
+
+		// Parameters are in the same block as the body's declarations.
+		// A default value may depend on the parameters before it
+		for i, param := range decl.Params {
+			if value := param.Default; value != nil {
+				t := c.typeOf(value)
+				if !c.assign(value, t, fn.Params[i]) {
+					errorf(value, "cannot use %s value as %s in default value of %s", t, fn.Params[i], fn.Names[i])
+				}
+			}
+			c.declare(param, fn.Names[i], fn.Params[i])
+		}
+		for _, stmt := range decl.Body.Stmts {
+			c.VisitStatement(stmt)
+		}
+	})
+
+	c.function, c.generator = function, isGenerator
+
+	if fn.Result != nil && !generator && !c.terminates(decl.Body.Stmts) {
+		errorf(decl.Body, "missing return at the end of %s", name)
+	}
+
+	return nil
+}
+
+// arguments checks the arguments of a call to fn and matches them with its
+// parameters: first by position, then by name.
+func (c *Checker) arguments(expr *ast.Call, fn *Func) *Call {
+	fixed := len(fn.Params)
+	if fn.Variadic {
+		fixed--
+	}
+
+	args := expr.Args
+	call := &Call{Args: make([]ast.Expr, fixed), Variadic: fn.Variadic}
+	named := false
+	for i, arg := range args {
+		p := i
+		switch {
+		case arg.Name != nil:
+			named = true
+			p = slices.Index(fn.Names, arg.Name.Name)
+			switch {
+			case p < 0:
+				errorf(arg, "unknown argument %s", arg.Name.Name)
+			case p == fixed:
+				errorf(arg, "cannot give variadic argument %s by name", arg.Name.Name)
+			case call.Args[p] != nil:
+				errorf(arg, "argument %s given twice", arg.Name.Name)
+			}
+		case named:
+			errorf(arg, "positional argument after named arguments")
+		case i >= fixed && !fn.Variadic:
+			errorf(expr, "wrong number of arguments: have %d, want %d", len(args), fixed)
+		}
+
+		param := fn.Params[min(p, len(fn.Params)-1)]
+		if p >= fixed {
+			param = param.(*List).Elem
+		}
+
+		argType := c.typeOf(arg.Value)
+		if !c.assign(arg.Value, argType, param) {
+			errorf(arg, "cannot use %s value as %s in argument %d", argType, param, i+1)
+		}
+
+		if p >= fixed {
+			call.Rest = append(call.Rest, arg.Value)
+		} else {
+			call.Args[p] = arg.Value
+			call.Order = append(call.Order, p)
+		}
+	}
+	if slices.IsSorted(call.Order) {
+		call.Order = nil
+	}
+
+	for p := 0; p < fixed-fn.Defaults; p++ {
+		if call.Args[p] == nil && fn.Names != nil {
+			errorf(expr, "missing argument %s", fn.Names[p])
+		} else if call.Args[p] == nil {
+			errorf(expr, "wrong number of arguments: have %d, want %d", len(args), fixed)
+		}
+	}
+
+	return call
+}
+
+// generic checks a call of a generic builtin, which takes no named
+// arguments.
+func (c *Checker) generic(call *ast.Call, g *Generic) Type {
+	var args []ast.Expr
+	var types []Type
+	for _, arg := range call.Args {
+		if arg.Name != nil {
+			errorf(arg, "unknown argument %s", arg.Name.Name)
+		}
+		args = append(args, arg.Value)
+		types = append(types, c.typeOf(arg.Value))
+	}
+
+	result, err := g.Check(types)
+	if err != nil {
+		errorf(call, "%s: %s", g, err)
+	}
+	c.info.Calls[call] = &Call{Rest: args, Variadic: true}
+
+	return result
+}
+
+// VisitReturnStatement checks the values a function returns against its
+// result type. A function with a tuple result may return its elements.
+func (c *Checker) VisitReturnStatement(ret *ast.Return) interface{} {
+	if c.function == nil {
+		errorf(ret, "return outside a function")
+	}
+
+	result := c.function.Result
+	exprs := ret.Results
+	switch {
+	case c.generator:
+		if len(exprs) > 0 {
+			errorf(ret, "a generator cannot return a value, it yields them")
+		}
+		return nil
+	case len(exprs) == 0:
+		if result != nil {
+			errorf(ret, "not enough return values: have none, want %s", result)
+		}
+		return nil
+	case result == nil:
+		errorf(ret, "too many return values: function has no result")
+	}
+
+	results := []Type{result}
+	if tuple, ok := result.(*Tuple); ok && len(exprs) > 1 {
+		results = tuple.Elems
+	}
+	if len(exprs) != len(results) {
+		errorf(ret, "wrong number of return values: have %d, want %d", len(exprs), len(results))
+	}
+
+	for i, expr := range exprs {
+		t := c.typeOf(expr)
+		if !c.assign(expr, t, results[i]) {
+			errorf(expr, "cannot use %s value as %s in return statement", t, results[i])
+		}
+	}
+
+	return nil
+}
+
+// VisitDeferStatement checks a call deferred until the function returns.
+func (c *Checker) VisitDeferStatement(stmt *ast.Defer) interface{} {
+	if c.function == nil {
+		errorf(stmt, "defer outside a function")
+	}
+
+	return c.VisitFunctionCall(stmt.Call)
+}
+
+// VisitVariableDeclaration declares one variable, or several that receive
+// the elements of a tuple, like the results of a function.
+func (c *Checker) VisitVariableDeclaration(decl *ast.VarDecl) interface{} {
+	var types []Type
+	for _, v := range decl.Vars {
+		types = append(types, c.typeOf(v.Type))
+	}
+	valueType := c.typeOf(decl.Value)
+
+	varType := types[0]
+	if len(types) > 1 {
+		tuple, ok := valueType.(*Tuple)
+		if !ok || len(tuple.Elems) != len(types) {
+			n := 1
+			if ok {
+				n = len(tuple.Elems)
+			}
+			errorf(decl, "assignment mismatch: %d variables but %s returns %d values", len(types), ast.String(decl.Value), n)
+		}
+		varType = TupleOf(types...)
+	}
+
+	if !c.assign(decl.Value, valueType, varType) {
+		errorf(decl.Value, "cannot use %s value as %s in declaration of %s", valueType, varType, decl.Vars[0].Name.Name)
+	}
+
+	for i, v := range decl.Vars {
+		c.declare(decl, v.Name.Name, types[i])
+	}
+
+	return nil
+}
+
+// terminates reports whether statements always end in a return statement.
+func (c *Checker) terminates(stmts []ast.Stmt) bool {
+	if len(stmts) == 0 {
+		return false
+	}
+
+	switch s := stmts[len(stmts)-1].(type) {
+	case *ast.Return:
+		return true
+	case *ast.Switch:
+		// Every value runs an arm and every arm returns
+		if !c.exhaustive[s] {
+			return false
+		}
+		for _, arm := range s.Arms {
+			if !c.terminates(arm.Body.Stmts) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,166 @@
+package checker
+
+import (
+	"bo/ast"
+	"fmt"
+)
+
+// iterators is the bo/iter module, whose members combine iterables lazily:
+//
+//	iter.take(a, n)         the first n values of a
+//	iter.zip(a, b, ...)     tuples of the values of a, b, ... until one ends
+//	iter.enumerate(a)       tuples of the index and the value of a's values
+//	iter.chain(a, b, ...)   the values of a, then those of b, ...
+//	iter.collect(a)         a list of the values of a, which must end
+var iterators = &Module{Path: "bo/iter", Members: map[string]Type{
+	"take": &Generic{Name: "iter.take", Check: func(args []Type) (Type, error) {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("wrong number of arguments: have %d, want 2", len(args))
+		}
+		elem, err := iterableArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		if args[1] != Int {
+			return nil, fmt.Errorf("cannot use %s value as int in argument 2", args[1])
+		}
+		return IteratorOf(elem), nil
+	}},
+	"zip": &Generic{Name: "iter.zip", Check: func(args []Type) (Type, error) {
+		if len(args) < 2 {
+			return nil, fmt.Errorf("not enough arguments: need two iterables")
+		}
+		var elems []Type
+		for _, arg := range args {
+			elem, err := iterableArg(arg)
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, elem)
+		}
+		return IteratorOf(TupleOf(elems...)), nil
+	}},
+	"enumerate": &Generic{Name: "iter.enumerate", Check: func(args []Type) (Type, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("wrong number of arguments: have %d, want 1", len(args))
+		}
+		elem, err := iterableArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return IteratorOf(TupleOf(Int, elem)), nil
+	}},
+	"chain": &Generic{Name: "iter.chain", Check: func(args []Type) (Type, error) {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("not enough arguments: need an iterable")
+		}
+		var elem Type
+		for _, arg := range args {
+			e, err := iterableArg(arg)
+			switch {
+			case err != nil:
+				return nil, err
+			case elem != nil && e != elem:
+				return nil, fmt.Errorf("mismatched values: %s and %s", elem, e)
+			}
+			elem = e
+		}
+		return IteratorOf(elem), nil
+	}},
+	"collect": &Generic{Name: "iter.collect", Check: func(args []Type) (Type, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("wrong number of arguments: have %d, want 1", len(args))
+		}
+		elem, err := iterableArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return ListOf(elem), nil
+	}},
+}}
+
+func iterableArg(t Type) (Type, error) {
+	elem := iterable(t)
+	if elem == nil {
+		return nil, fmt.Errorf("%s is not iterable", t)
+	}
+	return elem, nil
+}
+
+// iterable returns the type of the values a for loop over a value of type t
+// goes through, or nil if it cannot go through them. A range's values are
+// ints, a map's are tuples of a key and its value, and a struct is iterable
+// if it has an iter method returning an iterator.
+func iterable(t Type) Type {
+	if t == Range {
+		return Int
+	}
+
+	switch t := t.(type) {
+	case *List:
+		return t.Elem
+	case *Map:
+		return TupleOf(t.Key, t.Value)
+	case *Iterator:
+		return t.Elem
+	case *Struct:
+		if method, ok := t.Methods["iter"]; ok && len(method.Params) == 0 {
+			if it, ok := method.Result.(*Iterator); ok {
+				return it.Elem
+			}
+		}
+	}
+	return nil
+}
+
+// containsYield reports whether a function body yields values, which makes
+// the function a generator. Yields in the functions it declares are theirs.
+func containsYield(body *ast.Block) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node.(type) {
+		case *ast.Yield:
+			found = true
+		case *ast.FuncDecl:
+			return false
+		}
+		return !found
+	})
+	return found
+}
+
+// VisitYieldStatement checks a value a generator yields against the values
+// of the iterator it returns.
+func (c *Checker) VisitYieldStatement(stmt *ast.Yield) interface{} {
+	if !c.generator {
+		errorf(stmt, "yield outside a generator")
+	}
+
+	elem := c.function.Result.(*Iterator).Elem
+	t := c.typeOf(stmt.Value)
+	if !c.assign(stmt.Value, t, elem) {
+		errorf(stmt.Value, "cannot yield %s value in %s", t, c.function.Result)
+	}
+
+	return nil
+}
+
+// VisitForStatement checks a for loop, whose pattern must match every value
+// it goes through.
+func (c *Checker) VisitForStatement(stmt *ast.For) interface{} {
+	t := c.typeOf(stmt.X)
+	elem := iterable(t)
+	if elem == nil {
+		errorf(stmt.X, "cannot range over %s value", t)
+	}
+	if !irrefutable([]ast.Pattern{stmt.Pattern}) {
+		errorf(stmt.Pattern, "pattern %s does not match every %s value", ast.String(stmt.Pattern), elem)
+	}
+
+	c.block(func() {
+		c.bindPattern(stmt.Pattern, elem)
+		c.Visit(stmt.Body)
+	})
+
+	return nil
+}
@@ -0,0 +1,515 @@
+package checker
+
+import (
+	"bo/ast"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// VisitMatchExpression checks a match expression, whose value is that of
+// the first arm whose pattern and guard match. Every value of the matched
+// type must be matched by some arm.
+func (c *Checker) VisitMatchExpression(expr *ast.Match) interface{} {
+	subject := c.typeOf(expr.X)
+
+	var patterns []ast.Pattern
+	var guards []ast.Expr
+	var results []ast.Expr
+	var types []Type
+	afterNil := false
+	for _, arm := range expr.Arms {
+		c.arm(arm.Pattern, arm.Guard, subject, c.armNilChecks(expr.X, arm.Pattern, afterNil), func() {
+			types = append(types, c.typeOf(arm.Value))
+		})
+		afterNil = afterNil || matchesNil(arm.Pattern, arm.Guard)
+
+		patterns = append(patterns, arm.Pattern)
+		guards = append(guards, arm.Guard)
+		results = append(results, arm.Value)
+	}
+
+	c.checkArms(expr, subject, patterns, guards, true)
+
+	return c.unify(expr, results, types, "match arms")
+}
+
+// VisitSwitchStatement checks a switch statement, which runs the block of
+// the first arm whose pattern and guard match. A switch on an enum must
+// handle each of its cases.
+func (c *Checker) VisitSwitchStatement(stmt *ast.Switch) interface{} {
+	subject := c.typeOf(stmt.X)
+
+	var patterns []ast.Pattern
+	var guards []ast.Expr
+	afterNil := false
+	for _, arm := range stmt.Arms {
+		c.arm(arm.Pattern, arm.Guard, subject, c.armNilChecks(stmt.X, arm.Pattern, afterNil), func() {
+			c.Visit(arm.Body)
+		})
+		afterNil = afterNil || matchesNil(arm.Pattern, arm.Guard)
+
+		patterns = append(patterns, arm.Pattern)
+		guards = append(guards, arm.Guard)
+	}
+
+	elem := subject
+	if optional, ok := subject.(*Optional); ok {
+		elem = optional.Elem
+	}
+	_, isEnum := elem.(*Enum)
+	c.exhaustive[stmt] = c.checkArms(stmt, subject, patterns, guards, isEnum)
+
+	return nil
+}
+
+// arm checks the pattern and guard of an arm against a value of type
+// subject, then the body of the arm with the variables the pattern binds in
+// scope and the nil checks of the guard holding. The variables nonNil
+// names are known not to be nil in the guard and the body.
+func (c *Checker) arm(pattern ast.Pattern, guard ast.Expr, subject Type, nonNil []string, body func()) {
+	c.block(func() {
+		c.bindPattern(pattern, subject)
+
+		c.withNonNil(nonNil, func() {
+			if guard == nil {
+				body()
+				return
+			}
+
+			if t := c.typeOf(guard); t != Bool {
+				errorf(guard, "non-boolean guard: %s value", t)
+			}
+			c.withNonNil(c.nilChecks(guard, true), body)
+		})
+	})
+}
+
+// armNilChecks returns the optional variables known not to be nil in an
+// arm of a match or switch on subject: those the nil checks of a boolean
+// subject prove when the arm matches true or false, and, once an arm has
+// matched nil, the subject and the variable that binds it.
+func (c *Checker) armNilChecks(subject ast.Expr, pattern ast.Pattern, afterNil bool) []string {
+	if p, ok := pattern.(*ast.LiteralPattern); ok {
+		if lit, ok := p.Value.(*ast.BasicLit); ok && lit.Kind == ast.Bool {
+			return c.nilChecks(subject, lit.Value == "true")
+		}
+	}
+	if !afterNil {
+		return nil
+	}
+
+	var names []string
+	if name := variable(ast.Unparen(subject)); name != "" {
+		names = append(names, name)
+	}
+	if p, ok := pattern.(*ast.BindingPattern); ok {
+		names = append(names, p.Name.Name)
+	}
+	return names
+}
+
+// matchesNil reports whether an arm matches every nil value.
+func matchesNil(pattern ast.Pattern, guard ast.Expr) bool {
+	p, ok := pattern.(*ast.LiteralPattern)
+	return ok && guard == nil && isNilPattern(p)
+}
+
+// bindPattern checks that pattern can match values of type t and declares
+// the variables it binds. Patterns other than nil match the element of an
+// optional type, bindings and wildcards match nil too.
+func (c *Checker) bindPattern(pattern ast.Pattern, t Type) {
+	elem := t
+	if optional, ok := t.(*Optional); ok {
+		elem = optional.Elem
+	}
+
+	switch p := pattern.(type) {
+	case *ast.WildcardPattern:
+	case *ast.BindingPattern:
+		c.declare(p, p.Name.Name, t)
+	case *ast.LiteralPattern:
+		c.info.Types[p] = elem
+
+		var ok bool
+		switch lit := p.Value.(type) {
+		case *ast.StringLit:
+			if len(lit.Parts) > 1 || len(lit.Parts) == 1 && lit.Parts[0].Expr != nil {
+				errorf(p, "cannot interpolate in a pattern")
+			}
+			ok = elem == String
+		case *ast.BasicLit:
+			switch lit.Kind {
+			case ast.Nil:
+				ok = elem != t
+			case ast.Int, ast.Float:
+				ok = representable(c.patternValue(p), elem)
+			case ast.BigInt:
+				ok = elem == BigInt
+			case ast.Decimal:
+				ok = elem == Decimal
+			case ast.Bool:
+				ok = elem == Bool
+			}
+		}
+
+		if !ok {
+			errorf(p, "cannot match %s against %s value", ast.String(p), t)
+		}
+	case *ast.CasePattern:
+		enum, ok := elem.(*Enum)
+		if !ok {
+			errorf(p, "cannot match %s against %s value", ast.String(p), t)
+		}
+
+		if p.Enum != nil {
+			if name, ok := c.symbolTable[p.Enum.Name].(*TypeName); !ok || name.Type != enum {
+				errorf(p, "cannot match %s against %s value", ast.String(p), t)
+			}
+		}
+
+		name := p.Case.Name
+		enumCase := enum.Case(name)
+		if enumCase == nil {
+			errorf(p, "%s has no case %s", enum, name)
+		}
+
+		// Without parentheses the values of the case are not matched
+		fields := p.Fields
+		if p.Parens && len(fields) != len(enumCase.Fields) {
+			errorf(p, "wrong number of values in pattern: have %d, want %d", len(fields), len(enumCase.Fields))
+		}
+		for i, field := range fields {
+			c.bindPattern(field, enumCase.Fields[i])
+		}
+	case *ast.ListPattern:
+		list, ok := elem.(*List)
+		if !ok {
+			errorf(p, "cannot match %s against %s value", ast.String(p), t)
+		}
+
+		elems := p.Elems
+		for i, e := range elems {
+			if rest, ok := e.(*ast.RestPattern); ok {
+				if i != len(elems)-1 {
+					errorf(rest, "rest pattern must be last")
+				}
+				if rest.Name != nil {
+					c.declare(rest, rest.Name.Name, list)
+				}
+				continue
+			}
+			c.bindPattern(e, list.Elem)
+		}
+	case *ast.RestPattern:
+		errorf(p, "rest pattern outside a list pattern")
+	case *ast.MapPattern:
+		m, ok := elem.(*Map)
+		if !ok {
+			errorf(p, "cannot match %s against %s value", ast.String(p), t)
+		}
+
+		// Keys are looked up, so they must be literals; an entry matches
+		// nil when the map has no such key
+		keys := make(map[string]bool)
+		for _, entry := range p.Entries {
+			key, ok := entry.Key.(*ast.LiteralPattern)
+			if !ok || isNilPattern(key) {
+				errorf(entry.Key, "map pattern keys must be literals")
+			}
+			c.bindPattern(key, m.Key)
+			if k := c.literalKey(key); keys[k] {
+				errorf(key, "duplicate key %s in map pattern", ast.String(key))
+			} else {
+				keys[k] = true
+			}
+			c.bindPattern(entry.Value, OptionalOf(m.Value))
+		}
+	case *ast.TuplePattern:
+		tuple, ok := elem.(*Tuple)
+		if !ok {
+			errorf(p, "cannot match %s against %s value", ast.String(p), t)
+		}
+
+		elems := p.Elems
+		if len(elems) != len(tuple.Elems) {
+			errorf(p, "wrong number of values in pattern: have %d, want %d", len(elems), len(tuple.Elems))
+		}
+		for i, e := range elems {
+			c.bindPattern(e, tuple.Elems[i])
+		}
+	case *ast.StructPattern:
+		st, ok := elem.(*Struct)
+		if name, isName := c.symbolTable[p.Type.Name].(*TypeName); !ok || !isName || name.Type != st {
+			errorf(p, "cannot match %s against %s value", ast.String(p), t)
+		}
+
+		for _, fp := range p.Fields {
+			name := fp.Name.Name
+			field := st.Field(name)
+			if field == nil {
+				errorf(fp, "%s has no field %s", st, name)
+			}
+			if fp.Pattern == nil {
+				c.declare(fp, name, field.Type)
+				continue
+			}
+			c.bindPattern(fp.Pattern, field.Type)
+		}
+	}
+}
+
+// patternValue returns the value of a numeric literal pattern, as for
+// constant.
+func (c *Checker) patternValue(p *ast.LiteralPattern) interface{} {
+	lit := p.Value.(*ast.BasicLit)
+
+	var value interface{}
+	switch lit.Kind {
+	case ast.Int, ast.BigInt:
+		value = ast.BigIntLiteral(lit.Value)
+	case ast.Float:
+		f, err := ast.FloatLiteral(lit.Value)
+		if err != nil {
+			errorf(p, "%s", err)
+		}
+		value = f
+	}
+
+	if p.Neg {
+		value = negate(value)
+	}
+	return value
+}
+
+func negate(value interface{}) interface{} {
+	switch value := value.(type) {
+	case float64:
+		return -value
+	default:
+		return new(big.Int).Neg(value.(*big.Int))
+	}
+}
+
+// checkArms reports the arms that earlier arms make unreachable and, when
+// exhaustive is set, the values of type t that no arm matches. An arm with
+// a guard matches nothing for sure. It returns whether the arms match every
+// value.
+func (c *Checker) checkArms(node ast.Node, t Type, patterns []ast.Pattern, guards []ast.Expr, exhaustive bool) bool {
+	elem := t
+	if optional, ok := t.(*Optional); ok {
+		elem = optional.Elem
+	}
+	enum, _ := elem.(*Enum)
+
+	// Enum cases and literals matched by earlier arms, every value but nil,
+	// or every value
+	cases := make(map[string]bool)
+	literals := make(map[string]bool)
+	values := false
+	all := false
+
+	// Lengths of lists that earlier arms match whatever their elements
+	lengths := make(map[int]bool)
+
+	for i, pattern := range patterns {
+		guarded := guards[i] != nil
+
+		switch p := pattern.(type) {
+		case *ast.CasePattern:
+			name := p.Case.Name
+			if all || values || cases[name] {
+				errorf(p, "unreachable pattern: %s", ast.String(p))
+			}
+			if !guarded && irrefutable(p.Fields) {
+				cases[name] = true
+			}
+		case *ast.LiteralPattern:
+			key := c.literalKey(p)
+			if all || values && !isNilPattern(p) || literals[key] {
+				errorf(p, "unreachable pattern: %s", ast.String(p))
+			}
+			if !guarded {
+				literals[key] = true
+			}
+		case *ast.ListPattern:
+			if all || values {
+				errorf(p, "unreachable pattern: %s", ast.String(p))
+			}
+			elems := p.Elems
+			if guarded || !irrefutable(elems) {
+				break
+			}
+
+			// A rest pattern matches the lengths from those before it on
+			if rest := len(elems) - 1; rest >= 0 && isRest(elems[rest]) {
+				values = true
+				for n := 0; n < rest; n++ {
+					values = values && lengths[n]
+				}
+			} else if lengths[len(elems)] {
+				errorf(p, "unreachable pattern: %s", ast.String(p))
+			} else {
+				lengths[len(elems)] = true
+			}
+		case *ast.WildcardPattern, *ast.BindingPattern:
+			if all {
+				errorf(pattern, "unreachable pattern: %s", ast.String(pattern))
+			}
+			if !guarded {
+				all = true
+			}
+		default:
+			if all || values {
+				errorf(pattern, "unreachable pattern: %s", ast.String(pattern))
+			}
+			if !guarded && irrefutable([]ast.Pattern{pattern}) {
+				values = true
+			}
+		}
+
+		covered := values || enum != nil && len(cases) == len(enum.Cases) || elem == Bool && literals["true"] && literals["false"]
+		if covered && (elem == t || literals["nil"]) {
+			all = true
+		}
+	}
+
+	if !exhaustive || all {
+		return all
+	}
+
+	var missing []string
+	if enum != nil && !values {
+		for _, enumCase := range enum.Cases {
+			if !cases[enumCase.Name] {
+				missing = append(missing, enum.Name+"."+enumCase.Name)
+			}
+		}
+	}
+	if elem == Bool && !values {
+		for _, b := range []string{"true", "false"} {
+			if !literals[b] {
+				missing = append(missing, b)
+			}
+		}
+	}
+	if elem != t && !literals["nil"] {
+		missing = append(missing, "nil")
+	}
+
+	if len(missing) == 0 || enum == nil && elem != Bool && !values {
+		errorf(node, "non-exhaustive match on %s: add a _ arm", t)
+	}
+	errorf(node, "non-exhaustive match on %s: missing %s", t, strings.Join(missing, ", "))
+
+	return false
+}
+
+func isRest(pattern ast.Pattern) bool {
+	_, ok := pattern.(*ast.RestPattern)
+	return ok
+}
+
+func isNilPattern(p *ast.LiteralPattern) bool {
+	lit, ok := p.Value.(*ast.BasicLit)
+	return ok && lit.Kind == ast.Nil
+}
+
+// irrefutable reports whether patterns match any values other than nil.
+func irrefutable(patterns []ast.Pattern) bool {
+	for _, pattern := range patterns {
+		switch p := pattern.(type) {
+		case *ast.WildcardPattern, *ast.BindingPattern, *ast.RestPattern:
+		case *ast.ListPattern:
+			// Only [...rest] matches lists of any length
+			elems := p.Elems
+			if len(elems) != 1 || !isRest(elems[0]) {
+				return false
+			}
+		case *ast.MapPattern:
+			// Values of missing keys are nil, which only bindings match
+			for _, entry := range p.Entries {
+				switch entry.Value.(type) {
+				case *ast.WildcardPattern, *ast.BindingPattern:
+				default:
+					return false
+				}
+			}
+		case *ast.TuplePattern:
+			if !irrefutable(p.Elems) {
+				return false
+			}
+		case *ast.StructPattern:
+			for _, field := range p.Fields {
+				if field.Pattern != nil && !irrefutable([]ast.Pattern{field.Pattern}) {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// literalKey returns a key that is the same for literal patterns matching
+// the same value.
+func (c *Checker) literalKey(p *ast.LiteralPattern) string {
+	switch lit := p.Value.(type) {
+	case *ast.StringLit:
+		var text string
+		for _, part := range lit.Parts {
+			text += part.Text
+		}
+		return strconv.Quote(text)
+	case *ast.BasicLit:
+		switch lit.Kind {
+		case ast.Nil, ast.Bool, ast.Decimal:
+			return ast.String(p)
+		}
+	}
+
+	switch value := c.patternValue(p).(type) {
+	case float64:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	default:
+		return value.(*big.Int).String()
+	}
+}
+
+// unify returns the type that each of exprs, of the given types, can be
+// used as, and records their implicit conversions. Constants adapt to the
+// other expressions and nil makes the type optional.
+func (c *Checker) unify(node ast.Node, exprs []ast.Expr, types []Type, what string) Type {
+	var candidates []Type
+	for _, constants := range []bool{false, true} {
+		for i, t := range types {
+			if _, ok := c.constant(exprs[i]); ok == constants && t != Nil {
+				candidates = append(candidates, t, OptionalOf(t))
+			}
+		}
+	}
+	candidates = append(candidates, Nil)
+
+	for _, candidate := range candidates {
+		if c.assignAll(exprs, types, candidate) {
+			return candidate
+		}
+	}
+
+	errorf(node, "mismatched types in %s: %s", what, typeList(types))
+
+	return nil
+}
+
+// assignAll reports whether each of exprs can be used as a value of type to.
+func (c *Checker) assignAll(exprs []ast.Expr, types []Type, to Type) bool {
+	for i, expr := range exprs {
+		// Undo the conversion an earlier candidate recorded
+		c.info.Types[expr] = types[i]
+		if !c.assign(expr, types[i], to) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package checker
+
+// builtins are the functions and types in scope in every program.
+var builtins = map[string]Type{
+	// any is a name rather than a keyword, so that Future.any can be called
+	"any": &TypeName{Type: Any},
+
+	"Range": &TypeName{Type: Range},
+
+	// Future is a keyword, so that Future[T] is a type, and a module
+	"Future": futures,
+
+	// println prints each of its arguments on a line of its own
+	"println": &Func{Params: []Type{ListOf(Any)}, Names: []string{"values"}, Variadic: true},
+}
+
+// WaitGroup waits for a number of tasks to be done, like Go's
+// sync.WaitGroup.
+var WaitGroup = &Opaque{Name: "sync.WaitGroup", Methods: map[string]Type{
+	"add":  &Func{Params: []Type{Int}, Names: []string{"n"}, Defaults: 1},
+	"done": &Func{},
+	"wait": &Func{},
+}}
+
+// Mutex is a lock held by one task at a time, like Go's sync.Mutex.
+var Mutex = &Opaque{Name: "sync.Mutex", Methods: map[string]Type{
+	"lock":   &Func{},
+	"unlock": &Func{},
+}}
+
+func init() {
+	WaitGroup.New = &Func{Result: WaitGroup}
+	Mutex.New = &Func{Result: Mutex}
+}
+
+// stdModules describes the standard library modules a require statement can
+// bind, by import path. The runner provides their implementations.
+var stdModules = map[string]*Module{
+	"bo/math/big": {
+		Path: "bo/math/big",
+		Members: map[string]Type{
+			"pow":    &Func{Params: []Type{BigInt, Int}, Result: BigInt},
+			"modpow": &Func{Params: []Type{BigInt, BigInt, BigInt}, Result: BigInt},
+			"mod":    &Func{Params: []Type{BigInt, BigInt}, Result: BigInt},
+			"gcd":    &Func{Params: []Type{BigInt, BigInt}, Result: BigInt},
+			"abs":    &Func{Params: []Type{BigInt}, Result: BigInt},
+			"round": &Func{
+				Params:   []Type{Decimal, Int, Int},
+				Result:   Decimal,
+				Names:    []string{"d", "scale", "mode"},
+				Defaults: 2,
+			},
+			"div": &Func{
+				Params:   []Type{Decimal, Decimal, Int, Int},
+				Result:   Decimal,
+				Names:    []string{"x", "y", "scale", "mode"},
+				Defaults: 1,
+			},
+
+			// Rounding modes for round and div
+			"HalfEven": Int,
+			"HalfUp":   Int,
+			"HalfDown": Int,
+			"Up":       Int,
+			"Down":     Int,
+			"Ceiling":  Int,
+			"Floor":    Int,
+		},
+	},
+	"bo/iter": iterators,
+	"bo/sync": {
+		Path: "bo/sync",
+		Members: map[string]Type{
+			"WaitGroup": &TypeName{Type: WaitGroup},
+			"Mutex":     &TypeName{Type: Mutex},
+		},
+	},
+}
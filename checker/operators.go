@@ -0,0 +1,152 @@
+package checker
+
+import (
+	"bo/ast"
+	"slices"
+)
+
+// Operator is an overload of an operator for the values of a struct, like
+// func (Vec a) + (Vec b) Vec.
+type Operator struct {
+	Func *Func
+	Decl *ast.FuncDecl
+}
+
+// Overload is the operator method an expression calls. Swap passes the
+// operands the other way round and Negate negates the result, which derives
+// >, <= and >= from <, and != from ==.
+type Overload struct {
+	Decl         *ast.FuncDecl
+	Swap, Negate bool
+}
+
+// declareOperator checks an operator method of s and adds it to the
+// overloads of its operator, which must take different parameters.
+func (c *Checker) declareOperator(decl *ast.FuncDecl, s *Struct, fn *Func) {
+	op := decl.Name.Name
+
+	var params []int
+	result := fn.Result
+	switch op {
+	case "-":
+		params = []int{0, 1}
+	case "string":
+		params, result = []int{0}, String
+	case "==", "<":
+		params, result = []int{1}, Bool
+	default:
+		params = []int{1}
+	}
+
+	switch {
+	case decl.Async || c.info.Generators[decl]:
+		errorf(decl, "operator %s cannot be async or a generator", op)
+	case fn.Defaults > 0 || fn.Variadic:
+		errorf(decl, "operator %s cannot have default values or variadic parameters", op)
+	case !slices.Contains(params, len(fn.Params)):
+		errorf(decl, "wrong number of parameters for operator %s: have %d", op, len(fn.Params))
+	case fn.Result == nil || fn.Result != result:
+		errorf(decl, "operator %s must return %s", op, resultName(result))
+	}
+
+	for _, other := range s.Operators[op] {
+		if slices.Equal(other.Func.Params, fn.Params) {
+			errorf(decl, "%s already has operator %s for (%s)", s, op, typeList(fn.Params))
+		}
+	}
+	s.Operators[op] = append(s.Operators[op], &Operator{Func: fn, Decl: decl})
+}
+
+func resultName(t Type) string {
+	if t == nil {
+		return "a value"
+	}
+	return t.String()
+}
+
+// overload resolves the overload of op that a value of type recv calls
+// with args, and records it for ctx. An argument of the exact parameter
+// type picks an overload over one the argument converts to. It returns nil
+// if recv has no overloads of op, so that the operator keeps its built-in
+// meaning.
+func (c *Checker) overload(expr ast.Expr, op string, recv Type, args []ast.Expr, types []Type) *Func {
+	s, ok := recv.(*Struct)
+	if !ok || len(s.Operators[op]) == 0 {
+		return nil
+	}
+
+	var exact, assignable []*Operator
+	for _, candidate := range s.Operators[op] {
+		if len(candidate.Func.Params) != len(args) {
+			continue
+		}
+		if slices.Equal(candidate.Func.Params, types) {
+			exact = append(exact, candidate)
+		} else if c.assignAllTo(args, types, candidate.Func.Params) {
+			assignable = append(assignable, candidate)
+		}
+	}
+
+	var chosen *Operator
+	switch {
+	case len(exact) == 1:
+		chosen = exact[0]
+	case len(assignable) == 1:
+		chosen = assignable[0]
+	case len(assignable) > 1:
+		errorf(expr, "ambiguous operator %s of %s for (%s)", op, s, typeList(types))
+	default:
+		errorf(expr, "%s has no operator %s for (%s)", s, op, typeList(types))
+	}
+
+	// Record the conversions of the chosen overload's arguments
+	c.assignAllTo(args, types, chosen.Func.Params)
+	c.info.Operators[expr] = &Overload{Decl: chosen.Decl}
+
+	return chosen.Func
+}
+
+// assignAllTo reports whether each of args may be used as a value of the
+// parameter type at its position.
+func (c *Checker) assignAllTo(args []ast.Expr, types, params []Type) bool {
+	for i, arg := range args {
+		// Undo the conversion an earlier candidate recorded
+		c.info.Types[arg] = types[i]
+		if !c.assign(arg, types[i], params[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// binaryOverload resolves the operator method a binary expression calls,
+// if its operands are values of a struct with one. Comparisons other than
+// < call < with the operands swapped and the result negated as needed, !=
+// negates ==.
+func (c *Checker) binaryOverload(expr *ast.Binary, left, right Type) Type {
+	op, x, y := expr.Op, expr.X, expr.Y
+
+	var swap, negate bool
+	switch op {
+	case ">":
+		op, swap = "<", true
+	case "<=":
+		op, swap, negate = "<", true, true
+	case ">=":
+		op, negate = "<", true
+	case "!=":
+		op, negate = "==", true
+	}
+
+	if swap {
+		y, left, right = x, right, left
+	}
+	fn := c.overload(expr, op, left, []ast.Expr{y}, []Type{right})
+	if fn == nil {
+		return nil
+	}
+
+	overload := c.info.Operators[expr]
+	overload.Swap, overload.Negate = swap, negate
+	return fn.Result
+}
@@ -0,0 +1,39 @@
+package checker
+
+import "bo/ast"
+
+// Range is the type of ranges of ints, like 0..10, 0..<10 or 10..0 step 2.
+// Their values are computed as they are asked for.
+var Range = &Opaque{Name: "Range", Methods: map[string]Type{
+	"contains": &Func{Params: []Type{Int}, Names: []string{"x"}, Result: Bool},
+	"len":      &Func{Result: Int},
+}}
+
+func init() {
+	Range.Methods["reverse"] = &Func{Result: Range}
+}
+
+// VisitRangeExpression checks a..b, which goes from a up to b, and a..<b,
+// which stops before b.
+func (c *Checker) VisitRangeExpression(expr *ast.Range) interface{} {
+	for _, bound := range []ast.Expr{expr.From, expr.To} {
+		if t := c.typeOf(bound); !c.assign(bound, t, Int) {
+			errorf(bound, "cannot use %s value as int range bound", t)
+		}
+	}
+
+	return Range
+}
+
+// VisitStepExpression checks r step n, which takes every nth value of r,
+// going backwards from its end if n is negative.
+func (c *Checker) VisitStepExpression(expr *ast.Step) interface{} {
+	if t := c.typeOf(expr.X); t != Range {
+		errorf(expr.X, "cannot step through %s value", t)
+	}
+	if t := c.typeOf(expr.Step); !c.assign(expr.Step, t, Int) {
+		errorf(expr.Step, "cannot use %s value as int step", t)
+	}
+
+	return Range
+}
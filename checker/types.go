@@ -0,0 +1,482 @@
+package checker
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// Type is the static type of a Bo expression.
+type Type interface {
+	String() string
+}
+
+// BasicKind identifies a builtin scalar type.
+type BasicKind int
+
+const (
+	IntKind BasicKind = iota
+	Int8Kind
+	Int16Kind
+	Int32Kind
+	Int64Kind
+	Uint8Kind
+	Uint16Kind
+	Uint32Kind
+	Uint64Kind
+	FloatKind
+	Float32Kind
+	BigIntKind
+	DecimalKind
+	StringKind
+	BoolKind
+	NilKind // the type of the nil literal, assignable to any optional
+	AnyKind // holds a value of any type
+)
+
+// Basic is one of the builtin scalar types.
+type Basic struct {
+	name string
+	kind BasicKind
+	bits int
+}
+
+func (b *Basic) String() string {
+	return b.name
+}
+
+func (b *Basic) Kind() BasicKind {
+	return b.kind
+}
+
+// Bits returns the size of a numeric type in bits.
+func (b *Basic) Bits() int {
+	return b.bits
+}
+
+func (b *Basic) IsInteger() bool {
+	return b.kind <= Uint64Kind
+}
+
+func (b *Basic) IsUnsigned() bool {
+	return b.kind >= Uint8Kind && b.kind <= Uint64Kind
+}
+
+func (b *Basic) IsFloat() bool {
+	return b.kind == FloatKind || b.kind == Float32Kind
+}
+
+// IsBig reports whether b is one of the arbitrary-precision types.
+func (b *Basic) IsBig() bool {
+	return b.kind == BigIntKind || b.kind == DecimalKind
+}
+
+func (b *Basic) IsNumeric() bool {
+	return b.IsInteger() || b.IsFloat() || b.IsBig()
+}
+
+var (
+	Int     = &Basic{name: "int", kind: IntKind, bits: 64}
+	Int8    = &Basic{name: "int8", kind: Int8Kind, bits: 8}
+	Int16   = &Basic{name: "int16", kind: Int16Kind, bits: 16}
+	Int32   = &Basic{name: "int32", kind: Int32Kind, bits: 32}
+	Int64   = &Basic{name: "int64", kind: Int64Kind, bits: 64}
+	Uint8   = &Basic{name: "uint8", kind: Uint8Kind, bits: 8}
+	Uint16  = &Basic{name: "uint16", kind: Uint16Kind, bits: 16}
+	Uint32  = &Basic{name: "uint32", kind: Uint32Kind, bits: 32}
+	Uint64  = &Basic{name: "uint64", kind: Uint64Kind, bits: 64}
+	Float   = &Basic{name: "float", kind: FloatKind, bits: 64}
+	Float32 = &Basic{name: "float32", kind: Float32Kind, bits: 32}
+	BigInt  = &Basic{name: "bigint", kind: BigIntKind}
+	Decimal = &Basic{name: "decimal", kind: DecimalKind}
+	String  = &Basic{name: "string", kind: StringKind}
+	Bool    = &Basic{name: "bool", kind: BoolKind}
+	Nil     = &Basic{name: "nil", kind: NilKind}
+	Any     = &Basic{name: "any", kind: AnyKind}
+)
+
+var basicTypes = map[string]Type{
+	"int":     Int,
+	"int8":    Int8,
+	"int16":   Int16,
+	"int32":   Int32,
+	"int64":   Int64,
+	"uint8":   Uint8,
+	"uint16":  Uint16,
+	"uint32":  Uint32,
+	"uint64":  Uint64,
+	"float":   Float,
+	"float32": Float32,
+	"bigint":  BigInt,
+	"decimal": Decimal,
+	"string":  String,
+	"bool":    Bool,
+
+	// Aliases, as in Go
+	"byte": Uint8,
+	"char": Int32,
+	"rune": Int32,
+}
+
+func isNumeric(t Type) bool {
+	b, ok := t.(*Basic)
+	return ok && b.IsNumeric()
+}
+
+func isInteger(t Type) bool {
+	b, ok := t.(*Basic)
+	return ok && b.IsInteger()
+}
+
+func isFloat(t Type) bool {
+	b, ok := t.(*Basic)
+	return ok && b.IsFloat()
+}
+
+func isUnsigned(t Type) bool {
+	b, ok := t.(*Basic)
+	return ok && b.IsUnsigned()
+}
+
+// Optional, list, map and tuple types are unique per element types, so like
+// the other types they compare with ==.
+var composites = struct {
+	sync.Mutex
+	types map[string]Type
+}{types: make(map[string]Type)}
+
+// composite returns the type t built from elems, or the equal type that was
+// built before.
+func composite(t Type, elems ...Type) Type {
+	key := fmt.Sprintf("%T", t)
+	for _, elem := range elems {
+		key += fmt.Sprintf(",%p", elem)
+	}
+
+	composites.Lock()
+	defer composites.Unlock()
+
+	if prev, ok := composites.types[key]; ok {
+		return prev
+	}
+	composites.types[key] = t
+	return t
+}
+
+// Optional is the type T? of values of type T or nil.
+type Optional struct {
+	Elem Type
+}
+
+func (o *Optional) String() string {
+	return o.Elem.String() + "?"
+}
+
+// OptionalOf returns the optional type of elem. Optionals do not nest: nil
+// and optional types are their own optional.
+func OptionalOf(elem Type) Type {
+	if _, ok := elem.(*Optional); ok || elem == Nil {
+		return elem
+	}
+	return composite(&Optional{Elem: elem}, elem)
+}
+
+// List is the type []T of immutable sequences of values of type T.
+type List struct {
+	Elem Type
+}
+
+func (l *List) String() string {
+	return "[]" + l.Elem.String()
+}
+
+func ListOf(elem Type) Type {
+	return composite(&List{Elem: elem}, elem)
+}
+
+// Map is the type map[K]V of immutable maps from keys of type K, which must
+// be a basic type, to values of type V.
+type Map struct {
+	Key, Value Type
+}
+
+func (m *Map) String() string {
+	return "map[" + m.Key.String() + "]" + m.Value.String()
+}
+
+func MapOf(key, value Type) Type {
+	return composite(&Map{Key: key, Value: value}, key, value)
+}
+
+// Chan is the type chan[T] of channels that pass values of type T between
+// tasks.
+type Chan struct {
+	Elem Type
+}
+
+func (c *Chan) String() string {
+	return "chan[" + c.Elem.String() + "]"
+}
+
+func ChanOf(elem Type) Type {
+	return composite(&Chan{Elem: elem}, elem)
+}
+
+// Future is the type Future[T] of the value of type T an async function
+// call or a combination of futures will have. A future whose function
+// returns no value is a Future[nil].
+type Future struct {
+	Elem Type
+}
+
+func (f *Future) String() string {
+	return "Future[" + f.Elem.String() + "]"
+}
+
+func FutureOf(elem Type) Type {
+	return composite(&Future{Elem: elem}, elem)
+}
+
+// Iterator is the type Iterator[T] of lazy sequences of values of type T,
+// which generators and the bo/iter combinators make.
+type Iterator struct {
+	Elem Type
+}
+
+func (i *Iterator) String() string {
+	return "Iterator[" + i.Elem.String() + "]"
+}
+
+func IteratorOf(elem Type) Type {
+	return composite(&Iterator{Elem: elem}, elem)
+}
+
+// Generic is the type of a builtin function whose result type depends on
+// the types of its arguments. Check works it out, or says what is wrong
+// with them. The runner gets the arguments as a list.
+type Generic struct {
+	Name  string
+	Check func(args []Type) (Type, error)
+}
+
+func (g *Generic) String() string {
+	return g.Name
+}
+
+// Tuple is the type (T1, T2, ...) of fixed-size groups of values.
+type Tuple struct {
+	Elems []Type
+}
+
+func (t *Tuple) String() string {
+	return "(" + typeList(t.Elems) + ")"
+}
+
+func TupleOf(elems ...Type) Type {
+	return composite(&Tuple{Elems: elems}, elems...)
+}
+
+// Struct is a declared type with named fields.
+type Struct struct {
+	Name    string
+	Fields  []*Field
+	Methods map[string]*Func
+
+	// Overloads of each operator, by symbol
+	Operators map[string][]*Operator
+}
+
+type Field struct {
+	Name string
+	Type Type
+}
+
+func (s *Struct) String() string {
+	return s.Name
+}
+
+// Field returns the field of s with the given name, or nil.
+func (s *Struct) Field(name string) *Field {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// Enum is a type whose values are one of a fixed set of cases, each of
+// which may carry associated values.
+type Enum struct {
+	Name  string
+	Cases []*EnumCase
+}
+
+// EnumCase is a case of an enum and the types of the values it carries.
+type EnumCase struct {
+	Name   string
+	Fields []Type
+}
+
+func (e *Enum) String() string {
+	return e.Name
+}
+
+// Case returns the case of e with the given name, or nil.
+func (e *Enum) Case(name string) *EnumCase {
+	for _, c := range e.Cases {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// TypeName is the type of an identifier that names a declared type, such
+// as the Color in Color.Red.
+type TypeName struct {
+	Type Type
+}
+
+func (t *TypeName) String() string {
+	return "type " + t.Type.String()
+}
+
+// Module is the type of the name a require statement binds.
+type Module struct {
+	Path    string
+	Members map[string]Type
+}
+
+func (m *Module) String() string {
+	return "module " + m.Path
+}
+
+// Opaque is a type the runner implements, whose values are only used
+// through their methods.
+type Opaque struct {
+	Name    string
+	Methods map[string]Type
+
+	// The type of calling the type itself to make a value, if it can be
+	New *Func
+}
+
+func (o *Opaque) String() string {
+	return o.Name
+}
+
+// Func is the type of a function.
+type Func struct {
+	Params []Type
+	Result Type // nil when the function returns no value
+
+	// Names of the parameters, by which arguments may be given
+	Names []string
+
+	// Number of parameters with a default value, which come after the
+	// others and may be left out
+	Defaults int
+
+	// The last parameter of a variadic function is a list of the
+	// arguments left over
+	Variadic bool
+}
+
+func (f *Func) String() string {
+	params := typeList(f.Params)
+	if f.Variadic {
+		last := f.Params[len(f.Params)-1].(*List)
+		params = strings.TrimSuffix(params, last.String()) + "..." + last.Elem.String()
+	}
+
+	s := "func(" + params + ")"
+	if f.Result != nil {
+		s += " " + f.Result.String()
+	}
+	return s
+}
+
+func typeList(types []Type) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// widens reports whether every value of type from is exactly representable
+// in type to, so that the conversion may happen implicitly.
+func widens(from, to Type) bool {
+	// A value of type T, or T?, may be used as a wider optional
+	if t, ok := to.(*Optional); ok {
+		if f, ok := from.(*Optional); ok {
+			from = f.Elem
+		}
+		return from == t.Elem || widens(from, t.Elem)
+	}
+
+	f, ok := from.(*Basic)
+	if !ok || !f.IsNumeric() {
+		return false
+	}
+	t, ok := to.(*Basic)
+	if !ok || !t.IsNumeric() {
+		return false
+	}
+
+	switch {
+	case t.IsBig():
+		// Any integer fits a bigint, any integer or bigint a decimal
+		return f.IsInteger() || f == BigInt
+	case f.IsBig():
+		return false
+	case f.IsFloat():
+		return t.IsFloat() && t.bits >= f.bits
+	case t.IsFloat():
+		// Integers must fit in the mantissa
+		mantissa := 53
+		if t == Float32 {
+			mantissa = 24
+		}
+		return f.bits < mantissa
+	case f.IsUnsigned() == t.IsUnsigned():
+		return t.bits >= f.bits
+	default:
+		// Unsigned to signed needs an extra bit, signed to unsigned never fits
+		return f.IsUnsigned() && t.bits > f.bits
+	}
+}
+
+// representable reports whether a constant value, a *big.Int or float64,
+// can be given type t.
+func representable(value interface{}, t Type) bool {
+	if o, ok := t.(*Optional); ok {
+		t = o.Elem
+	}
+
+	b, ok := t.(*Basic)
+	if !ok {
+		return false
+	}
+
+	switch value := value.(type) {
+	case *big.Int:
+		switch {
+		case b.IsFloat() || b.IsBig():
+			return true
+		case b.IsUnsigned():
+			return value.Sign() >= 0 && value.BitLen() <= b.bits
+		case b.IsInteger():
+			// Two's complement: -2^(n-1) needs n-1 bits of magnitude
+			limit := new(big.Int).Lsh(big.NewInt(1), uint(b.bits-1))
+			return value.CmpAbs(limit) < 0 || value.Sign() < 0 && value.CmpAbs(limit) == 0
+		}
+	case float64:
+		return b == Float || b == Decimal || b == Float32 && math.Abs(value) <= math.MaxFloat32
+	}
+
+	return false
+}
@@ -0,0 +1,686 @@
+// Package compiler lowers a checked program to the bytecode the vm package
+// runs: a function for each function declaration and one for the top level,
+// whose instructions refer to the constants of the program and to the
+// locals of the function by slot.
+package compiler
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"bo/decimal"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"path"
+
+	"bo/value"
+)
+
+type compiler struct {
+	info *checker.Info
+	prog *Program
+
+	// The index of each constant, by constantKey
+	constants map[interface{}]int
+
+	// The index of each function declaration in prog.Functions, assigned
+	// before any is compiled so that operator methods are known by index
+	// wherever they are used
+	functions map[*ast.FuncDecl]int
+
+	// The function being compiled, and the line of the code it emits
+	fn   *funcState
+	line int
+}
+
+// funcState is a function being compiled, nested in the one it is
+// declared in.
+type funcState struct {
+	fn        *Function
+	enclosing *funcState
+
+	// The name the function refers to itself by, "" for a method or the top
+	// level
+	self string
+
+	// The slots of the variables declared in each block, innermost last,
+	// and the next slot free
+	blocks []map[string]int
+	slots  int
+
+	// The index of each variable captured, by name
+	captures map[string]int
+}
+
+// Compile lowers a checked program to bytecode.
+func Compile(prog *ast.Program, info *checker.Info) (p *Program, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if cErr, ok := r.(*compileError); ok {
+				p, err = nil, cErr
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	c := &compiler{
+		info:      info,
+		prog:      &Program{},
+		constants: make(map[interface{}]int),
+		functions: make(map[*ast.FuncDecl]int),
+	}
+
+	main := &Function{Name: "<main>"}
+	c.prog.Functions = append(c.prog.Functions, main)
+	ast.Inspect(prog, func(n ast.Node) bool {
+		if decl, ok := n.(*ast.FuncDecl); ok {
+			c.functions[decl] = len(c.prog.Functions)
+			c.prog.Functions = append(c.prog.Functions, &Function{})
+		}
+		return true
+	})
+
+	c.fn = &funcState{fn: main, blocks: []map[string]int{{}}, captures: make(map[string]int)}
+	for _, stmt := range prog.Stmts {
+		c.stmt(stmt)
+	}
+	c.emit(OpNil)
+	c.emit(OpReturn)
+
+	return c.prog, nil
+}
+
+// function compiles the body of a function declaration.
+func (c *compiler) function(decl *ast.FuncDecl) {
+	fn := c.prog.Functions[c.functions[decl]]
+	*fn = Function{
+		Name:      decl.Name.Name,
+		Method:    decl.Recv != nil,
+		Async:     decl.Async,
+		Generator: c.info.Generators[decl],
+	}
+	if decl.Recv != nil {
+		fn.Name = ast.String(decl.Recv.Type) + "." + fn.Name
+	}
+
+	f := &funcState{fn: fn, enclosing: c.fn, blocks: []map[string]int{{}}, captures: make(map[string]int)}
+	if decl.Recv == nil {
+		f.self = decl.Name.Name
+	}
+
+	enclosing, line := c.fn, c.line
+	c.fn = f
+	defer func() {
+		c.fn, c.line = enclosing, line
+	}()
+
+	c.setLine(decl)
+	if decl.Recv != nil {
+		c.declare(decl.Recv, decl.Recv.Name.Name)
+	}
+
+	// The parameters take the first slots, but each is only in scope in
+	// the default values of those after it
+	first := f.slots
+	for range decl.Params {
+		c.slot(decl)
+	}
+	fn.Params = f.slots
+	for i, param := range decl.Params {
+		if param.Default != nil {
+			jump := c.emit(OpDefault, first+i, 0)
+			c.expr(param.Default)
+			c.emit(OpStore, first+i)
+			c.patch(jump)
+		}
+		f.blocks[0][param.Name.Name] = first + i
+	}
+
+	for _, stmt := range decl.Body.Stmts {
+		c.stmt(stmt)
+	}
+	c.emit(OpNil)
+	c.emit(OpReturn)
+}
+
+// block compiles what compile does in a new block, whose declarations
+// shadow and then go out of scope, freeing their slots.
+func (c *compiler) block(compile func()) {
+	f := c.fn
+	slots := f.slots
+	f.blocks = append(f.blocks, make(map[string]int))
+
+	compile()
+
+	f.blocks = f.blocks[:len(f.blocks)-1]
+	f.slots = slots
+}
+
+// slot returns a new slot in the function being compiled.
+func (c *compiler) slot(node ast.Node) int {
+	f := c.fn
+	if f.slots > math.MaxUint16 {
+		errorf(node, "too many variables in %s", f.fn.Name)
+	}
+
+	f.slots++
+	f.fn.Locals = max(f.fn.Locals, f.slots)
+	return f.slots - 1
+}
+
+// declare binds name to a new slot in the innermost block.
+func (c *compiler) declare(node ast.Node, name string) int {
+	s := c.slot(node)
+	c.fn.blocks[len(c.fn.blocks)-1][name] = s
+	return s
+}
+
+// resolve returns how f loads the variable name: from a slot, from its
+// captured variables, or as itself.
+func (c *compiler) resolve(f *funcState, name string) (Opcode, int, bool) {
+	for i := len(f.blocks) - 1; i >= 0; i-- {
+		if s, ok := f.blocks[i][name]; ok {
+			return OpLoad, s, true
+		}
+	}
+	if name == f.self {
+		return OpSelf, 0, true
+	}
+	if i, ok := f.captures[name]; ok {
+		return OpLoadFree, i, true
+	}
+	if f.enclosing == nil {
+		return 0, 0, false
+	}
+
+	op, index, ok := c.resolve(f.enclosing, name)
+	if !ok {
+		return 0, 0, false
+	}
+
+	kind := map[Opcode]CaptureKind{OpLoad: CaptureLocal, OpLoadFree: CaptureFree, OpSelf: CaptureSelf}[op]
+	f.fn.Captures = append(f.fn.Captures, Capture{Kind: kind, Index: index})
+	f.captures[name] = len(f.fn.Captures) - 1
+	return OpLoadFree, len(f.fn.Captures) - 1, true
+}
+
+// load emits the instruction pushing the value of a variable, or of a
+// builtin.
+func (c *compiler) load(node ast.Node, name string) {
+	if op, index, ok := c.resolve(c.fn, name); ok {
+		if op == OpSelf {
+			c.emit(op)
+		} else {
+			c.emit(op, index)
+		}
+		return
+	}
+	if _, ok := value.Builtins[name]; ok {
+		c.emit(OpBuiltin, c.constant(node, name))
+		return
+	}
+
+	errorf(node, "undefined: %s", name)
+}
+
+// numberKey is the key of a constant that is not comparable, or not equal
+// to itself: its type and exact text.
+type numberKey struct {
+	typ, text string
+}
+
+// constantKey returns a comparable key that is the same for constants
+// that are the same, including their type.
+func constantKey(val interface{}) interface{} {
+	switch val := val.(type) {
+	case *big.Int:
+		return numberKey{"bigint", val.String()}
+	case decimal.Decimal:
+		return numberKey{"decimal", val.String()}
+	case float32, float64:
+		return numberKey{fmt.Sprintf("%T", val), fmt.Sprintf("%b", val)}
+	}
+	return val
+}
+
+// constant returns the index of a constant, adding it to the program.
+func (c *compiler) constant(node ast.Node, val interface{}) int {
+	key := constantKey(val)
+	if i, ok := c.constants[key]; ok {
+		return i
+	}
+
+	if len(c.prog.Constants) > math.MaxUint16 {
+		errorf(node, "too many constants")
+	}
+	c.constants[key] = len(c.prog.Constants)
+	c.prog.Constants = append(c.prog.Constants, val)
+	return len(c.prog.Constants) - 1
+}
+
+// setLine makes the code emitted next map to the line node starts on.
+func (c *compiler) setLine(node ast.Node) {
+	c.line = node.Pos().Line
+}
+
+// emit appends an instruction to the function being compiled and returns
+// its offset.
+func (c *compiler) emit(op Opcode, operands ...int) int {
+	fn := c.fn.fn
+	offset := len(fn.Code)
+	if n := len(fn.Lines); n == 0 || fn.Lines[n-1].Line != c.line {
+		fn.Lines = append(fn.Lines, Line{Offset: offset, Line: c.line})
+	}
+
+	fn.Code = append(fn.Code, byte(op))
+	for i, o := range opcodes[op].operands {
+		switch widths[o] {
+		case 1:
+			fn.Code = append(fn.Code, byte(operands[i]))
+		case 2:
+			fn.Code = binary.BigEndian.AppendUint16(fn.Code, uint16(operands[i]))
+		case 4:
+			fn.Code = binary.BigEndian.AppendUint32(fn.Code, uint32(operands[i]))
+		}
+	}
+	return offset
+}
+
+// patch makes the jump at offset go to the code emitted next.
+func (c *compiler) patch(offset int) {
+	code := c.fn.fn.Code
+	i := offset + 1
+	for _, o := range opcodes[code[offset]].operands {
+		if o == target {
+			binary.BigEndian.PutUint32(code[i:], uint32(len(code)))
+			return
+		}
+		i += widths[o]
+	}
+}
+
+// patchAll patches each of the jumps at offsets.
+func (c *compiler) patchAll(offsets []int) {
+	for _, offset := range offsets {
+		c.patch(offset)
+	}
+}
+
+// fits checks that n fits the operand of an instruction.
+func fits(node ast.Node, n, limit int) int {
+	if n > limit {
+		errorf(node, "too many values: %d", n)
+	}
+	return n
+}
+
+func (c *compiler) stmt(stmt ast.Stmt) {
+	c.setLine(stmt)
+
+	switch stmt := stmt.(type) {
+	case *ast.Require:
+		std := 0
+		if stmt.Std {
+			std = 1
+		}
+		c.emit(OpRequire, c.constant(stmt, stmt.Path), std)
+
+		if _, ok := value.StdModules[stmt.Path]; ok && stmt.Std {
+			c.emit(OpModule, c.constant(stmt, stmt.Path))
+			c.emit(OpStore, c.declare(stmt, path.Base(stmt.Path)))
+		}
+	case *ast.EnumDecl:
+		c.emit(OpDefEnum, c.constant(stmt, stmt.Name.Name))
+		for _, enumCase := range stmt.Cases {
+			c.emit(OpDefCase, c.constant(enumCase, enumCase.Name.Name), fits(enumCase, len(enumCase.Fields), math.MaxUint8))
+		}
+		c.emit(OpStore, c.declare(stmt, stmt.Name.Name))
+	case *ast.StructDecl:
+		c.emit(OpDefStruct, c.constant(stmt, stmt.Name.Name))
+		for _, field := range stmt.Fields {
+			c.emit(OpDefField, c.constant(field, field.Name.Name))
+		}
+		c.emit(OpStore, c.declare(stmt, stmt.Name.Name))
+	case *ast.FuncDecl:
+		c.funcDecl(stmt)
+	case *ast.Return:
+		c.values(stmt, stmt.Results)
+		c.emit(OpReturn)
+	case *ast.Defer:
+		c.deferred(OpDefer, stmt.Call)
+	case *ast.Yield:
+		c.expr(stmt.Value)
+		c.emit(OpYield)
+	case *ast.For:
+		c.forStmt(stmt)
+	case *ast.VarDecl:
+		c.expr(stmt.Value)
+		if len(stmt.Vars) == 1 {
+			c.emit(OpStore, c.declare(stmt.Vars[0], stmt.Vars[0].Name.Name))
+			return
+		}
+
+		// Several variables receive the elements of a tuple
+		c.emit(OpUnpack, fits(stmt, len(stmt.Vars), math.MaxUint16))
+		for _, v := range stmt.Vars {
+			c.emit(OpStore, c.declare(v, v.Name.Name))
+		}
+	case *ast.Destructure:
+		c.expr(stmt.Value)
+		c.destructure(stmt.Pattern)
+	case *ast.Switch:
+		c.switchStmt(stmt)
+	case *ast.Spawn:
+		c.deferred(OpSpawn, stmt.Call)
+	case *ast.Send:
+		c.expr(stmt.Chan)
+		c.expr(stmt.Value)
+		c.emit(OpSend)
+	case *ast.Select:
+		c.selectStmt(stmt)
+	case *ast.AwaitStmt:
+		c.expr(stmt.X)
+		c.emit(OpAwait)
+		c.emit(OpPop)
+	case *ast.CallStmt:
+		// Any result is discarded
+		skip := c.callee(stmt.Call)
+		c.emit(OpCall, c.arguments(stmt.Call))
+		if skip >= 0 {
+			c.patch(skip)
+		}
+		c.emit(OpPop)
+	default:
+		errorf(stmt, "unhandled statement type: %T", stmt)
+	}
+}
+
+// blockStmt compiles the statements of a block in a new block.
+func (c *compiler) blockStmt(block *ast.Block) {
+	c.block(func() {
+		for _, stmt := range block.Stmts {
+			c.stmt(stmt)
+		}
+	})
+}
+
+// funcDecl binds a function that captures the variables it uses, or adds
+// a method to its struct.
+func (c *compiler) funcDecl(decl *ast.FuncDecl) {
+	c.function(decl)
+
+	if decl.Recv != nil {
+		c.load(decl.Recv, ast.String(decl.Recv.Type))
+		c.emit(OpClosure, c.functions[decl])
+		if decl.Operator {
+			c.emit(OpOperator, c.constant(decl, decl.Name.Name))
+		} else {
+			c.emit(OpMethod, c.constant(decl, decl.Name.Name))
+		}
+		return
+	}
+
+	c.emit(OpClosure, c.functions[decl])
+	c.emit(OpStore, c.declare(decl, decl.Name.Name))
+}
+
+// values pushes the value of a return statement: nil, one value, or a
+// tuple of several.
+func (c *compiler) values(node ast.Node, exprs []ast.Expr) {
+	switch len(exprs) {
+	case 0:
+		c.emit(OpNil)
+	case 1:
+		c.expr(exprs[0])
+	default:
+		for _, expr := range exprs {
+			c.expr(expr)
+		}
+		c.emit(OpTuple, fits(node, len(exprs), math.MaxUint16))
+	}
+}
+
+// callee pushes the function a call statement calls. When it calls a
+// method of nil with ?., the nil is left on the stack instead, with a jump
+// to patch past the call, whose offset callee returns, or else -1.
+func (c *compiler) callee(call *ast.Call) int {
+	fun, ok := call.Fun.(*ast.Member)
+	if !ok {
+		id := call.Fun.(*ast.Ident)
+		c.load(id, id.Name)
+		return -1
+	}
+
+	c.expr(fun.X)
+	skip := -1
+	if fun.Safe {
+		skip = c.emit(OpJumpIfNil, 0)
+	}
+	c.emit(OpMember, c.constant(fun.Name, fun.Name.Name))
+	return skip
+}
+
+// arguments pushes the arguments of a call, in the order of the parameters
+// they are passed to, and returns how many there are. A parameter left out
+// takes its default value, and a variadic one the arguments left over as a
+// list. Named arguments written out of the order of their parameters are
+// evaluated as written into slots first.
+func (c *compiler) arguments(expr *ast.Call) int {
+	c.setLine(expr)
+	call := c.info.Calls[expr]
+
+	if call.Order != nil {
+		c.block(func() {
+			slots := make([]int, len(call.Args))
+			for _, i := range call.Order {
+				c.expr(call.Args[i])
+				slots[i] = c.slot(call.Args[i])
+				c.emit(OpStore, slots[i])
+			}
+			for i, arg := range call.Args {
+				if arg == nil {
+					c.emit(OpMissing)
+				} else {
+					c.emit(OpLoad, slots[i])
+				}
+			}
+		})
+	} else {
+		for _, arg := range call.Args {
+			if arg == nil {
+				c.emit(OpMissing)
+			} else {
+				c.expr(arg)
+			}
+		}
+	}
+	n := len(call.Args)
+	if call.Variadic {
+		for _, arg := range call.Rest {
+			c.expr(arg)
+		}
+		c.emit(OpList, fits(expr, len(call.Rest), math.MaxUint16))
+		n++
+	}
+
+	return fits(expr, n, math.MaxUint8)
+}
+
+// deferred compiles a defer or spawn statement, which evaluates the callee
+// and arguments of a call for op to call later.
+func (c *compiler) deferred(op Opcode, call *ast.Call) {
+	skip := c.callee(call)
+	c.emit(op, c.arguments(call))
+	if skip < 0 {
+		return
+	}
+
+	end := c.emit(OpJump, 0)
+	c.patch(skip)
+	c.emit(OpPop)
+	c.patch(end)
+}
+
+// forStmt compiles a for loop, which runs its body for each value of an
+// iterable with the variables its pattern binds in scope.
+func (c *compiler) forStmt(stmt *ast.For) {
+	c.expr(stmt.X)
+	c.setLine(stmt)
+	c.emit(OpIter)
+
+	loop := c.emit(OpNext, 0)
+	var mismatch []int
+	c.block(func() {
+		mismatch = c.bind(stmt.Pattern)
+		c.blockStmt(stmt.Body)
+	})
+	c.emit(OpJump, loop)
+
+	if mismatch != nil {
+		c.patchAll(mismatch[1:])
+		c.emit(OpLoad, mismatch[0])
+		c.emit(OpMismatch, c.constant(stmt.Pattern, ast.String(stmt.Pattern)))
+	}
+	c.patch(loop)
+	c.emit(OpEndIter)
+}
+
+// destructure binds the variables of a pattern the value on top of the
+// stack must match.
+func (c *compiler) destructure(pattern ast.Pattern) {
+	mismatch := c.bind(pattern)
+	if mismatch == nil {
+		return
+	}
+
+	end := c.emit(OpJump, 0)
+	c.patchAll(mismatch[1:])
+	c.emit(OpLoad, mismatch[0])
+	c.emit(OpMismatch, c.constant(pattern, ast.String(pattern)))
+	c.patch(end)
+}
+
+// bind matches the value on top of the stack against a pattern that binds
+// variables in the innermost block. Unless the pattern matches any value,
+// bind returns the slot holding the value followed by the jumps to patch
+// to where it does not match.
+func (c *compiler) bind(pattern ast.Pattern) []int {
+	var mismatch []int
+	if !irrefutable(pattern) {
+		s := c.slot(pattern)
+		c.emit(OpStore, s)
+		c.emit(OpLoad, s)
+		mismatch = []int{s}
+	}
+
+	var bindings []binding
+	c.pattern(pattern, &mismatch, &bindings)
+	c.declareAll(bindings)
+
+	return mismatch
+}
+
+// switchStmt compiles a switch statement, which runs the first arm whose
+// pattern and guard match its value.
+func (c *compiler) switchStmt(stmt *ast.Switch) {
+	c.block(func() {
+		c.expr(stmt.X)
+		subject := c.slot(stmt)
+		c.emit(OpStore, subject)
+
+		var end []int
+		for _, arm := range stmt.Arms {
+			var next []int
+			c.block(func() {
+				c.setLine(arm)
+				c.emit(OpLoad, subject)
+				c.arm(arm.Pattern, arm.Guard, &next)
+				c.blockStmt(arm.Body)
+				end = append(end, c.emit(OpJump, 0))
+			})
+			c.patchAll(next)
+		}
+		c.patchAll(end)
+	})
+}
+
+// arm matches the value on top of the stack against the pattern of an
+// arm, binding its variables, and then checks its guard. Where either
+// fails, it adds a jump to patch to next.
+func (c *compiler) arm(pattern ast.Pattern, guard ast.Expr, next *[]int) {
+	var bindings []binding
+	c.pattern(pattern, next, &bindings)
+	c.declareAll(bindings)
+
+	if guard != nil {
+		c.expr(guard)
+		*next = append(*next, c.emit(OpJumpIfFalse, 0))
+	}
+}
+
+// selectStmt compiles a select statement: the channels and values of its
+// arms are evaluated in order, then the arm chosen runs.
+func (c *compiler) selectStmt(stmt *ast.Select) {
+	for _, arm := range stmt.Arms {
+		switch arm := arm.(type) {
+		case *ast.ReceiveArm:
+			c.expr(arm.Chan)
+			c.emit(OpSelectRecv)
+		case *ast.SendArm:
+			c.expr(arm.Chan)
+			c.expr(arm.Value)
+			c.emit(OpSelectSend)
+		case *ast.DefaultArm:
+			c.emit(OpSelectDefault)
+		}
+	}
+	c.setLine(stmt)
+	c.emit(OpSelect, fits(stmt, len(stmt.Arms), math.MaxUint8))
+
+	// The value received is on the stack, under the index of the arm
+	var end []int
+	for i, arm := range stmt.Arms {
+		next := c.emit(OpArm, i, 0)
+		switch arm := arm.(type) {
+		case *ast.ReceiveArm:
+			c.block(func() {
+				if arm.Name != nil {
+					c.emit(OpStore, c.declare(arm.Name, arm.Name.Name))
+				} else {
+					c.emit(OpPop)
+				}
+				c.blockStmt(arm.Body)
+			})
+		case *ast.SendArm:
+			c.emit(OpPop)
+			c.blockStmt(arm.Body)
+		case *ast.DefaultArm:
+			c.emit(OpPop)
+			c.blockStmt(arm.Body)
+		}
+		end = append(end, c.emit(OpJump, 0))
+		c.patch(next)
+	}
+	c.patchAll(end)
+}
+
+// stringLit pushes the value of a string literal, with the string form of
+// the value of each embedded expression.
+func (c *compiler) stringLit(lit *ast.StringLit) {
+	if len(lit.Parts) == 1 && lit.Parts[0].Expr == nil {
+		c.emit(OpConst, c.constant(lit, lit.Parts[0].Text))
+		return
+	}
+
+	for _, part := range lit.Parts {
+		if part.Expr != nil {
+			c.expr(part.Expr)
+			c.emit(OpToString)
+		} else {
+			c.emit(OpConst, c.constant(lit, part.Text))
+		}
+	}
+	c.emit(OpConcat, fits(lit, len(lit.Parts), math.MaxUint16))
+}
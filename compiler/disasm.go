@@ -0,0 +1,108 @@
+package compiler
+
+import (
+	"bo/decimal"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"bo/value"
+)
+
+// Disassemble prints the code of each function of a program: the offset
+// of each instruction, the source line it was compiled from, shown where
+// it changes, and its operands, with what they refer to.
+func Disassemble(w io.Writer, p *Program) {
+	for i, fn := range p.Functions {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		disassemble(w, p, i, fn)
+	}
+}
+
+var captureKinds = [...]string{
+	CaptureLocal: "local",
+	CaptureFree:  "free",
+	CaptureSelf:  "self",
+}
+
+func disassemble(w io.Writer, p *Program, index int, fn *Function) {
+	var flags []string
+	if fn.Method {
+		flags = append(flags, "method")
+	}
+	if fn.Async {
+		flags = append(flags, "async")
+	}
+	if fn.Generator {
+		flags = append(flags, "generator")
+	}
+	fmt.Fprintf(w, "func #%d %s: params %d, locals %d", index, fn.Name, fn.Params, fn.Locals)
+	if len(flags) > 0 {
+		fmt.Fprintf(w, ", %s", strings.Join(flags, ", "))
+	}
+	fmt.Fprintln(w)
+
+	if len(fn.Captures) > 0 {
+		captures := make([]string, len(fn.Captures))
+		for i, c := range fn.Captures {
+			if c.Kind == CaptureSelf {
+				captures[i] = captureKinds[c.Kind]
+			} else {
+				captures[i] = fmt.Sprintf("%s %d", captureKinds[c.Kind], c.Index)
+			}
+		}
+		fmt.Fprintf(w, "  captures: %s\n", strings.Join(captures, ", "))
+	}
+
+	line := -1
+	for offset := 0; offset < len(fn.Code); offset += Opcode(fn.Code[offset]).Size() {
+		op := Opcode(fn.Code[offset])
+
+		lineCol := "   |"
+		if l := fn.Line(offset); l != line {
+			line = l
+			lineCol = fmt.Sprintf("%4d", l)
+		}
+
+		var operands, notes []string
+		for i, operand := range fn.Operands(offset) {
+			text := strconv.Itoa(operand)
+			switch opcodes[op].operands[i] {
+			case constant:
+				notes = append(notes, constantString(p.Constants[operand]))
+			case target:
+				text = fmt.Sprintf("%04d", operand)
+			case function:
+				notes = append(notes, p.Functions[operand].Name)
+			case typeCode:
+				notes = append(notes, TypeOf(byte(operand)).String())
+			case binaryOp:
+				notes = append(notes, BinaryOps[operand])
+			}
+			operands = append(operands, text)
+		}
+
+		instr := fmt.Sprintf("%-19s %s", op, strings.Join(operands, " "))
+		if len(notes) > 0 {
+			instr = fmt.Sprintf("%-28s ; %s", instr, strings.Join(notes, ", "))
+		}
+		fmt.Fprintf(w, "  %04d %s  %s\n", offset, lineCol, strings.TrimRight(instr, " "))
+	}
+}
+
+// constantString returns a constant as it is written, with its type.
+func constantString(c interface{}) string {
+	switch c := c.(type) {
+	case string:
+		return strconv.Quote(c)
+	case *big.Int:
+		return c.String() + " (bigint)"
+	case decimal.Decimal:
+		return c.String() + " (decimal)"
+	}
+	return fmt.Sprintf("%s (%T)", value.ToString(c), c)
+}
@@ -0,0 +1,68 @@
+package compiler_test
+
+import (
+	"bo/compiler"
+	"bo/internal/botest"
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the .golden files of testdata with what Disassemble prints")
+
+// TestDisassemble disassembles the programs of testdata, and checks that
+// each prints what its .golden file holds: the offset, source line,
+// operands and notes of each instruction. A program read back from its
+// .boc form prints the same.
+func TestDisassemble(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.bo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no programs in testdata")
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".bo")
+		golden := strings.TrimSuffix(file, ".bo") + ".golden"
+		t.Run(name, func(t *testing.T) {
+			p, err := botest.Load(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			prog, err := compiler.Compile(p.Prog, p.Info)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got bytes.Buffer
+			compiler.Disassemble(&got, prog)
+
+			if *update {
+				if err := os.WriteFile(golden, got.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("got:\n%s\nwant:\n%s", got.Bytes(), want)
+			}
+
+			decoded, err := compiler.Decode(compiler.Encode(prog))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var again bytes.Buffer
+			compiler.Disassemble(&again, decoded)
+			if !bytes.Equal(again.Bytes(), want) {
+				t.Errorf("read back from its .boc form:\n%s\nwant:\n%s", again.Bytes(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,400 @@
+package compiler
+
+import (
+	"bo/decimal"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"math/big"
+)
+
+// A compiled program is saved as a .boc file:
+//
+//	magic     "BOC\x00"
+//	version   uint16
+//	constants uvarint count, then for each a tag byte and its value
+//	functions uvarint count, then for each its name, sizes, flags,
+//	          captures, code and line table
+//	checksum  uint32, the CRC-32 of all that comes before it
+//
+// Integers are big endian when fixed in size, and varints otherwise.
+// Strings are a uvarint length followed by their bytes.
+
+// Magic is the header of a .boc file.
+const Magic = "BOC\x00"
+
+// Version is the version of the bytecode, bumped whenever the instructions
+// or the file format change.
+const Version = 1
+
+// The tags of the types of constants.
+const (
+	tagInt64 byte = iota
+	tagInt8
+	tagInt16
+	tagInt32
+	tagUint8
+	tagUint16
+	tagUint32
+	tagUint64
+	tagFloat32
+	tagFloat64
+	tagBigInt
+	tagDecimal
+	tagString
+	tagBool
+)
+
+// Function flags.
+const (
+	flagMethod byte = 1 << iota
+	flagAsync
+	flagGenerator
+)
+
+// bytecodeError is the error of a file that is not a compiled program this
+// version of bo can run.
+type bytecodeError struct {
+	msg string
+}
+
+func (e *bytecodeError) Error() string {
+	return "Bytecode error: " + e.msg
+}
+
+// Encode returns a compiled program in the .boc format.
+func Encode(p *Program) []byte {
+	e := &encoder{}
+	e.buf.WriteString(Magic)
+	e.buf.Write(binary.BigEndian.AppendUint16(nil, Version))
+
+	e.uvarint(len(p.Constants))
+	for _, c := range p.Constants {
+		e.constant(c)
+	}
+
+	e.uvarint(len(p.Functions))
+	for _, fn := range p.Functions {
+		e.string(fn.Name)
+		e.uvarint(fn.Params)
+		e.uvarint(fn.Locals)
+
+		var flags byte
+		if fn.Method {
+			flags |= flagMethod
+		}
+		if fn.Async {
+			flags |= flagAsync
+		}
+		if fn.Generator {
+			flags |= flagGenerator
+		}
+		e.buf.WriteByte(flags)
+
+		e.uvarint(len(fn.Captures))
+		for _, c := range fn.Captures {
+			e.buf.WriteByte(byte(c.Kind))
+			e.uvarint(c.Index)
+		}
+
+		e.uvarint(len(fn.Code))
+		e.buf.Write(fn.Code)
+
+		e.uvarint(len(fn.Lines))
+		for _, l := range fn.Lines {
+			e.uvarint(l.Offset)
+			e.uvarint(l.Line)
+		}
+	}
+
+	return binary.BigEndian.AppendUint32(e.buf.Bytes(), crc32.ChecksumIEEE(e.buf.Bytes()))
+}
+
+type encoder struct {
+	buf bytes.Buffer
+}
+
+func (e *encoder) uvarint(n int) {
+	e.buf.Write(binary.AppendUvarint(nil, uint64(n)))
+}
+
+func (e *encoder) string(s string) {
+	e.uvarint(len(s))
+	e.buf.WriteString(s)
+}
+
+func (e *encoder) constant(c interface{}) {
+	var b []byte
+	switch c := c.(type) {
+	case int64:
+		b = binary.AppendVarint([]byte{tagInt64}, c)
+	case int8:
+		b = []byte{tagInt8, byte(c)}
+	case int16:
+		b = binary.BigEndian.AppendUint16([]byte{tagInt16}, uint16(c))
+	case int32:
+		b = binary.BigEndian.AppendUint32([]byte{tagInt32}, uint32(c))
+	case uint8:
+		b = []byte{tagUint8, c}
+	case uint16:
+		b = binary.BigEndian.AppendUint16([]byte{tagUint16}, c)
+	case uint32:
+		b = binary.BigEndian.AppendUint32([]byte{tagUint32}, c)
+	case uint64:
+		b = binary.AppendUvarint([]byte{tagUint64}, c)
+	case float32:
+		b = binary.BigEndian.AppendUint32([]byte{tagFloat32}, math.Float32bits(c))
+	case float64:
+		b = binary.BigEndian.AppendUint64([]byte{tagFloat64}, math.Float64bits(c))
+	case *big.Int:
+		e.buf.WriteByte(tagBigInt)
+		e.string(c.String())
+	case decimal.Decimal:
+		e.buf.WriteByte(tagDecimal)
+		e.string(c.String())
+	case string:
+		e.buf.WriteByte(tagString)
+		e.string(c)
+	case bool:
+		b = []byte{tagBool, 0}
+		if c {
+			b[1] = 1
+		}
+	default:
+		panic(fmt.Sprintf("constant -> unhandled constant type: %T", c))
+	}
+	e.buf.Write(b)
+}
+
+// Decode reads a compiled program in the .boc format. It fails on a file
+// of another format or version, or one that is corrupt.
+func Decode(data []byte) (p *Program, err error) {
+	if len(data) < len(Magic)+2 || string(data[:len(Magic)]) != Magic {
+		return nil, &bytecodeError{"not a compiled Bo program"}
+	}
+	if v := binary.BigEndian.Uint16(data[len(Magic):]); v != Version {
+		return nil, &bytecodeError{fmt.Sprintf("compiled for bytecode version %d, this bo runs version %d: build it again", v, Version)}
+	}
+	if len(data) < len(Magic)+6 {
+		return nil, &bytecodeError{"file is truncated"}
+	}
+
+	body, sum := data[:len(data)-4], binary.BigEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(body) != sum {
+		return nil, &bytecodeError{"checksum mismatch: file is corrupt"}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if bErr, ok := r.(*bytecodeError); ok {
+				p, err = nil, bErr
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	d := &decoder{data: body, pos: len(Magic) + 2}
+	p = &Program{}
+
+	p.Constants = make([]interface{}, d.count())
+	for i := range p.Constants {
+		p.Constants[i] = d.constant()
+	}
+
+	p.Functions = make([]*Function, d.count())
+	for i := range p.Functions {
+		fn := &Function{Name: d.string(), Params: d.uvarint(), Locals: d.uvarint()}
+
+		flags := d.bytes(1)[0]
+		fn.Method = flags&flagMethod != 0
+		fn.Async = flags&flagAsync != 0
+		fn.Generator = flags&flagGenerator != 0
+
+		fn.Captures = make([]Capture, d.count())
+		for j := range fn.Captures {
+			fn.Captures[j] = Capture{Kind: CaptureKind(d.bytes(1)[0]), Index: d.uvarint()}
+		}
+
+		fn.Code = append([]byte(nil), d.bytes(d.uvarint())...)
+
+		fn.Lines = make([]Line, d.count())
+		for j := range fn.Lines {
+			fn.Lines[j] = Line{Offset: d.uvarint(), Line: d.uvarint()}
+		}
+
+		p.Functions[i] = fn
+	}
+
+	if d.pos != len(d.data) {
+		d.fail("unexpected data after the functions")
+	}
+	if len(p.Functions) == 0 {
+		d.fail("no functions")
+	}
+	for _, fn := range p.Functions {
+		d.verify(p, fn)
+	}
+
+	return p, nil
+}
+
+// decoder reads the body of a .boc file, failing with a bytecodeError.
+type decoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *decoder) fail(format string, args ...interface{}) {
+	panic(&bytecodeError{"malformed file: " + fmt.Sprintf(format, args...)})
+}
+
+func (d *decoder) bytes(n int) []byte {
+	if n < 0 || n > len(d.data)-d.pos {
+		d.fail("truncated at offset %d", d.pos)
+	}
+	d.pos += n
+	return d.data[d.pos-n : d.pos]
+}
+
+func (d *decoder) uvarint() int {
+	n, size := binary.Uvarint(d.data[d.pos:])
+	if size <= 0 || n > math.MaxInt32 {
+		d.fail("bad integer at offset %d", d.pos)
+	}
+	d.pos += size
+	return int(n)
+}
+
+// count reads the number of items that follow, each of which takes at
+// least a byte.
+func (d *decoder) count() int {
+	n := d.uvarint()
+	if n > len(d.data)-d.pos {
+		d.fail("bad count at offset %d", d.pos)
+	}
+	return n
+}
+
+func (d *decoder) string() string {
+	return string(d.bytes(d.uvarint()))
+}
+
+func (d *decoder) constant() interface{} {
+	switch tag := d.bytes(1)[0]; tag {
+	case tagInt64:
+		n, size := binary.Varint(d.data[d.pos:])
+		if size <= 0 {
+			d.fail("bad integer at offset %d", d.pos)
+		}
+		d.pos += size
+		return n
+	case tagInt8:
+		return int8(d.bytes(1)[0])
+	case tagInt16:
+		return int16(binary.BigEndian.Uint16(d.bytes(2)))
+	case tagInt32:
+		return int32(binary.BigEndian.Uint32(d.bytes(4)))
+	case tagUint8:
+		return d.bytes(1)[0]
+	case tagUint16:
+		return binary.BigEndian.Uint16(d.bytes(2))
+	case tagUint32:
+		return binary.BigEndian.Uint32(d.bytes(4))
+	case tagUint64:
+		n, size := binary.Uvarint(d.data[d.pos:])
+		if size <= 0 {
+			d.fail("bad integer at offset %d", d.pos)
+		}
+		d.pos += size
+		return n
+	case tagFloat32:
+		return math.Float32frombits(binary.BigEndian.Uint32(d.bytes(4)))
+	case tagFloat64:
+		return math.Float64frombits(binary.BigEndian.Uint64(d.bytes(8)))
+	case tagBigInt:
+		s := d.string()
+		i, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			d.fail("bad bigint %q", s)
+		}
+		return i
+	case tagDecimal:
+		s := d.string()
+		m, err := decimal.Parse(s)
+		if err != nil {
+			d.fail("%s", err)
+		}
+		return m
+	case tagString:
+		return d.string()
+	case tagBool:
+		return d.bytes(1)[0] == 1
+	default:
+		d.fail("unknown constant tag %d", tag)
+		return nil
+	}
+}
+
+// verify checks that the code of fn only refers to what exists, so that
+// running it cannot go astray.
+func (d *decoder) verify(p *Program, fn *Function) {
+	if fn.Params > fn.Locals {
+		d.fail("%s has more parameters than locals", fn.Name)
+	}
+	for _, c := range fn.Captures {
+		if c.Kind > CaptureSelf {
+			d.fail("%s captures a variable of unknown kind %d", fn.Name, c.Kind)
+		}
+	}
+
+	starts := make(map[int]bool)
+	for offset := 0; offset < len(fn.Code); {
+		op := Opcode(fn.Code[offset])
+		if int(op) >= len(opcodes) {
+			d.fail("unknown opcode %d at %d in %s", op, offset, fn.Name)
+		}
+		if offset+op.Size() > len(fn.Code) {
+			d.fail("truncated %s at %d in %s", op, offset, fn.Name)
+		}
+		starts[offset] = true
+		offset += op.Size()
+	}
+	if n := len(fn.Code); n == 0 || Opcode(fn.Code[n-1]) != OpReturn {
+		d.fail("%s does not end with %s", fn.Name, OpReturn)
+	}
+
+	for offset := 0; offset < len(fn.Code); offset += Opcode(fn.Code[offset]).Size() {
+		op := Opcode(fn.Code[offset])
+		for i, operand := range fn.Operands(offset) {
+			var ok bool
+			switch opcodes[op].operands[i] {
+			case constant:
+				// Only OpConst pushes a constant that is not a name
+				ok = operand < len(p.Constants)
+				if _, name := p.Constants[min(operand, len(p.Constants)-1)].(string); ok && op != OpConst {
+					ok = name
+				}
+			case slot:
+				ok = operand < fn.Locals
+			case free:
+				ok = operand < len(fn.Captures)
+			case target:
+				ok = starts[operand]
+			case function:
+				ok = operand < len(p.Functions)
+			case typeCode:
+				ok = int(operand&^OptionalBit) < len(basics)
+			case binaryOp:
+				ok = operand < len(BinaryOps)
+			default:
+				ok = true
+			}
+			if !ok {
+				d.fail("bad operand %d of %s at %d in %s", operand, op, offset, fn.Name)
+			}
+		}
+	}
+}
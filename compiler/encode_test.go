@@ -0,0 +1,157 @@
+package compiler_test
+
+import (
+	"bo/compiler"
+	"bo/decimal"
+	"bo/internal/botest"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// TestRoundTrip compiles the programs of parser/testdata and testdata, and
+// checks that each reads back from its .boc form as it was.
+func TestRoundTrip(t *testing.T) {
+	for _, p := range botest.Programs(t, "testdata") {
+		t.Run(p.Name, func(t *testing.T) {
+			prog, err := compiler.Compile(p.Prog, p.Info)
+			if err != nil {
+				t.Fatal(err)
+			}
+			roundTrip(t, prog)
+		})
+	}
+}
+
+// TestConstants checks that a constant of each type reads back with its
+// type and value.
+func TestConstants(t *testing.T) {
+	price, err := decimal.Parse("-19.990")
+	if err != nil {
+		t.Fatal(err)
+	}
+	huge := new(big.Int).Lsh(big.NewInt(-3), 200)
+	constants := []interface{}{
+		int64(math.MinInt64), int64(0), int64(math.MaxInt64),
+		int8(-128), int16(-32768), int32(math.MinInt32),
+		uint8(255), uint16(65535), uint32(math.MaxUint32), uint64(math.MaxUint64),
+		float32(-1.5), math.Inf(-1), math.Copysign(0, -1), 6.022e23,
+		huge, new(big.Int), price,
+		"", "héllo\x00\n", true, false,
+	}
+
+	var code []byte
+	for i := range constants {
+		code = append(code, byte(compiler.OpConst), byte(i>>8), byte(i), byte(compiler.OpPop))
+	}
+	code = append(code, byte(compiler.OpNil), byte(compiler.OpReturn))
+	roundTrip(t, &compiler.Program{
+		Constants: constants,
+		Functions: []*compiler.Function{{Name: "main", Code: code, Lines: []compiler.Line{{Offset: 0, Line: 1}}}},
+	})
+}
+
+// roundTrip checks that a program reads back from its .boc form as it
+// was. Functions and constants are compared by how they print, as an empty
+// list reads back as one that is not nil, and a bigint or decimal may be
+// equal to another without being made of the same words.
+func roundTrip(t *testing.T, want *compiler.Program) {
+	t.Helper()
+	got, err := compiler.Decode(compiler.Encode(want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Functions) != len(want.Functions) {
+		t.Fatalf("%d functions, want %d", len(got.Functions), len(want.Functions))
+	}
+	for i, fn := range got.Functions {
+		if g, w := fmt.Sprintf("%+v", *fn), fmt.Sprintf("%+v", *want.Functions[i]); g != w {
+			t.Errorf("function %d is\n%s\nwant\n%s", i, g, w)
+		}
+	}
+	if len(got.Constants) != len(want.Constants) {
+		t.Fatalf("%d constants, want %d", len(got.Constants), len(want.Constants))
+	}
+	for i, c := range got.Constants {
+		if g, w := fmt.Sprintf("%T %v", c, c), fmt.Sprintf("%T %v", want.Constants[i], want.Constants[i]); g != w {
+			t.Errorf("constant %d is %s, want %s", i, g, w)
+		}
+	}
+}
+
+// TestDecodeErrors checks that Decode refuses files of another format or
+// version, and corrupt ones, with an error that says why.
+func TestDecodeErrors(t *testing.T) {
+	main := func(code ...compiler.Opcode) *compiler.Program {
+		fn := &compiler.Function{Name: "main", Locals: 1}
+		for _, op := range code {
+			fn.Code = append(fn.Code, byte(op))
+		}
+		return &compiler.Program{Constants: []interface{}{int64(42)}, Functions: []*compiler.Function{fn}}
+	}
+	valid := compiler.Encode(main(compiler.OpConst, 0, 0, compiler.OpReturn))
+	body := valid[:len(valid)-4]
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"empty file", nil, "not a compiled Bo program"},
+		{"another format", []byte("#!/bin/sh\necho hello\n"), "not a compiled Bo program"},
+		{"other version", binary.BigEndian.AppendUint16([]byte(compiler.Magic), compiler.Version+1),
+			fmt.Sprintf("compiled for bytecode version %d, this bo runs version %d: build it again", compiler.Version+1, compiler.Version)},
+		{"header only", valid[:len(compiler.Magic)+2], "file is truncated"},
+		{"truncated", valid[:len(valid)-3], "checksum mismatch: file is corrupt"},
+		{"no checksum", body, "checksum mismatch: file is corrupt"},
+		{"changed byte", flip(valid, len(valid)/2), "checksum mismatch: file is corrupt"},
+		{"data after the functions", seal(append(append([]byte(nil), body...), 0)), "malformed file: unexpected data after the functions"},
+		{"unknown opcode", compiler.Encode(main(255)), "malformed file: unknown opcode 255 at 0 in main"},
+		{"no return", compiler.Encode(main(compiler.OpNil)), "malformed file: main does not end with RETURN"},
+		{"unknown constant", compiler.Encode(main(compiler.OpConst, 0, 1, compiler.OpReturn)), "malformed file: bad operand 1 of CONST at 0 in main"},
+		{"slot out of the frame", compiler.Encode(main(compiler.OpLoad, 0, 1, compiler.OpReturn)), "malformed file: bad operand 1 of LOAD at 0 in main"},
+		{"jump into an instruction", compiler.Encode(main(compiler.OpJump, 0, 0, 0, 1, compiler.OpReturn)), "malformed file: bad operand 1 of JUMP at 0 in main"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			decodeError(t, test.data, test.want)
+		})
+	}
+
+	// A file cut anywhere, with a checksum that matches what is left, is
+	// refused as malformed
+	for n := len(compiler.Magic) + 2; n < len(body); n++ {
+		t.Run(fmt.Sprintf("cut at %d", n), func(t *testing.T) {
+			decodeError(t, seal(body[:n]), "malformed file: ")
+		})
+	}
+}
+
+func decodeError(t *testing.T, data []byte, want string) {
+	t.Helper()
+	p, err := compiler.Decode(data)
+	switch {
+	case err == nil:
+		t.Errorf("decoded %d functions, want an error with %q", len(p.Functions), want)
+	case !strings.HasPrefix(err.Error(), "Bytecode error: "):
+		t.Errorf("error %q is not a bytecode error", err)
+	case !strings.Contains(err.Error(), want):
+		t.Errorf("error %q, want one with %q", err, want)
+	}
+}
+
+// seal appends the checksum of a body.
+func seal(body []byte) []byte {
+	return binary.BigEndian.AppendUint32(append([]byte(nil), body...), crc32.ChecksumIEEE(body))
+}
+
+// flip returns data with the bits of its byte i inverted.
+func flip(data []byte, i int) []byte {
+	data = append([]byte(nil), data...)
+	data[i] ^= 0xff
+	return data
+}
@@ -0,0 +1,21 @@
+package compiler
+
+import (
+	"bo/ast"
+	"fmt"
+)
+
+type compileError struct {
+	line   int
+	column int
+	msg    string
+}
+
+func (e *compileError) Error() string {
+	return fmt.Sprintf("Compile error at line %d:%d: %s", e.line, e.column, e.msg)
+}
+
+func errorf(node ast.Node, format string, args ...interface{}) {
+	pos := node.Pos()
+	panic(&compileError{line: pos.Line, column: pos.Column, msg: fmt.Sprintf(format, args...)})
+}
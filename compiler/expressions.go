@@ -0,0 +1,364 @@
+package compiler
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"bo/decimal"
+	"math"
+	"math/big"
+	"slices"
+
+	"bo/value"
+)
+
+// expr pushes the value of an expression, converted to the type the
+// checker recorded for it.
+func (c *compiler) expr(expr ast.Expr) {
+	if c.literal(expr) {
+		return
+	}
+
+	c.exprValue(expr)
+	if code, ok := typeCodeOf(c.info.Types[expr]); ok {
+		c.emit(OpConvert, int(code))
+	}
+}
+
+func (c *compiler) exprValue(expr ast.Expr) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		c.load(expr, expr.Name)
+	case *ast.StringLit:
+		c.stringLit(expr)
+	case *ast.Paren:
+		c.expr(expr.X)
+	case *ast.ListLit:
+		for _, elem := range expr.Elems {
+			c.expr(elem)
+		}
+		c.emit(OpList, fits(expr, len(expr.Elems), math.MaxUint16))
+	case *ast.MapLit:
+		for _, entry := range expr.Entries {
+			c.expr(entry.Key)
+			c.expr(entry.Value)
+		}
+		c.emit(OpMap, fits(expr, len(expr.Entries), math.MaxUint16))
+	case *ast.TupleLit:
+		for _, elem := range expr.Elems {
+			c.expr(elem)
+		}
+		c.emit(OpTuple, fits(expr, len(expr.Elems), math.MaxUint16))
+	case *ast.StructLit:
+		// Fields left out are nil
+		c.load(expr.Type, expr.Type.Name)
+		c.emit(OpNewStruct)
+		for _, field := range expr.Fields {
+			c.expr(field.Value)
+			c.emit(OpSetField, c.constant(field, field.Name.Name))
+		}
+	case *ast.Match:
+		c.match(expr)
+	case *ast.Conversion:
+		c.expr(expr.X)
+		if o := c.info.Operators[expr]; o != nil {
+			c.overloaded(expr, o, 0)
+		} else if _, ok := c.info.Types[expr.Type].(*checker.Chan); ok {
+			c.emit(OpNewChan)
+		} else if code, ok := typeCodeOf(c.info.Types[expr.Type]); ok {
+			c.emit(OpConvert, int(code))
+		}
+	case *ast.Member:
+		// A member of nil taken with ?. is nil
+		c.expr(expr.X)
+		skip := -1
+		if expr.Safe {
+			skip = c.emit(OpJumpIfNil, 0)
+		}
+		c.emit(OpMember, c.constant(expr.Name, expr.Name.Name))
+		if skip >= 0 {
+			c.patch(skip)
+		}
+	case *ast.Call:
+		// x?.m(args) is nil if x is, without evaluating args
+		skip := -1
+		if member, ok := expr.Fun.(*ast.Member); ok && member.Safe {
+			skip = c.callee(expr)
+		} else {
+			c.expr(expr.Fun)
+		}
+		n := c.arguments(expr)
+		c.emit(OpCall, n)
+		if skip >= 0 {
+			c.patch(skip)
+		}
+	case *ast.Index:
+		c.expr(expr.X)
+		c.expr(expr.Index)
+		if o := c.info.Operators[expr]; o != nil {
+			c.overloaded(expr, o, 1)
+		} else {
+			c.emit(OpIndex)
+		}
+	case *ast.Unary:
+		c.expr(expr.X)
+		if o := c.info.Operators[expr]; o != nil {
+			c.overloaded(expr, o, 0)
+		} else if expr.Op == "!" {
+			c.emit(OpNot)
+		} else {
+			c.emit(OpNeg)
+		}
+	case *ast.Receive:
+		c.expr(expr.Chan)
+		c.emit(OpReceive)
+	case *ast.Await:
+		c.expr(expr.X)
+		c.emit(OpAwait)
+	case *ast.Range:
+		c.expr(expr.From)
+		c.expr(expr.To)
+		exclusive := 0
+		if expr.Exclusive {
+			exclusive = 1
+		}
+		c.emit(OpRange, exclusive)
+	case *ast.Step:
+		c.expr(expr.X)
+		c.expr(expr.Step)
+		c.emit(OpStep)
+	case *ast.Binary:
+		c.binary(expr)
+	default:
+		errorf(expr, "unhandled expression type: %T", expr)
+	}
+}
+
+// binary compiles a binary expression, left operand first. The right
+// operand of &&, || and ?? is only evaluated when needed.
+func (c *compiler) binary(expr *ast.Binary) {
+	var jump Opcode
+	switch expr.Op {
+	case "&&":
+		jump = OpJumpFalseOrPop
+	case "||":
+		jump = OpJumpTrueOrPop
+	case "??":
+		jump = OpJumpNotNilOrPop
+	}
+	if jump != 0 {
+		c.expr(expr.X)
+		end := c.emit(jump, 0)
+		c.expr(expr.Y)
+		c.patch(end)
+		return
+	}
+
+	c.expr(expr.X)
+	c.expr(expr.Y)
+	if o := c.info.Operators[expr]; o != nil {
+		if o.Swap {
+			c.emit(OpSwap)
+		}
+		c.overloaded(expr, o, 1)
+		return
+	}
+
+	op := slices.Index(BinaryOps, expr.Op)
+	if op < 0 {
+		errorf(expr, "unhandled operator: %s", expr.Op)
+	}
+	c.emit(OpBinary, op)
+}
+
+// overloaded calls the operator method o of the struct value below the n
+// arguments on top of the stack.
+func (c *compiler) overloaded(node ast.Node, o *checker.Overload, n int) {
+	c.setLine(node)
+	c.emit(OpOperatorCall, c.functions[o.Decl], n)
+	if o.Negate {
+		c.emit(OpNot)
+	}
+}
+
+// match compiles a match expression, whose value is that of the first arm
+// whose pattern and guard match the value of its subject.
+func (c *compiler) match(expr *ast.Match) {
+	c.block(func() {
+		c.expr(expr.X)
+		subject := c.slot(expr)
+		c.emit(OpStore, subject)
+
+		var end []int
+		for _, arm := range expr.Arms {
+			var next []int
+			c.block(func() {
+				c.emit(OpLoad, subject)
+				c.arm(arm.Pattern, arm.Guard, &next)
+				c.expr(arm.Value)
+				end = append(end, c.emit(OpJump, 0))
+			})
+			c.patchAll(next)
+		}
+
+		// Only arms with guards can leave a value unmatched
+		c.emit(OpLoad, subject)
+		c.emit(OpNoMatch)
+		c.patchAll(end)
+	})
+}
+
+// literal pushes the value of a literal, or of a negated int literal,
+// converted to its type when that can be done once and for all, and
+// reports whether expr is one.
+func (c *compiler) literal(expr ast.Expr) bool {
+	var val interface{}
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		switch expr.Kind {
+		case ast.Nil:
+			c.emit(OpNil)
+			return true
+		case ast.Bool:
+			if expr.Value == "true" {
+				c.emit(OpTrue)
+			} else {
+				c.emit(OpFalse)
+			}
+			return true
+		}
+		val = c.basicLit(expr)
+	case *ast.Unary:
+		// A negated literal is evaluated as a whole so that the smallest
+		// int can be written without overflowing
+		lit, ok := expr.X.(*ast.BasicLit)
+		if !ok || lit.Kind != ast.Int || expr.Op != "-" {
+			return false
+		}
+		if i, err := ast.IntLiteral(lit.Value, true); err == nil {
+			val = i
+		} else {
+			i := ast.BigIntLiteral(lit.Value)
+			val = i.Neg(i)
+		}
+	default:
+		return false
+	}
+
+	c.constantOf(expr, val, c.info.Types[expr])
+	return true
+}
+
+// basicLit returns the value of a numeric literal.
+func (c *compiler) basicLit(lit *ast.BasicLit) interface{} {
+	switch lit.Kind {
+	case ast.Int:
+		if val, err := ast.IntLiteral(lit.Value, false); err == nil {
+			return val
+		}
+		if val, err := ast.UintLiteral(lit.Value); err == nil {
+			return val
+		}
+
+		// Only valid as a bigint or decimal, which the checker verified
+		return ast.BigIntLiteral(lit.Value)
+	case ast.Float:
+		// Read a decimal exactly rather than through the nearest float
+		if c.info.Types[lit] == checker.Decimal {
+			if val, err := ast.DecimalLiteral(lit.Value); err == nil {
+				return val
+			}
+		}
+
+		val, err := ast.FloatLiteral(lit.Value)
+		if err != nil {
+			errorf(lit, "%s", err)
+		}
+		return val
+	case ast.BigInt:
+		return ast.BigIntLiteral(lit.Value)
+	case ast.Decimal:
+		val, err := ast.DecimalLiteral(lit.Value)
+		if err != nil {
+			errorf(lit, "%s", err)
+		}
+		return val
+	}
+
+	errorf(lit, "unhandled literal kind: %d", lit.Kind)
+	return nil
+}
+
+// constantOf pushes a constant converted to t. A value out of the range of
+// t is converted when the code runs, which then fails as it should.
+func (c *compiler) constantOf(node ast.Node, val interface{}, t checker.Type) {
+	converted, ok := convertConstant(val, t)
+	if ok {
+		c.emit(OpConst, c.constant(node, converted))
+		return
+	}
+
+	c.emit(OpConst, c.constant(node, val))
+	if code, ok := typeCodeOf(t); ok {
+		c.emit(OpConvert, int(code))
+	}
+}
+
+// convertConstant converts a constant to t, or reports that it cannot.
+func convertConstant(val interface{}, t checker.Type) (converted interface{}, ok bool) {
+	defer func() {
+		if recover() != nil {
+			converted, ok = nil, false
+		}
+	}()
+	return value.Convert(val, t), true
+}
+
+// patternValue pushes the value of a literal pattern, converted to the
+// type of the values it is matched against.
+func (c *compiler) patternValue(p *ast.LiteralPattern) {
+	lit, ok := p.Value.(*ast.BasicLit)
+	if !ok {
+		c.stringLit(p.Value.(*ast.StringLit))
+		return
+	}
+
+	var val interface{}
+	switch lit.Kind {
+	case ast.Nil:
+		c.emit(OpNil)
+		return
+	case ast.Bool:
+		if lit.Value == "true" {
+			c.emit(OpTrue)
+		} else {
+			c.emit(OpFalse)
+		}
+		return
+	case ast.Int, ast.BigInt:
+		val = ast.BigIntLiteral(lit.Value)
+	case ast.Decimal:
+		d, err := ast.DecimalLiteral(lit.Value)
+		if err != nil {
+			errorf(lit, "%s", err)
+		}
+		val = d
+	case ast.Float:
+		if d, err := ast.DecimalLiteral(lit.Value); err == nil {
+			val = d
+		} else if val, err = ast.FloatLiteral(lit.Value); err != nil {
+			errorf(lit, "%s", err)
+		}
+	}
+
+	if p.Neg {
+		switch lit := val.(type) {
+		case *big.Int:
+			val = new(big.Int).Neg(lit)
+		case decimal.Decimal:
+			val = lit.Neg()
+		case float64:
+			val = -lit
+		}
+	}
+	c.constantOf(p, val, c.info.Types[p])
+}
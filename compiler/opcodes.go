@@ -0,0 +1,226 @@
+package compiler
+
+import "fmt"
+
+// Opcode is the first byte of an instruction. Its operands follow it, big
+// endian, with the widths listed in opcodes: slots, constants, functions and
+// lengths take two bytes, jump targets four and small counts and flags one.
+type Opcode byte
+
+const (
+	// Constants and variables
+	OpConst    Opcode = iota // k: push constant k
+	OpNil                    // push nil
+	OpTrue                   // push true
+	OpFalse                  // push false
+	OpMissing                // push the argument of a parameter left to its default
+	OpPop                    // drop the top of the stack
+	OpDup                    // push the top of the stack again
+	OpSwap                   // swap the two values on top of the stack
+	OpLoad                   // s: push local s
+	OpStore                  // s: pop into local s
+	OpLoadFree               // i: push captured variable i
+	OpSelf                   // push the function being run
+	OpBuiltin                // k: push the builtin named by constant k
+	OpDefault                // s, t: jump to t unless local s was left to its default
+
+	// Operators
+	OpConvert // t: convert to the numeric type with type code t
+	OpBinary  // o: apply BinaryOps[o] to the two values on top
+	OpNeg     // negate
+	OpNot     // negate a bool
+
+	// Jumps to absolute offsets in the function's code
+	OpJump            // t
+	OpJumpIfFalse     // t: pop a bool, jump if false
+	OpJumpFalseOrPop  // t: jump if false, keeping it, else pop it
+	OpJumpTrueOrPop   // t: jump if true, keeping it, else pop it
+	OpJumpNotNilOrPop // t: jump if not nil, keeping it, else pop it
+	OpJumpIfNil       // t: jump if nil, keeping it
+
+	// Composite values
+	OpList      // n: pop n values into a list
+	OpMap       // n: pop n keys and values into a map
+	OpTuple     // n: pop n values into a tuple
+	OpUnpack    // n: replace a tuple with its n elements, the first on top
+	OpNewStruct // replace a struct with a value of it, its fields nil
+	OpSetField  // k: pop a value into field k of the struct value below it
+	OpMember    // k: replace a value with its field or method k
+	OpIndex     // replace a value and an index with its element
+	OpRange     // x: replace two ints with a range, exclusive if x is 1
+	OpStep      // replace a range and an int with every so many of its values
+	OpToString  // replace a value with its string form
+	OpConcat    // n: pop n strings and push them joined
+
+	// Declarations
+	OpDefStruct // k: push a struct named k, with no fields
+	OpDefField  // k: add field k to the struct on top
+	OpDefEnum   // k: push an enum named k, with no cases
+	OpDefCase   // k, n: add case k carrying n values to the enum on top
+	OpClosure   // f: push function f with its captured variables
+	OpMethod    // k: pop a function into method k of the struct below it
+	OpOperator  // k: pop a function into operator method k of the struct below it
+	OpRequire   // k, x: import module k, a standard one if x is 1
+	OpModule    // k: push standard module k
+
+	// Calls
+	OpCall         // n: pop n arguments and a callee, and push its result
+	OpOperatorCall // f, n: call operator method f of the struct value below n arguments
+	OpReturn       // return the value on top
+	OpDefer        // n: pop n arguments and a callee, called when the function returns
+	OpSpawn        // n: pop n arguments and a callee, called by a task of its own
+
+	// Iteration and tasks
+	OpIter          // pop an iterable and start iterating over it
+	OpNext          // t: push the next value of the iteration, or jump to t at its end
+	OpEndIter       // stop the innermost iteration
+	OpYield         // pop a value and hand it to the task asking for it
+	OpAwait         // replace a future with its value
+	OpSend          // pop a channel and a value to send on it
+	OpReceive       // replace a channel with a value received from it
+	OpNewChan       // replace an int with a channel of that size
+	OpSelectRecv    // replace a channel with a select case receiving from it
+	OpSelectSend    // replace a channel and a value with a select case sending it
+	OpSelectDefault // push the default select case
+	OpSelect        // n: pop n select cases, push the value received and the index of the case chosen
+	OpArm           // i, t: jump to t unless case i was chosen, else pop its index
+
+	// Patterns
+	OpIsCase   // k: replace a value with whether it is case k of an enum
+	OpIsList   // n, x: replace a value with whether it is a list of n elements, or more if x is 1
+	OpIsMap    // replace a value with whether it is a map
+	OpIsTuple  // replace a value with whether it is a tuple
+	OpIsStruct // replace a value with whether it is a struct value
+	OpElem     // i: replace a list, tuple or enum value with its ith element
+	OpField    // k: replace a struct value with its field k
+	OpRest     // n: replace a list with a copy of its elements from the nth on
+	OpMismatch // k: fail, the value on top not matching pattern k
+	OpNoMatch  // fail, no arm matching the value on top
+)
+
+// BinaryOps are the operators of OpBinary.
+var BinaryOps = []string{"+", "-", "*", "/", "%", "+%", "-%", "*%", "<", "<=", ">", ">=", "==", "!="}
+
+// An operand is what an instruction refers to, which decides how it is
+// printed.
+type operand int
+
+const (
+	constant operand = iota // a constant
+	slot                    // a local
+	free                    // a captured variable
+	target                  // a jump target
+	function                // a function
+	size                    // a length or index
+	count                   // a small count, index or flag
+	typeCode                // a numeric type
+	binaryOp                // an operator of BinaryOps
+)
+
+// widths are the sizes of the operands in bytes.
+var widths = [...]int{
+	constant: 2,
+	slot:     2,
+	free:     2,
+	target:   4,
+	function: 2,
+	size:     2,
+	count:    1,
+	typeCode: 1,
+	binaryOp: 1,
+}
+
+var opcodes = [...]struct {
+	name     string
+	operands []operand
+}{
+	OpConst:           {"CONST", []operand{constant}},
+	OpNil:             {"NIL", nil},
+	OpTrue:            {"TRUE", nil},
+	OpFalse:           {"FALSE", nil},
+	OpMissing:         {"MISSING", nil},
+	OpPop:             {"POP", nil},
+	OpDup:             {"DUP", nil},
+	OpSwap:            {"SWAP", nil},
+	OpLoad:            {"LOAD", []operand{slot}},
+	OpStore:           {"STORE", []operand{slot}},
+	OpLoadFree:        {"LOAD_FREE", []operand{free}},
+	OpSelf:            {"SELF", nil},
+	OpBuiltin:         {"BUILTIN", []operand{constant}},
+	OpDefault:         {"DEFAULT", []operand{slot, target}},
+	OpConvert:         {"CONVERT", []operand{typeCode}},
+	OpBinary:          {"BINARY", []operand{binaryOp}},
+	OpNeg:             {"NEG", nil},
+	OpNot:             {"NOT", nil},
+	OpJump:            {"JUMP", []operand{target}},
+	OpJumpIfFalse:     {"JUMP_IF_FALSE", []operand{target}},
+	OpJumpFalseOrPop:  {"JUMP_FALSE_OR_POP", []operand{target}},
+	OpJumpTrueOrPop:   {"JUMP_TRUE_OR_POP", []operand{target}},
+	OpJumpNotNilOrPop: {"JUMP_NOT_NIL_OR_POP", []operand{target}},
+	OpJumpIfNil:       {"JUMP_IF_NIL", []operand{target}},
+	OpList:            {"LIST", []operand{size}},
+	OpMap:             {"MAP", []operand{size}},
+	OpTuple:           {"TUPLE", []operand{size}},
+	OpUnpack:          {"UNPACK", []operand{size}},
+	OpNewStruct:       {"NEW_STRUCT", nil},
+	OpSetField:        {"SET_FIELD", []operand{constant}},
+	OpMember:          {"MEMBER", []operand{constant}},
+	OpIndex:           {"INDEX", nil},
+	OpRange:           {"RANGE", []operand{count}},
+	OpStep:            {"STEP", nil},
+	OpToString:        {"TO_STRING", nil},
+	OpConcat:          {"CONCAT", []operand{size}},
+	OpDefStruct:       {"DEF_STRUCT", []operand{constant}},
+	OpDefField:        {"DEF_FIELD", []operand{constant}},
+	OpDefEnum:         {"DEF_ENUM", []operand{constant}},
+	OpDefCase:         {"DEF_CASE", []operand{constant, count}},
+	OpClosure:         {"CLOSURE", []operand{function}},
+	OpMethod:          {"METHOD", []operand{constant}},
+	OpOperator:        {"OPERATOR", []operand{constant}},
+	OpRequire:         {"REQUIRE", []operand{constant, count}},
+	OpModule:          {"MODULE", []operand{constant}},
+	OpCall:            {"CALL", []operand{count}},
+	OpOperatorCall:    {"OPERATOR_CALL", []operand{function, count}},
+	OpReturn:          {"RETURN", nil},
+	OpDefer:           {"DEFER", []operand{count}},
+	OpSpawn:           {"SPAWN", []operand{count}},
+	OpIter:            {"ITER", nil},
+	OpNext:            {"NEXT", []operand{target}},
+	OpEndIter:         {"END_ITER", nil},
+	OpYield:           {"YIELD", nil},
+	OpAwait:           {"AWAIT", nil},
+	OpSend:            {"SEND", nil},
+	OpReceive:         {"RECEIVE", nil},
+	OpNewChan:         {"NEW_CHAN", nil},
+	OpSelectRecv:      {"SELECT_RECV", nil},
+	OpSelectSend:      {"SELECT_SEND", nil},
+	OpSelectDefault:   {"SELECT_DEFAULT", nil},
+	OpSelect:          {"SELECT", []operand{count}},
+	OpArm:             {"ARM", []operand{count, target}},
+	OpIsCase:          {"IS_CASE", []operand{constant}},
+	OpIsList:          {"IS_LIST", []operand{size, count}},
+	OpIsMap:           {"IS_MAP", nil},
+	OpIsTuple:         {"IS_TUPLE", nil},
+	OpIsStruct:        {"IS_STRUCT", nil},
+	OpElem:            {"ELEM", []operand{size}},
+	OpField:           {"FIELD", []operand{constant}},
+	OpRest:            {"REST", []operand{size}},
+	OpMismatch:        {"MISMATCH", []operand{constant}},
+	OpNoMatch:         {"NO_MATCH", nil},
+}
+
+func (op Opcode) String() string {
+	if int(op) < len(opcodes) {
+		return opcodes[op].name
+	}
+	return fmt.Sprintf("Opcode(%d)", op)
+}
+
+// Size returns the size in bytes of an instruction with opcode op.
+func (op Opcode) Size() int {
+	size := 1
+	for _, o := range opcodes[op].operands {
+		size += widths[o]
+	}
+	return size
+}
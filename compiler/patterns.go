@@ -0,0 +1,141 @@
+package compiler
+
+import (
+	"bo/ast"
+	"math"
+	"slices"
+)
+
+// binding is a variable a pattern binds, to the slot its value was stored
+// in. Bindings are only in scope once the whole pattern matched.
+type binding struct {
+	name string
+	slot int
+}
+
+// declareAll binds the variables of a pattern in the innermost block.
+func (c *compiler) declareAll(bindings []binding) {
+	block := c.fn.blocks[len(c.fn.blocks)-1]
+	for _, b := range bindings {
+		block[b.name] = b.slot
+	}
+}
+
+// irrefutable reports whether a pattern matches any value.
+func irrefutable(pattern ast.Pattern) bool {
+	switch pattern.(type) {
+	case *ast.WildcardPattern, *ast.BindingPattern:
+		return true
+	}
+	return false
+}
+
+// pattern matches the value on top of the stack, which it pops, against a
+// pattern. Where the value does not match, it adds a jump to patch to fail,
+// taken with the stack as it was below the value. The variables the
+// pattern binds are added to bindings.
+func (c *compiler) pattern(pattern ast.Pattern, fail *[]int, bindings *[]binding) {
+	switch p := pattern.(type) {
+	case *ast.WildcardPattern:
+		c.emit(OpPop)
+	case *ast.BindingPattern:
+		c.bindTo(p.Name, bindings)
+	case *ast.LiteralPattern:
+		c.patternValue(p)
+		c.emit(OpBinary, opEqual)
+		*fail = append(*fail, c.emit(OpJumpIfFalse, 0))
+	case *ast.CasePattern:
+		if len(p.Fields) == 0 {
+			c.emit(OpIsCase, c.constant(p.Case, p.Case.Name))
+			*fail = append(*fail, c.emit(OpJumpIfFalse, 0))
+			return
+		}
+
+		tmp := c.test(p, fail, OpIsCase, c.constant(p.Case, p.Case.Name))
+		for i, field := range p.Fields {
+			c.part(field, tmp, fail, bindings, OpElem, i)
+		}
+	case *ast.ListPattern:
+		elems, rest := p.Elems, (*ast.RestPattern)(nil)
+		if n := len(elems); n > 0 {
+			if r, ok := elems[n-1].(*ast.RestPattern); ok {
+				elems, rest = elems[:n-1], r
+			}
+		}
+
+		more := 0
+		if rest != nil {
+			more = 1
+		}
+		tmp := c.test(p, fail, OpIsList, fits(p, len(elems), math.MaxUint16), more)
+		for i, elem := range elems {
+			c.part(elem, tmp, fail, bindings, OpElem, i)
+		}
+		if rest != nil && rest.Name != nil {
+			c.emit(OpLoad, tmp)
+			c.emit(OpRest, len(elems))
+			c.bindTo(rest.Name, bindings)
+		}
+	case *ast.MapPattern:
+		tmp := c.test(p, fail, OpIsMap)
+		for _, entry := range p.Entries {
+			// A missing key matches as nil
+			c.emit(OpLoad, tmp)
+			c.patternValue(entry.Key.(*ast.LiteralPattern))
+			c.emit(OpIndex)
+			c.pattern(entry.Value, fail, bindings)
+		}
+	case *ast.TuplePattern:
+		tmp := c.test(p, fail, OpIsTuple)
+		for i, elem := range p.Elems {
+			c.part(elem, tmp, fail, bindings, OpElem, fits(p, i, math.MaxUint16))
+		}
+	case *ast.StructPattern:
+		tmp := c.test(p, fail, OpIsStruct)
+		for _, field := range p.Fields {
+			c.emit(OpLoad, tmp)
+			c.emit(OpField, c.constant(field.Name, field.Name.Name))
+			if field.Pattern == nil {
+				c.bindTo(field.Name, bindings)
+			} else {
+				c.pattern(field.Pattern, fail, bindings)
+			}
+		}
+	default:
+		errorf(pattern, "unhandled pattern type: %T", pattern)
+	}
+}
+
+// opEqual is the operand of OpBinary for ==.
+var opEqual = slices.Index(BinaryOps, "==")
+
+// bindTo pops the value on top of the stack into a new slot for a variable
+// the pattern being compiled binds.
+func (c *compiler) bindTo(name *ast.Ident, bindings *[]binding) {
+	s := c.slot(name)
+	c.emit(OpStore, s)
+	*bindings = append(*bindings, binding{name: name.Name, slot: s})
+}
+
+// test pops the value of a compound pattern into a slot, whose index it
+// returns, and checks its kind with op.
+func (c *compiler) test(pattern ast.Pattern, fail *[]int, op Opcode, operands ...int) int {
+	tmp := c.slot(pattern)
+	c.emit(OpStore, tmp)
+	c.emit(OpLoad, tmp)
+	c.emit(op, operands...)
+	*fail = append(*fail, c.emit(OpJumpIfFalse, 0))
+	return tmp
+}
+
+// part matches an element of the value of a compound pattern, which op
+// takes out of it, against a pattern. A wildcard needs no element.
+func (c *compiler) part(pattern ast.Pattern, tmp int, fail *[]int, bindings *[]binding, op Opcode, operand int) {
+	if _, ok := pattern.(*ast.WildcardPattern); ok {
+		return
+	}
+
+	c.emit(OpLoad, tmp)
+	c.emit(op, operand)
+	c.pattern(pattern, fail, bindings)
+}
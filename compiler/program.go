@@ -0,0 +1,129 @@
+package compiler
+
+import (
+	"bo/checker"
+	"encoding/binary"
+)
+
+// Program is a compiled program: its functions, the first of which runs
+// the top level, and the constants their code refers to.
+type Program struct {
+	Constants []interface{}
+	Functions []*Function
+}
+
+// Function is the code of a function declaration, or of the top level of
+// the program. Its locals are slots in its frame: the receiver of a method
+// first, then the parameters, then the variables it declares.
+type Function struct {
+	Name   string
+	Params int // the parameters, and the receiver of a method
+	Locals int
+
+	Method, Async, Generator bool
+
+	// What the function captures where it is declared
+	Captures []Capture
+
+	Code  []byte
+	Lines []Line
+}
+
+// CaptureKind is where the value of a captured variable comes from.
+type CaptureKind byte
+
+const (
+	CaptureLocal CaptureKind = iota // a local of the enclosing function
+	CaptureFree                     // a variable it captured itself
+	CaptureSelf                     // the enclosing function itself
+)
+
+// Capture is a variable a function uses from the one it is declared in.
+// Variables never change once declared, so it keeps a copy.
+type Capture struct {
+	Kind  CaptureKind
+	Index int
+}
+
+// Line maps the code from Offset on, up to the next Line, to the source
+// line it was compiled from.
+type Line struct {
+	Offset, Line int
+}
+
+// Line returns the source line of the instruction at offset.
+func (f *Function) Line(offset int) int {
+	line := 0
+	for _, l := range f.Lines {
+		if l.Offset > offset {
+			break
+		}
+		line = l.Line
+	}
+	return line
+}
+
+// Operands decodes the operands of the instruction at offset.
+func (f *Function) Operands(offset int) []int {
+	op := Opcode(f.Code[offset])
+	operands := make([]int, len(opcodes[op].operands))
+	i := offset + 1
+	for j, o := range opcodes[op].operands {
+		switch widths[o] {
+		case 1:
+			operands[j] = int(f.Code[i])
+		case 2:
+			operands[j] = int(binary.BigEndian.Uint16(f.Code[i:]))
+		case 4:
+			operands[j] = int(binary.BigEndian.Uint32(f.Code[i:]))
+		}
+		i += widths[o]
+	}
+	return operands
+}
+
+// A type code is the kind of a numeric type, with OptionalBit set for an
+// optional one.
+const OptionalBit = 0x80
+
+// basics are the numeric types by kind.
+var basics = [...]*checker.Basic{
+	checker.IntKind:     checker.Int,
+	checker.Int8Kind:    checker.Int8,
+	checker.Int16Kind:   checker.Int16,
+	checker.Int32Kind:   checker.Int32,
+	checker.Int64Kind:   checker.Int64,
+	checker.Uint8Kind:   checker.Uint8,
+	checker.Uint16Kind:  checker.Uint16,
+	checker.Uint32Kind:  checker.Uint32,
+	checker.Uint64Kind:  checker.Uint64,
+	checker.FloatKind:   checker.Float,
+	checker.Float32Kind: checker.Float32,
+	checker.BigIntKind:  checker.BigInt,
+	checker.DecimalKind: checker.Decimal,
+}
+
+// typeCodeOf returns the type code of a numeric type, or of an optional
+// one, or false for a type that values are not converted to.
+func typeCodeOf(t checker.Type) (byte, bool) {
+	var code byte
+	if optional, ok := t.(*checker.Optional); ok {
+		t = optional.Elem
+		code = OptionalBit
+	}
+
+	b, ok := t.(*checker.Basic)
+	if !ok || !b.IsNumeric() {
+		return 0, false
+	}
+	return code | byte(b.Kind()), true
+}
+
+// TypeOf returns the type with a type code.
+func TypeOf(code byte) checker.Type {
+	t := checker.Type(basics[code&^OptionalBit])
+	if code&OptionalBit != 0 {
+		t = checker.OptionalOf(t)
+	}
+	return t
+}
@@ -0,0 +1,245 @@
+// Package decimal implements exact base-10 fixed-point numbers backing the
+// Bo decimal type.
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DivisionDigits is the number of fractional digits, beyond those of the
+// operands, kept when a quotient does not terminate.
+const DivisionDigits = 34
+
+// RoundingMode selects how digits are discarded when rounding.
+type RoundingMode int
+
+const (
+	HalfEven RoundingMode = iota // to nearest, ties to even
+	HalfUp                       // to nearest, ties away from zero
+	HalfDown                     // to nearest, ties toward zero
+	Up                           // away from zero
+	Down                         // toward zero
+	Ceiling                      // toward positive infinity
+	Floor                        // toward negative infinity
+)
+
+// Decimal is the value unscaled * 10^-scale. Decimals are immutable: every
+// operation returns a new value.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+var ten = big.NewInt(10)
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(ten, big.NewInt(int64(n)), nil)
+}
+
+// New returns unscaled * 10^-scale.
+func New(unscaled *big.Int, scale int32) Decimal {
+	return Decimal{unscaled: new(big.Int).Set(unscaled), scale: scale}
+}
+
+// FromInt returns the decimal value of an integer.
+func FromInt(i *big.Int) Decimal {
+	return New(i, 0)
+}
+
+// FromFloat returns the decimal with the shortest representation that
+// rounds to f.
+func FromFloat(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, fmt.Errorf("cannot convert %v to decimal", f)
+	}
+	return Parse(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// Parse reads a decimal written as digits with an optional sign and
+// fractional part, such as -12.50.
+func Parse(s string) (Decimal, error) {
+	digits := s
+	var scale int32
+	if dot := strings.IndexByte(s, '.'); dot >= 0 {
+		digits = s[:dot] + s[dot+1:]
+		scale = int32(len(s) - dot - 1)
+	}
+
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	return Decimal{unscaled: unscaled, scale: scale}, nil
+}
+
+// Scale returns the number of fractional digits.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+func (d Decimal) Sign() int {
+	return d.unscaled.Sign()
+}
+
+// rescale returns the unscaled value of d at a larger scale.
+func (d Decimal) rescale(scale int32) *big.Int {
+	if scale == d.scale {
+		return d.unscaled
+	}
+	return new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))
+}
+
+// align returns the unscaled values of d and e at their common scale.
+func align(d, e Decimal) (*big.Int, *big.Int, int32) {
+	scale := max(d.scale, e.scale)
+	return d.rescale(scale), e.rescale(scale), scale
+}
+
+func (d Decimal) Add(e Decimal) Decimal {
+	x, y, scale := align(d, e)
+	return Decimal{unscaled: new(big.Int).Add(x, y), scale: scale}
+}
+
+func (d Decimal) Sub(e Decimal) Decimal {
+	x, y, scale := align(d, e)
+	return Decimal{unscaled: new(big.Int).Sub(x, y), scale: scale}
+}
+
+func (d Decimal) Mul(e Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.unscaled, e.unscaled), scale: d.scale + e.scale}
+}
+
+// Div returns d / e, exact when the quotient terminates within
+// DivisionDigits extra fractional digits and rounded half to even otherwise.
+// e must not be zero.
+func (d Decimal) Div(e Decimal) Decimal {
+	scale := max(d.scale, e.scale)
+	q := d.Quo(e, scale+DivisionDigits, HalfEven)
+
+	// Drop the trailing zeros an exact quotient was padded with
+	for q.scale > scale {
+		r := new(big.Int)
+		u, _ := new(big.Int).QuoRem(q.unscaled, ten, r)
+		if r.Sign() != 0 {
+			break
+		}
+		q = Decimal{unscaled: u, scale: q.scale - 1}
+	}
+
+	return q
+}
+
+// Quo returns d / e rounded to the given scale. e must not be zero.
+func (d Decimal) Quo(e Decimal, scale int32, mode RoundingMode) Decimal {
+	// d / e = (d.unscaled * 10^shift / e.unscaled) * 10^-scale
+	num := new(big.Int).Set(d.unscaled)
+	den := new(big.Int).Set(e.unscaled)
+	if shift := scale - d.scale + e.scale; shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+
+	return Decimal{unscaled: roundQuo(num, den, mode), scale: scale}
+}
+
+// Rem returns the remainder of d / e truncated toward zero, with the sign
+// of d. e must not be zero.
+func (d Decimal) Rem(e Decimal) Decimal {
+	x, y, scale := align(d, e)
+	return Decimal{unscaled: new(big.Int).Rem(x, y), scale: scale}
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.unscaled), scale: d.scale}
+}
+
+// Cmp compares the values of d and e, regardless of their scales.
+func (d Decimal) Cmp(e Decimal) int {
+	x, y, _ := align(d, e)
+	return x.Cmp(y)
+}
+
+// Round returns d rounded to the given number of fractional digits.
+func (d Decimal) Round(scale int32, mode RoundingMode) Decimal {
+	if scale >= d.scale {
+		return Decimal{unscaled: d.rescale(scale), scale: scale}
+	}
+	return Decimal{unscaled: roundQuo(d.unscaled, pow10(d.scale-scale), mode), scale: scale}
+}
+
+// Int returns the integer part of d, truncated toward zero.
+func (d Decimal) Int() *big.Int {
+	if d.scale <= 0 {
+		return d.rescale(0)
+	}
+	return new(big.Int).Quo(d.unscaled, pow10(d.scale))
+}
+
+// Float64 returns the float nearest to d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+func (d Decimal) String() string {
+	if d.scale <= 0 {
+		return d.rescale(0).String()
+	}
+
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+
+	sign := ""
+	if d.unscaled.Sign() < 0 {
+		sign = "-"
+	}
+	point := len(digits) - int(d.scale)
+
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// roundQuo returns num / den rounded to an integer.
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// The sign of the exact quotient, and how the remainder compares to
+	// half of the divisor
+	sign := num.Sign() * den.Sign()
+	half := new(big.Int).Abs(r)
+	half.Lsh(half, 1)
+	half2 := half.Cmp(new(big.Int).Abs(den))
+
+	var away bool
+	switch mode {
+	case HalfEven:
+		away = half2 > 0 || half2 == 0 && q.Bit(0) == 1
+	case HalfUp:
+		away = half2 >= 0
+	case HalfDown:
+		away = half2 > 0
+	case Up:
+		away = true
+	case Down:
+		away = false
+	case Ceiling:
+		away = sign > 0
+	case Floor:
+		away = sign < 0
+	}
+
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
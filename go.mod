@@ -1,7 +1,10 @@
 module bo
 
-go 1.22
+go 1.22.0
 
-require github.com/antlr4-go/antlr/v4 v4.13.1
+require (
+	github.com/antlr4-go/antlr/v4 v4.13.1
+	github.com/tetratelabs/wazero v1.9.0
+)
 
 require golang.org/x/exp v0.0.0-20240506185415-9bf2ced13842 // indirect
@@ -0,0 +1,395 @@
+package gogen
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"strconv"
+	"strings"
+)
+
+// call returns the code of a call, the type of its result, nil if none,
+// and whether the Go function called returns the elements of a tuple
+// result as several values.
+func (g *generator) call(call *ast.Call) (goExpr, checker.Type, bool) {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		v := g.lookup(fun.Name)
+		v.used = true
+		switch {
+		case v.builtin == "println":
+			args := make([]string, len(g.info.Calls[call].Rest))
+			for i, arg := range g.info.Calls[call].Rest {
+				args[i] = g.value(arg).code
+			}
+			return primary("rt.Println(" + strings.Join(args, ", ") + ")"), nil, false
+		case v.fn != nil:
+			return g.funcCall(call, v.code, nil, v.fn, v.outer)
+		}
+	case *ast.Member:
+		if fun.Safe && isOptional(g.info.Types[fun.X]) {
+			return g.safeCall(call, fun)
+		}
+
+		name := fun.Name.Name
+		switch x := g.info.Types[fun.X].(type) {
+		case *checker.Module:
+			return g.moduleCall(call, x, name)
+		case *checker.TypeName:
+			enum := x.Type.(*checker.Enum)
+			args := g.valuesAs(g.info.Calls[call].Args, enum.Case(name).Fields...)
+			return goExpr{code: g.enums[enum.Name].cases[name] + "{" + args + "}", prec: precPrimary, concrete: true}, enum, false
+		}
+
+		switch x := elemType(g.info.Types[fun.X]).(type) {
+		case *checker.Struct:
+			method := g.structs[x.Name].methods[name]
+			return g.funcCall(call, g.pointer(fun.X)+"."+method.code, fun.X, method.fn, method.outer)
+		case *checker.Iterator:
+			it := g.expr(fun.X).paren(precPrimary)
+			if name == "hasNext" {
+				return primary(it + ".HasNext()"), checker.Bool, false
+			}
+			if isOptional(x.Elem) {
+				return primary("rt.Flatten(" + it + ".Next())"), x.Elem, false
+			}
+			return primary(it + ".Next()"), checker.OptionalOf(x.Elem), false
+		case *checker.Chan:
+			return primary("close(" + g.expr(fun.X).code + ")"), nil, true
+		case *checker.Opaque:
+			return g.opaqueCall(call, x, g.expr(fun.X).paren(precPrimary), name)
+		}
+	}
+
+	g.errorf("cannot call %s", ast.String(call.Fun))
+	return goExpr{}, nil, false
+}
+
+// safeCall returns the code of x?.m(args), which calls m with x used as
+// its value unless x is nil, and is nil then.
+func (g *generator) safeCall(call *ast.Call, member *ast.Member) (goExpr, checker.Type, bool) {
+	x := g.expr(member.X)
+	elem := elemType(g.info.Types[member.X])
+
+	var v string
+	var code goExpr
+	var result checker.Type
+	var multi bool
+	g.block(func() {
+		v = g.temp("v")
+		id := &ast.Ident{Name: v}
+		g.scope.vars[v] = &variable{code: v, typ: elem, used: true}
+		g.info.Types[id] = elem
+		fun := &ast.Member{Span: member.Span, X: id, Name: member.Name}
+		narrowed := &ast.Call{Span: call.Span, Fun: fun, Args: call.Args}
+		g.info.Calls[narrowed] = g.info.Calls[call]
+		code, result, multi = g.call(narrowed)
+	})
+	if result == nil {
+		return code, nil, false
+	}
+	if multi {
+		code = primary("rt.NewTuple" + strconv.Itoa(len(result.(*checker.Tuple).Elems)) + "(" + code.code + ")")
+	}
+	if isInterface(result) {
+		g.errorf("%s of an any value is not supported", ast.String(call))
+	}
+
+	fn := "func(" + v + " " + g.goType(elem) + ") " + g.goType(result) + " { return " + code.code + " }"
+	if isOptional(result) {
+		return primary("rt.ThenOptional(" + x.code + ", " + fn + ")"), result, false
+	}
+	return primary("rt.Then(" + x.code + ", " + fn + ")"), checker.OptionalOf(result), false
+}
+
+// result returns the type of the value a call of a function declaration
+// returns, nil if none, and whether its Go function returns the elements
+// of a tuple as several values.
+func (g *generator) result(decl *ast.FuncDecl) (checker.Type, bool) {
+	var result checker.Type
+	if decl.Result != nil {
+		result = g.info.Types[decl.Result]
+	}
+	switch {
+	case decl.Async:
+		if result == nil {
+			result = checker.Nil
+		}
+		return checker.FutureOf(result), false
+	case g.info.Generators[decl]:
+		return result, false
+	}
+	_, multi := result.(*checker.Tuple)
+	return result, result != nil && multi
+}
+
+// funcCall returns the code of a call of a function declaration. Default
+// values are evaluated where the call is made; one that depends on other
+// parameters, or named arguments written out of the order of their
+// parameters, make the call a function literal that binds the parameters
+// first, in the order the arguments are written.
+func (g *generator) funcCall(call *ast.Call, fun string, recv ast.Expr, decl *ast.FuncDecl, outer *scope) (goExpr, checker.Type, bool) {
+	result, multi := g.result(decl)
+	c := g.info.Calls[call]
+
+	bound := c.Order != nil
+	for i, arg := range c.Args {
+		if arg == nil && g.usesParams(decl, decl.Params[i].Default) {
+			bound = true
+		}
+	}
+	if !bound {
+		var args []string
+		for i, arg := range c.Args {
+			if arg == nil {
+				args = append(args, g.defaultValue(decl.Params[i].Default, outer).code)
+			} else {
+				args = append(args, g.exprAs(arg, g.info.Types[decl.Params[i].Type]).code)
+			}
+		}
+		if len(c.Rest) > 0 {
+			args = append(args, g.valuesAs(c.Rest, g.info.Types[decl.Params[len(decl.Params)-1].Type]))
+		}
+		return primary(fun + "(" + strings.Join(args, ", ") + ")"), result, multi
+	}
+
+	// func() R { p1, p2 := arg1, arg2; p3 := default; return f(p1, p2, p3) }()
+	var code strings.Builder
+	g.block(func() {
+		var names, values []string
+		if recv != nil {
+			r := g.temp("recv")
+			names, values = append(names, r), append(values, fun[:strings.LastIndex(fun, ".")])
+			fun = r + fun[strings.LastIndex(fun, "."):]
+		}
+
+		params := newScope(outer)
+		if recv != nil {
+			params.vars[decl.Recv.Name.Name] = &variable{code: names[0], typ: elemType(g.info.Types[recv]), used: true}
+		}
+		var args []string
+		var defaults []string
+		given := make([]string, len(c.Args))
+		for i, arg := range c.Args {
+			param := decl.Params[i]
+			name := g.temp(goName(param.Name.Name))
+			args = append(args, name)
+			if arg != nil {
+				given[i] = g.typed(g.exprAs(arg, g.info.Types[param.Type]), g.info.Types[param.Type]).code
+			} else {
+				scope := g.scope
+				g.scope = params
+				defaults = append(defaults, name+" := "+g.typed(g.expr(param.Default), g.info.Types[param.Type]).code)
+				g.scope = scope
+			}
+			params.vars[param.Name.Name] = &variable{code: name, typ: g.info.Types[param.Type], used: true}
+		}
+		for _, i := range c.Written() {
+			names, values = append(names, args[i]), append(values, given[i])
+		}
+		if len(c.Rest) > 0 {
+			rest := g.temp("rest")
+			elem := g.info.Types[decl.Params[len(decl.Params)-1].Type]
+			names, values = append(names, rest), append(values, "[]"+g.goType(elem)+"{"+g.valuesAs(c.Rest, elem)+"}")
+			args = append(args, rest+"...")
+		}
+
+		code.WriteString("func() " + g.resultType(result, multi) + " {\n")
+		if len(names) > 0 {
+			code.WriteString(strings.Join(names, ", ") + " := " + strings.Join(values, ", ") + "\n")
+		}
+		for _, d := range defaults {
+			code.WriteString(d + "\n")
+		}
+		if result != nil {
+			code.WriteString("return ")
+		}
+		code.WriteString(fun + "(" + strings.Join(args, ", ") + ")\n}()")
+	})
+	return primary(code.String()), result, multi
+}
+
+// usesParams reports whether a default value refers to the parameters, or
+// the receiver, of the function it belongs to.
+func (g *generator) usesParams(decl *ast.FuncDecl, value ast.Expr) bool {
+	names := make(map[string]bool)
+	for _, param := range decl.Params {
+		names[param.Name.Name] = true
+	}
+	if decl.Recv != nil {
+		names[decl.Recv.Name.Name] = true
+	}
+
+	uses := false
+	ast.Inspect(value, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && names[id.Name] {
+			uses = true
+		}
+		return !uses
+	})
+	return uses
+}
+
+// defaultValue returns the code of a default value, which refers to the
+// variables in scope where its function is declared. Those must be the
+// ones in scope where it is called.
+func (g *generator) defaultValue(value ast.Expr, outer *scope) goExpr {
+	ast.Inspect(value, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			if lookupIn(outer, id.Name) != lookupIn(g.scope, id.Name) {
+				g.errorf("default value %s refers to %s, which is shadowed where the function is called", ast.String(value), id.Name)
+			}
+		}
+		return true
+	})
+
+	scope := g.scope
+	g.scope = newScope(outer)
+	defer func() { g.scope = scope }()
+
+	return g.expr(value)
+}
+
+func lookupIn(s *scope, name string) *variable {
+	for ; s != nil; s = s.outer {
+		if v, ok := s.vars[name]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// resultType returns the result of the signature of a Go function that
+// returns values of type t.
+func (g *generator) resultType(t checker.Type, multi bool) string {
+	switch {
+	case t == nil:
+		return ""
+	case multi:
+		return "(" + g.goTypes(t.(*checker.Tuple).Elems) + ")"
+	}
+	return g.goType(t)
+}
+
+// moduleCall returns the code of a call of a member of a module: the
+// builtins of Future and of the standard library.
+func (g *generator) moduleCall(call *ast.Call, module *checker.Module, name string) (goExpr, checker.Type, bool) {
+	member := module.Members[name]
+	if generic, ok := member.(*checker.Generic); ok {
+		rest := g.info.Calls[call].Rest
+		types := make([]checker.Type, len(rest))
+		for i, arg := range rest {
+			types[i] = g.info.Types[arg]
+		}
+		result, err := generic.Check(types)
+		if err != nil {
+			g.errorf("%s", err)
+		}
+
+		if module.Path == "Future" {
+			fn := map[string]string{"all": "All", "any": "AnyOf", "timeout": "Timeout"}[name]
+			return primary("rt." + fn + "(" + g.values(rest, false) + ")"), result, false
+		}
+
+		args := make([]string, len(rest))
+		for i, arg := range rest {
+			args[i] = g.iterator(arg)
+		}
+		fn := map[string]string{"take": "Take", "enumerate": "Enumerate", "chain": "Chain", "collect": "Collect"}[name]
+		if name == "take" {
+			args[1] = g.expr(rest[1]).code
+		}
+		if name == "zip" {
+			if len(args) > 4 {
+				g.errorf("iter.zip of more than 4 iterables is not supported")
+			}
+			fn = "Zip" + strconv.Itoa(len(args))
+		}
+		return primary("rt." + fn + "(" + strings.Join(args, ", ") + ")"), result, false
+	}
+
+	if name, ok := member.(*checker.TypeName); ok {
+		return primary("new(" + strings.TrimPrefix(g.goType(name.Type), "*") + ")"), name.Type, false
+	}
+
+	// The functions of bo/math/big, with their default values
+	fn := member.(*checker.Func)
+	c := g.info.Calls[call]
+	args := make([]string, len(c.Args))
+	for i, arg := range c.Args {
+		switch {
+		case arg != nil && c.Order != nil:
+			args[i] = g.typed(g.expr(arg), fn.Params[i]).code
+		case arg != nil:
+			args[i] = g.expr(arg).code
+		case fn.Names[i] == "mode":
+			args[i] = "rt.HalfEven"
+		default:
+			args[i] = "0"
+		}
+	}
+	funcs := map[string]string{"pow": "BigPow", "modpow": "BigModPow", "mod": "BigMod", "gcd": "BigGCD", "abs": "BigAbs", "round": "BigRound", "div": "BigDiv"}
+	if c.Order == nil {
+		return primary("rt." + funcs[name] + "(" + strings.Join(args, ", ") + ")"), fn.Result, false
+	}
+
+	// func() R { a2, a1 := arg2, arg1; return f(a1, a2) }()
+	var code string
+	g.block(func() {
+		var names, values []string
+		temps := make([]string, len(args))
+		for i := range args {
+			temps[i] = args[i]
+			if c.Args[i] != nil {
+				temps[i] = g.temp(goName(fn.Names[i]))
+			}
+		}
+		for _, i := range c.Order {
+			names, values = append(names, temps[i]), append(values, args[i])
+		}
+		code = "func() " + g.goType(fn.Result) + " {\n" + strings.Join(names, ", ") + " := " + strings.Join(values, ", ") + "\n"
+		code += "return rt." + funcs[name] + "(" + strings.Join(temps, ", ") + ")\n}()"
+	})
+	return primary(code), fn.Result, false
+}
+
+// iterator returns the code of an iterable as an iterator.
+func (g *generator) iterator(e ast.Expr) string {
+	code := g.expr(e)
+	switch t := g.info.Types[e].(type) {
+	case *checker.List:
+		return "rt.Values(" + code.code + ")"
+	case *checker.Map:
+		return code.paren(precPrimary) + ".Iterate()"
+	case *checker.Struct:
+		return code.paren(precPrimary) + ".iter()"
+	default:
+		if t == checker.Range {
+			return "rt.RangeValues(" + code.code + ")"
+		}
+	}
+	return code.code
+}
+
+// opaqueCall returns the code of a call of a method of a value the runtime
+// implements.
+func (g *generator) opaqueCall(call *ast.Call, t *checker.Opaque, recv, name string) (goExpr, checker.Type, bool) {
+	c := g.info.Calls[call]
+	switch name {
+	case "add":
+		n := "1"
+		if arg := c.Args[0]; arg != nil {
+			n = g.expr(arg).code
+			if _, _, ok := constant(arg); !ok {
+				n = "int(" + n + ")"
+			}
+		}
+		return primary(recv + ".Add(" + n + ")"), nil, false
+	case "contains":
+		return primary(recv + ".Contains(" + g.expr(c.Args[0]).code + ")"), checker.Bool, false
+	case "len":
+		return primary(recv + ".Len()"), checker.Int, false
+	case "reverse":
+		return primary(recv + ".Reverse()"), checker.Range, false
+	}
+	return primary(recv + "." + strings.ToUpper(name[:1]) + name[1:] + "()"), nil, false
+}
@@ -0,0 +1,367 @@
+package gogen
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// structType is a struct of the program, and the methods declared on it.
+type structType struct {
+	name    string
+	decl    *ast.StructDecl
+	methods map[string]*variable
+
+	// The type the checker gave the struct
+	typ checker.Type
+
+	// Whether the struct has a string operator, which is its String method
+	stringer bool
+}
+
+// enumType is an enum of the program: an interface, and a struct type for
+// each case, whose fields F0, F1, ... hold the values of the case.
+type enumType struct {
+	name  string
+	cases map[string]string
+}
+
+// operatorNames holds the base names of the methods of operators, by
+// symbol.
+var operatorNames = map[string]string{
+	"+": "OpAdd", "-": "OpSub", "*": "OpMul", "/": "OpDiv", "%": "OpRem",
+	"==": "OpEqual", "<": "OpLess", "[]": "OpIndex", "string": "String",
+}
+
+// declareTypes names the structs, enums and operator methods of the top
+// level before any code is generated, and collects the identifiers of the
+// program.
+func (g *generator) declareTypes(prog *ast.Program) {
+	ast.Inspect(prog, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			g.names[id.Name] = true
+		}
+		return true
+	})
+
+	// The checker does not record the types declarations declare, but the
+	// types of the expressions of a struct have it
+	types := make(map[string]checker.Type)
+	var named func(t checker.Type)
+	named = func(t checker.Type) {
+		switch t := t.(type) {
+		case *checker.Struct:
+			types[t.Name] = t
+		case *checker.Optional:
+			named(t.Elem)
+		}
+	}
+	for _, t := range g.info.Types {
+		named(t)
+	}
+
+	operators := make(map[string][]*ast.FuncDecl)
+	for _, stmt := range prog.Stmts {
+		g.node = stmt
+		switch stmt := stmt.(type) {
+		case *ast.StructDecl:
+			typ := types[stmt.Name.Name]
+			if typ == nil {
+				typ = &checker.Struct{Name: stmt.Name.Name}
+			}
+			g.structs[stmt.Name.Name] = &structType{name: goName(stmt.Name.Name), decl: stmt, methods: make(map[string]*variable), typ: typ}
+		case *ast.EnumDecl:
+			enum := &enumType{name: goName(stmt.Name.Name), cases: make(map[string]string)}
+			for _, c := range stmt.Cases {
+				enum.cases[c.Name.Name] = stmt.Name.Name + c.Name.Name
+			}
+			g.enums[stmt.Name.Name] = enum
+		case *ast.FuncDecl:
+			if !stmt.Operator {
+				break
+			}
+
+			name := operatorNames[stmt.Name.Name]
+			if name == "OpSub" && len(stmt.Params) == 0 {
+				name = "OpNeg"
+			}
+			g.operators[stmt] = name
+			key := stmt.Recv.Type.Name.Name + "." + name
+			operators[key] = append(operators[key], stmt)
+			if name == "String" {
+				g.structs[stmt.Recv.Type.Name.Name].stringer = true
+			}
+		}
+	}
+
+	// Overloads of an operator are told apart by the type of their parameter
+	for _, decls := range operators {
+		if len(decls) < 2 {
+			continue
+		}
+		for _, decl := range decls {
+			g.operators[decl] += typeSuffix(g.info.Types[decl.Params[0].Type])
+		}
+	}
+}
+
+// typeSuffix returns the name of a type as part of a Go identifier.
+func typeSuffix(t checker.Type) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range t.String() {
+		switch {
+		case r == '[':
+			b.WriteString("List")
+			upper = true
+		case r == '?':
+			b.WriteString("Opt")
+			upper = true
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if upper {
+				r = unicode.ToUpper(r)
+			}
+			b.WriteRune(r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	return b.String()
+}
+
+// structDecl generates a struct type, and the String method that prints
+// its values as Bo does unless its string operator does.
+func (g *generator) structDecl(decl *ast.StructDecl) {
+	st := g.structs[decl.Name.Name]
+
+	var b strings.Builder
+	b.WriteString("type " + st.name + " struct {\n")
+	for _, field := range decl.Fields {
+		b.WriteString(exported(field.Name.Name) + " " + g.goType(g.info.Types[field.Type]) + "\n")
+	}
+	b.WriteString("}\n")
+
+	if !st.stringer {
+		recv := receiverName(st.name)
+		args := []string{strconv.Quote(decl.Name.Name)}
+		for _, field := range decl.Fields {
+			args = append(args, strconv.Quote(field.Name.Name), recv+"."+exported(field.Name.Name))
+		}
+		b.WriteString("\nfunc (" + recv + " " + st.name + ") String() string {\n")
+		b.WriteString("return rt.Struct(" + strings.Join(args, ", ") + ")\n}\n")
+	}
+	g.decls = append(g.decls, b.String())
+}
+
+// receiverName returns the name of the receiver of the methods generated
+// for a type.
+func receiverName(typeName string) string {
+	return strings.ToLower(typeName[:1])
+}
+
+// enumDecl generates the interface of an enum and the struct of each of
+// its cases.
+func (g *generator) enumDecl(decl *ast.EnumDecl) {
+	enum := g.enums[decl.Name.Name]
+	marker := "is" + decl.Name.Name
+
+	var b strings.Builder
+	b.WriteString("type " + enum.name + " interface {\n" + marker + "()\n}\n")
+	for _, c := range decl.Cases {
+		name := enum.cases[c.Name.Name]
+		b.WriteString("\ntype " + name + " struct {\n")
+		args := []string{strconv.Quote(decl.Name.Name + "." + c.Name.Name)}
+		for i, field := range c.Fields {
+			b.WriteString("F" + strconv.Itoa(i) + " " + g.goType(g.info.Types[field]) + "\n")
+			args = append(args, "v.F"+strconv.Itoa(i))
+		}
+		b.WriteString("}\n\n")
+
+		b.WriteString("func (" + name + ") " + marker + "() {}\n\n")
+		recv := "v"
+		if len(c.Fields) == 0 {
+			recv = "_"
+		}
+		b.WriteString("func (" + recv + " " + name + ") String() string {\n")
+		b.WriteString("return rt.Case(" + strings.Join(args, ", ") + ")\n}\n")
+	}
+	g.decls = append(g.decls, b.String())
+}
+
+// funcDecl generates a function of the top level, or a method.
+func (g *generator) funcDecl(decl *ast.FuncDecl) {
+	if decl.Recv == nil {
+		v := g.declare(decl.Name.Name, nil)
+		v.fn, v.outer = decl, g.scope
+
+		params, result := g.signature(decl)
+		g.decls = append(g.decls, "func "+v.code+params+result+" "+g.funcBody(decl, nil)+"\n")
+		return
+	}
+
+	st := g.structs[decl.Recv.Type.Name.Name]
+	if st == nil {
+		g.errorf("methods of %s are not supported", ast.String(decl.Recv.Type))
+	}
+
+	name := g.operators[decl]
+	if !decl.Operator {
+		name = goName(decl.Name.Name)
+		if name == "String" || name == "Unpack" {
+			name += "_"
+		}
+		for _, field := range st.decl.Fields {
+			if exported(field.Name.Name) == name {
+				name += "_"
+			}
+		}
+		st.methods[decl.Name.Name] = &variable{code: name, fn: decl, outer: g.scope}
+	}
+
+	recv := &variable{code: goName(decl.Recv.Name.Name), typ: st.typ}
+	params, result := g.signature(decl)
+	code := "func (" + recv.code + " " + st.name + ") " + name + params + result + " " + g.funcBody(decl, recv) + "\n"
+	g.decls = append(g.decls, code)
+}
+
+// closure generates a function declared in a block, as a function literal
+// bound to a variable.
+func (g *generator) closure(decl *ast.FuncDecl) {
+	if decl.Recv != nil {
+		g.errorf("methods must be declared at the top level")
+	}
+
+	v := g.declare(decl.Name.Name, nil)
+	v.fn, v.outer = decl, g.scope
+
+	// A function that calls itself must be declared before it is set
+	recursive := false
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == decl.Name.Name {
+			recursive = true
+		}
+		return !recursive
+	})
+
+	params, result := g.signature(decl)
+	fn := "func" + params + result
+	body := g.funcBody(decl, nil)
+	if recursive {
+		g.line("var %s %s", v.code, fn)
+		g.line("%s = %s %s", v.code, fn, body)
+	} else {
+		g.line("%s := %s %s", v.code, fn, body)
+	}
+	g.line("%s", g.later(func() string {
+		if v.used {
+			return ""
+		}
+		return "_ = " + v.code
+	}))
+}
+
+// signature returns the parameters and the result of the Go function of a
+// function declaration.
+func (g *generator) signature(decl *ast.FuncDecl) (string, string) {
+	params := make([]string, len(decl.Params))
+	for i, param := range decl.Params {
+		t := g.goType(g.info.Types[param.Type])
+		if param.Variadic {
+			t = "..." + t
+		}
+		params[i] = goName(param.Name.Name) + " " + t
+	}
+
+	result := g.resultType(g.result(decl))
+	if result != "" {
+		result = " " + result
+	}
+	return "(" + strings.Join(params, ", ") + ")", result
+}
+
+// funcBody returns the body of the Go function of a function declaration.
+// An async function starts its body as a task and returns its future, a
+// generator returns an iterator over the values its body yields.
+func (g *generator) funcBody(decl *ast.FuncDecl, recv *variable) string {
+	fn := g.fn
+	defer func() { g.fn = fn }()
+
+	var body string
+	g.block(func() {
+		if recv != nil {
+			recv.used = true
+			g.scope.vars[decl.Recv.Name.Name] = recv
+		}
+		for _, param := range decl.Params {
+			t := g.info.Types[param.Type]
+			if param.Variadic {
+				t = checker.ListOf(t)
+			}
+			g.declare(param.Name.Name, t).used = true
+		}
+
+		var result checker.Type
+		if decl.Result != nil {
+			result = g.info.Types[decl.Result]
+		}
+
+		switch {
+		case decl.Async:
+			void := result == nil
+			if void {
+				result = checker.Nil
+			}
+			g.fn = newFuncState(decl, result, false)
+			stmts := g.stmts(decl.Body.Stmts)
+			if void && !endsInReturn(decl.Body.Stmts) {
+				stmts += "return nil\n"
+			}
+			body = "return rt.Start(func() " + g.goType(result) + " {\n" + stmts + "})\n"
+		case g.info.Generators[decl]:
+			g.fn = newFuncState(decl, nil, false)
+			elem := result.(*checker.Iterator).Elem
+			body = "return rt.Generate(func(yield func(" + g.goType(elem) + ")) {\n" + g.stmts(decl.Body.Stmts) + "})\n"
+		default:
+			_, multi := result.(*checker.Tuple)
+			g.fn = newFuncState(decl, result, multi)
+			body = g.stmts(decl.Body.Stmts)
+		}
+	})
+	return "{\n" + body + "}"
+}
+
+func endsInReturn(stmts []ast.Stmt) bool {
+	if len(stmts) == 0 {
+		return false
+	}
+	_, ok := stmts[len(stmts)-1].(*ast.Return)
+	return ok
+}
+
+// newFuncState returns the state of a function with the given result. The
+// switches it ends with must return, which Go does not see unless they
+// have a default arm.
+func newFuncState(decl *ast.FuncDecl, result checker.Type, multi bool) *funcState {
+	fn := &funcState{decl: decl, result: result, multi: multi, terminal: make(map[*ast.Switch]bool)}
+	if result == nil || result == checker.Nil {
+		return fn
+	}
+
+	var terminal func(stmts []ast.Stmt)
+	terminal = func(stmts []ast.Stmt) {
+		if len(stmts) == 0 {
+			return
+		}
+		if s, ok := stmts[len(stmts)-1].(*ast.Switch); ok {
+			fn.terminal[s] = true
+			for _, arm := range s.Arms {
+				terminal(arm.Body.Stmts)
+			}
+		}
+	}
+	terminal(decl.Body.Stmts)
+	return fn
+}
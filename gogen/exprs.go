@@ -0,0 +1,590 @@
+package gogen
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"strconv"
+	"strings"
+)
+
+// expr returns the code of an expression, as a value of the type the
+// checker gave it after implicit conversions.
+func (g *generator) expr(e ast.Expr) goExpr {
+	code, natural := g.raw(e)
+	return g.convert(code, natural, g.info.Types[e])
+}
+
+// value returns the code of an expression whose Go type must be that of its
+// Bo type, where Go infers the type from the value.
+func (g *generator) value(e ast.Expr) goExpr {
+	return g.typed(g.expr(e), g.info.Types[e])
+}
+
+// exprAs returns the code of an expression used as a value of type t. An
+// untyped constant used as a value of an interface type is given the type
+// of its own, which Go would not.
+func (g *generator) exprAs(e ast.Expr, t checker.Type) goExpr {
+	if isInterface(t) {
+		return g.value(e)
+	}
+	return g.expr(e)
+}
+
+// valuesAs returns the code of expressions used as values of the given
+// types, or all of the one type given, separated by commas.
+func (g *generator) valuesAs(exprs []ast.Expr, types ...checker.Type) string {
+	codes := make([]string, len(exprs))
+	for i, e := range exprs {
+		codes[i] = g.exprAs(e, types[min(i, len(types)-1)]).code
+	}
+	return strings.Join(codes, ", ")
+}
+
+// values returns the code of expressions, separated by commas.
+func (g *generator) values(exprs []ast.Expr, typed bool) string {
+	codes := make([]string, len(exprs))
+	for i, e := range exprs {
+		if typed {
+			codes[i] = g.value(e).code
+		} else {
+			codes[i] = g.expr(e).code
+		}
+	}
+	return strings.Join(codes, ", ")
+}
+
+// raw returns the code of an expression and the type of its value before
+// any implicit conversion.
+func (g *generator) raw(e ast.Expr) (goExpr, checker.Type) {
+	node := g.node
+	g.node = e
+	defer func() { g.node = node }()
+
+	if lit, neg, ok := constant(e); ok {
+		t := elemType(g.info.Types[e])
+		if isAny(t) {
+			t = checker.Int
+			if lit.Kind == ast.Float {
+				t = checker.Float
+			}
+		}
+		return g.constant(lit, neg, t), t
+	}
+
+	switch e := e.(type) {
+	case *ast.Ident:
+		return g.ident(e)
+	case *ast.BasicLit:
+		switch e.Kind {
+		case ast.BigInt:
+			return g.constant(e, false, checker.BigInt), checker.BigInt
+		case ast.Decimal:
+			return g.constant(e, false, checker.Decimal), checker.Decimal
+		case ast.Bool:
+			return primary(e.Value), checker.Bool
+		default:
+			return goExpr{code: "nil", prec: precPrimary, isNil: true}, checker.Nil
+		}
+	case *ast.StringLit:
+		return g.stringLit(e), checker.String
+	case *ast.Paren:
+		return g.expr(e.X), g.info.Types[e.X]
+	case *ast.ListLit:
+		t := elemType(g.info.Types[e])
+		return primary(g.goType(t) + "{" + g.valuesAs(e.Elems, t.(*checker.List).Elem) + "}"), t
+	case *ast.MapLit:
+		return g.mapLit(e)
+	case *ast.TupleLit:
+		t := elemType(g.info.Types[e])
+		return primary(g.goType(t) + "{" + g.valuesAs(e.Elems, t.(*checker.Tuple).Elems...) + "}"), t
+	case *ast.StructLit:
+		return g.structLit(e)
+	case *ast.Match:
+		return g.match(e)
+	case *ast.Conversion:
+		return g.conversion(e)
+	case *ast.Member:
+		return g.member(e)
+	case *ast.Call:
+		code, t, multi := g.call(e)
+		if multi {
+			code = primary("rt.NewTuple" + strconv.Itoa(len(t.(*checker.Tuple).Elems)) + "(" + code.code + ")")
+		}
+		if t == nil {
+			g.errorf("%s (no value) used as value", ast.String(e.Fun))
+		}
+		return code, t
+	case *ast.Index:
+		return g.index(e)
+	case *ast.Unary:
+		return g.unary(e)
+	case *ast.Receive:
+		elem := g.info.Types[e.Chan].(*checker.Chan).Elem
+		ch := g.expr(e.Chan)
+		switch {
+		case isInterface(elem) && !isEnum(elem):
+			return goExpr{code: "<-" + ch.code, prec: precUnary}, checker.OptionalOf(elem)
+		case isOptional(elem):
+			return primary("rt.Flatten(rt.Receive(" + ch.code + "))"), elem
+		}
+		return primary("rt.Receive(" + ch.code + ")"), checker.OptionalOf(elem)
+	case *ast.Await:
+		return primary(g.expr(e.X).paren(precPrimary) + ".Await()"), g.info.Types[e.X].(*checker.Future).Elem
+	case *ast.Binary:
+		return g.binary(e)
+	case *ast.Range:
+		return primary("rt.NewRange(" + g.values([]ast.Expr{e.From, e.To}, false) + ", " + strconv.FormatBool(e.Exclusive) + ")"), checker.Range
+	case *ast.Step:
+		return primary(g.expr(e.X).paren(precPrimary) + ".Every(" + g.expr(e.Step).code + ")"), checker.Range
+	}
+
+	g.errorf("unhandled expression %s", ast.String(e))
+	return goExpr{}, nil
+}
+
+func isOptional(t checker.Type) bool {
+	_, ok := t.(*checker.Optional)
+	return ok
+}
+
+func isEnum(t checker.Type) bool {
+	_, ok := t.(*checker.Enum)
+	return ok
+}
+
+// constant returns the literal of an expression that is an untyped numeric
+// constant, and whether it is negated.
+func constant(e ast.Expr) (*ast.BasicLit, bool, bool) {
+	neg := false
+	if unary, ok := e.(*ast.Unary); ok && unary.Op == "-" {
+		neg, e = true, unary.X
+	}
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != ast.Int && lit.Kind != ast.Float {
+		return nil, false, false
+	}
+	return lit, neg, true
+}
+
+// constant returns the code of a numeric literal as a value of type t.
+// Literals are written in their plainest form, as the interpreters read
+// them.
+func (g *generator) constant(lit *ast.BasicLit, neg bool, t checker.Type) goExpr {
+	b := t.(*checker.Basic)
+
+	var e goExpr
+	switch {
+	case b == checker.Decimal:
+		d, err := ast.DecimalLiteral(lit.Value)
+		if err != nil {
+			f, _ := ast.FloatLiteral(lit.Value)
+			return primary("rt.Convert[decimal.Decimal](" + g.floatCode(f, neg) + ")")
+		}
+		if neg {
+			d = d.Neg()
+		}
+		return primary("rt.Decimal(" + strconv.Quote(d.String()) + ")")
+	case b == checker.BigInt:
+		i := ast.BigIntLiteral(lit.Value)
+		if neg {
+			i.Neg(i)
+		}
+		if i.IsInt64() {
+			return primary("big.NewInt(" + i.String() + ")")
+		}
+		return primary("rt.BigInt(" + strconv.Quote(i.String()) + ")")
+	case b.IsFloat():
+		f := ast.FloatValue(lit)
+		e = goExpr{code: g.floatCode(f, neg), untyped: "float64"}
+		if f == 0 && neg {
+			// Go constants have no negative zero
+			e = goExpr{code: "-rt.Var(" + g.goType(t) + "(0))"}
+		}
+	default:
+		i := ast.BigIntLiteral(lit.Value)
+		if neg {
+			i.Neg(i)
+		}
+		e = goExpr{code: i.String(), untyped: "int"}
+	}
+
+	e.prec = precPrimary
+	if strings.HasPrefix(e.code, "-") {
+		e.prec = precUnary
+	}
+	return e
+}
+
+func (g *generator) floatCode(f float64, neg bool) string {
+	if neg {
+		f = -f
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+	return s
+}
+
+// ident returns the code of a variable. An optional variable that a nil
+// check proved not nil is used as its value.
+func (g *generator) ident(id *ast.Ident) (goExpr, checker.Type) {
+	v := g.lookup(id.Name)
+	v.used = true
+	if v.builtin != "" || v.fn != nil {
+		g.errorf("%s cannot be used as a value", id.Name)
+	}
+
+	t := g.info.Types[id]
+	if o, ok := v.typ.(*checker.Optional); ok && !isOptional(t) && !isAny(t) && !isAny(o.Elem) {
+		return goExpr{code: "*" + v.code, prec: precUnary}, o.Elem
+	}
+	return primary(v.code), v.typ
+}
+
+// pointer returns the code of an expression whose fields or methods are
+// used: an optional variable proved not nil is used as the pointer it is,
+// which Go dereferences.
+func (g *generator) pointer(e ast.Expr) string {
+	if id, ok := e.(*ast.Ident); ok {
+		v := g.lookup(id.Name)
+		if o, ok := v.typ.(*checker.Optional); ok && !isAny(o.Elem) {
+			if _, ok := o.Elem.(*checker.Struct); ok {
+				v.used = true
+				return v.code
+			}
+		}
+	}
+	return g.expr(e).paren(precPrimary)
+}
+
+// stringLit returns the code of a string literal, the concatenation of its
+// parts.
+func (g *generator) stringLit(lit *ast.StringLit) goExpr {
+	var parts []string
+	for _, part := range lit.Parts {
+		if part.Expr == nil {
+			if part.Text != "" {
+				parts = append(parts, strconv.Quote(part.Text))
+			}
+			continue
+		}
+
+		if g.info.Types[part.Expr] == checker.String {
+			parts = append(parts, g.expr(part.Expr).paren(precAdd))
+		} else {
+			parts = append(parts, "rt.String("+g.value(part.Expr).code+")")
+		}
+	}
+
+	switch len(parts) {
+	case 0:
+		return primary(`""`)
+	case 1:
+		if strings.HasPrefix(parts[0], `"`) || strings.HasPrefix(parts[0], "rt.String(") {
+			return primary(parts[0])
+		}
+		return goExpr{code: parts[0], prec: precAdd}
+	}
+	return goExpr{code: strings.Join(parts, " + "), prec: precAdd}
+}
+
+// mapLit returns a chain of calls that builds a map.
+func (g *generator) mapLit(lit *ast.MapLit) (goExpr, checker.Type) {
+	t := elemType(g.info.Types[lit])
+	m := t.(*checker.Map)
+	if m.Key == checker.Nil {
+		t = checker.MapOf(checker.String, checker.Nil)
+	}
+
+	var b strings.Builder
+	b.WriteString("rt.NewMap[" + g.goType(t.(*checker.Map).Key) + ", " + g.goType(t.(*checker.Map).Value) + "]()")
+	for _, entry := range lit.Entries {
+		// Long literals put an entry on each line
+		if len(lit.Entries) > 2 {
+			b.WriteString(".\n")
+		} else {
+			b.WriteString(".")
+		}
+		b.WriteString("Set(" + g.valuesAs([]ast.Expr{entry.Key, entry.Value}, t.(*checker.Map).Key, t.(*checker.Map).Value) + ")")
+	}
+	return primary(b.String()), t
+}
+
+func (g *generator) structLit(lit *ast.StructLit) (goExpr, checker.Type) {
+	st := g.structs[lit.Type.Name]
+	if st == nil {
+		g.errorf("%s is not a struct declared at the top level", lit.Type.Name)
+	}
+
+	t := st.typ.(*checker.Struct)
+	fields := make([]string, len(lit.Fields))
+	for i, field := range lit.Fields {
+		fields[i] = exported(field.Name.Name) + ": " + g.exprAs(field.Value, t.Field(field.Name.Name).Type).code
+	}
+	return primary(st.name + "{" + strings.Join(fields, ", ") + "}"), elemType(g.info.Types[lit])
+}
+
+// conversion returns the code of T(x), which checks at runtime that a
+// numeric value fits T.
+func (g *generator) conversion(conv *ast.Conversion) (goExpr, checker.Type) {
+	target := g.info.Types[conv.Type]
+	operand := g.info.Types[conv.X]
+
+	if o := g.info.Operators[conv]; o != nil {
+		return primary(g.pointer(conv.X) + "." + g.operators[o.Decl] + "()"), checker.String
+	}
+	if ch, ok := target.(*checker.Chan); ok {
+		return primary("make(chan " + g.goType(ch.Elem) + ", " + g.expr(conv.X).code + ")"), target
+	}
+
+	x := g.expr(conv.X)
+	if operand == target {
+		return g.typed(x, target), target
+	}
+	return g.explicit(x, operand, target), target
+}
+
+// member returns the code of x.name, where name is not called.
+func (g *generator) member(e *ast.Member) (goExpr, checker.Type) {
+	name := e.Name.Name
+	switch x := g.info.Types[e.X].(type) {
+	case *checker.Module:
+		if x.Path == "bo/math/big" {
+			return primary("rt." + name), checker.Int
+		}
+	case *checker.TypeName:
+		if enum, ok := x.Type.(*checker.Enum); ok {
+			return goExpr{code: g.enums[enum.Name].cases[name] + "{}", prec: precPrimary, concrete: true}, enum
+		}
+	case *checker.Optional:
+		st, ok := x.Elem.(*checker.Struct)
+		if !ok || st.Field(name) == nil {
+			break
+		}
+
+		// x?.name is nil if x is
+		field := st.Field(name).Type
+		v := g.temp("v")
+		fn := "func(" + v + " " + g.goType(st) + ") " + g.goType(field) + " { return " + v + "." + exported(name) + " }"
+		if isOptional(field) {
+			return primary("rt.ThenOptional(" + g.expr(e.X).code + ", " + fn + ")"), field
+		}
+		if isInterface(field) {
+			g.errorf("%s?.%s of an any value is not supported", ast.String(e.X), name)
+		}
+		return primary("rt.Then(" + g.expr(e.X).code + ", " + fn + ")"), checker.OptionalOf(field)
+	case *checker.Struct:
+		if field := x.Field(name); field != nil {
+			code := primary(g.pointer(e.X) + "." + exported(name))
+			if e.Safe {
+				return g.convert(code, field.Type, checker.OptionalOf(field.Type)), checker.OptionalOf(field.Type)
+			}
+			return code, field.Type
+		}
+	}
+
+	g.errorf("%s cannot be used as a value", ast.String(e))
+	return goExpr{}, nil
+}
+
+func (g *generator) index(e *ast.Index) (goExpr, checker.Type) {
+	if o := g.info.Operators[e]; o != nil {
+		code := g.pointer(e.X) + "." + g.operators[o.Decl] + "(" + g.expr(e.Index).code + ")"
+		return primary(code), g.info.Types[o.Decl.Result]
+	}
+
+	x := g.expr(e.X)
+	switch t := g.info.Types[e.X].(type) {
+	case *checker.List:
+		if g.info.Types[e.Index] == checker.Range {
+			return primary("rt.Slice(" + x.code + ", " + g.expr(e.Index).code + ")"), t
+		}
+		return primary("rt.Index(" + x.code + ", " + g.expr(e.Index).code + ")"), t.Elem
+	case *checker.Map:
+		get := x.paren(precPrimary) + ".Get(" + g.expr(e.Index).code + ")"
+		switch {
+		case isInterface(t.Value) && !isEnum(t.Value):
+			return primary("rt.Or(" + get + ", nil)"), checker.OptionalOf(t.Value)
+		case isOptional(t.Value):
+			return primary("rt.Flatten(" + get + ")"), t.Value
+		}
+		return primary(get), checker.OptionalOf(t.Value)
+	}
+
+	g.errorf("cannot index %s", ast.String(e.X))
+	return goExpr{}, nil
+}
+
+func (g *generator) unary(e *ast.Unary) (goExpr, checker.Type) {
+	if o := g.info.Operators[e]; o != nil {
+		return primary(g.pointer(e.X) + "." + g.operators[o.Decl] + "()"), g.info.Types[o.Decl.Result]
+	}
+
+	x := g.expr(e.X)
+	t := g.info.Types[e.X]
+	if e.Op == "!" {
+		return goExpr{code: "!" + x.paren(precUnary), prec: precUnary}, checker.Bool
+	}
+
+	switch t {
+	case checker.BigInt:
+		return primary("new(big.Int).Neg(" + x.code + ")"), t
+	case checker.Decimal:
+		return primary(x.paren(precPrimary) + ".Neg()"), t
+	case checker.Float, checker.Float32:
+		return goExpr{code: "-" + x.paren(precUnary), prec: precUnary}, t
+	}
+	return primary("rt.Neg(" + g.typed(x, t).code + ")"), t
+}
+
+// binary returns the code of a binary expression. Integer arithmetic is
+// checked by the runtime, the wrapping operators are Go's own.
+func (g *generator) binary(e *ast.Binary) (goExpr, checker.Type) {
+	if o := g.info.Operators[e]; o != nil {
+		recv, arg := e.X, e.Y
+		if o.Swap {
+			recv, arg = arg, recv
+		}
+		code := primary(g.pointer(recv) + "." + g.operators[o.Decl] + "(" + g.exprAs(arg, g.info.Types[o.Decl.Params[0].Type]).code + ")")
+		if o.Negate {
+			code = goExpr{code: "!" + code.code, prec: precUnary}
+		}
+		return code, g.info.Types[o.Decl.Result]
+	}
+
+	switch e.Op {
+	case "&&":
+		return goExpr{code: g.expr(e.X).paren(precAnd) + " && " + g.expr(e.Y).paren(precAnd+1), prec: precAnd}, checker.Bool
+	case "||":
+		return goExpr{code: g.expr(e.X).paren(precOr) + " || " + g.expr(e.Y).paren(precOr+1), prec: precOr}, checker.Bool
+	case "??":
+		return g.coalesce(e)
+	}
+
+	t := g.info.Types[e.X]
+	x, y := g.expr(e.X), g.expr(e.Y)
+	if x.untyped != "" && y.untyped != "" {
+		x = primary("rt.Var(" + g.typed(x, t).code + ")")
+	}
+	infix := func(op string, prec int) goExpr {
+		return goExpr{code: x.paren(prec) + " " + op + " " + y.paren(prec+1), prec: prec}
+	}
+	b, _ := t.(*checker.Basic)
+
+	switch e.Op {
+	case "==", "!=":
+		switch {
+		case isNilLit(e.X) || isNilLit(e.Y):
+			return infix(e.Op, precCompare), checker.Bool
+		case b != nil && b.IsBig():
+			return goExpr{code: x.paren(precPrimary) + ".Cmp(" + y.code + ") " + e.Op + " 0", prec: precCompare}, checker.Bool
+		case b != nil && !isAny(b), t == checker.Range, isChan(t):
+			return infix(e.Op, precCompare), checker.Bool
+		}
+		code := goExpr{code: "rt.Equal(" + g.typed(x, t).code + ", " + g.typed(y, t).code + ")", prec: precPrimary}
+		if e.Op == "!=" {
+			code = goExpr{code: "!" + code.code, prec: precUnary}
+		}
+		return code, checker.Bool
+	case "<", "<=", ">", ">=":
+		if b.IsBig() {
+			return goExpr{code: x.paren(precPrimary) + ".Cmp(" + y.code + ") " + e.Op + " 0", prec: precCompare}, checker.Bool
+		}
+		return infix(e.Op, precCompare), checker.Bool
+	}
+
+	op := strings.TrimSuffix(e.Op, "%")
+	if e.Op == "%" {
+		op = "%"
+	}
+	prec := precAdd
+	if op == "*" || op == "/" || op == "%" {
+		prec = precMul
+	}
+
+	switch {
+	case b == checker.String, b.IsFloat(), e.Op != op:
+		return infix(op, prec), t
+	case b == checker.BigInt:
+		switch op {
+		case "/":
+			return primary("rt.QuoBig(" + x.code + ", " + y.code + ")"), t
+		case "%":
+			return primary("rt.RemBig(" + x.code + ", " + y.code + ")"), t
+		}
+		return primary("new(big.Int)." + arith[op] + "(" + x.code + ", " + y.code + ")"), t
+	case b == checker.Decimal:
+		switch op {
+		case "/":
+			return primary("rt.QuoDecimal(" + x.code + ", " + y.code + ")"), t
+		case "%":
+			return primary("rt.RemDecimal(" + x.code + ", " + y.code + ")"), t
+		}
+		return primary(x.paren(precPrimary) + "." + arith[op] + "(" + y.code + ")"), t
+	}
+
+	return primary("rt." + arith[op] + "(" + g.typed(x, t).code + ", " + y.code + ")"), t
+}
+
+// arith holds the names of the functions and methods of arithmetic
+// operations, by operator.
+var arith = map[string]string{"+": "Add", "-": "Sub", "*": "Mul", "/": "Div", "%": "Rem"}
+
+func isNilLit(e ast.Expr) bool {
+	lit, ok := e.(*ast.BasicLit)
+	return ok && lit.Kind == ast.Nil
+}
+
+func isChan(t checker.Type) bool {
+	_, ok := t.(*checker.Chan)
+	return ok
+}
+
+// coalesce returns the code of x ?? y, which only evaluates y if x is nil.
+func (g *generator) coalesce(e *ast.Binary) (goExpr, checker.Type) {
+	left, right := g.info.Types[e.X], g.info.Types[e.Y]
+	switch {
+	case left == checker.Nil:
+		return g.expr(e.Y), right
+	case right == checker.Nil:
+		return g.expr(e.X), checker.OptionalOf(left)
+	case !isOptional(left):
+		return g.expr(e.X), left
+	case isInterface(left) || isInterface(right) && !isEnum(right):
+		g.errorf("?? on any values is not supported")
+	}
+
+	x := g.expr(e.X)
+	y := g.expr(e.Y)
+	if isOptional(right) {
+		return primary("rt.OrOptional(" + x.code + ", func() " + g.goType(right) + " { return " + y.code + " })"), right
+	}
+	if pure(e.Y) {
+		return primary("rt.Or(" + x.code + ", " + g.typed(y, right).code + ")"), right
+	}
+	return primary("rt.OrElse(" + x.code + ", func() " + g.goType(right) + " { return " + y.code + " })"), right
+}
+
+// pure reports whether evaluating an expression has no effect and cannot
+// fail, so that it may be evaluated eagerly or more than once.
+func pure(e ast.Expr) bool {
+	if _, _, ok := constant(e); ok {
+		return true
+	}
+
+	switch e := e.(type) {
+	case *ast.Ident, *ast.BasicLit:
+		return true
+	case *ast.StringLit:
+		for _, part := range e.Parts {
+			if part.Expr != nil {
+				return false
+			}
+		}
+		return true
+	case *ast.Paren:
+		return pure(e.X)
+	}
+	return false
+}
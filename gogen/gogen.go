@@ -0,0 +1,375 @@
+// Package gogen translates a checked program to an equivalent Go program,
+// which the Go toolchain builds to a native binary. The Go program is meant
+// to be read: Bo's types become Go types, its functions Go functions and its
+// patterns conditions on the matched value. What Go does not have is done by
+// the runtime support package bo/gogen/rt.
+//
+// Top-level variables become package variables, set in order by main with
+// the rest of the top-level statements. Structs, enums and functions must be
+// declared at the top level, except functions, which become closures when
+// declared in a block.
+package gogen
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"bytes"
+	"fmt"
+	goast "go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Generate returns the source of a Go program that does what a checked
+// program does.
+func Generate(prog *ast.Program, info *checker.Info) (src []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if gErr, ok := r.(*genError); ok {
+				src, err = nil, gErr
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	g := &generator{
+		info:      info,
+		names:     make(map[string]bool),
+		structs:   make(map[string]*structType),
+		enums:     make(map[string]*enumType),
+		operators: make(map[*ast.FuncDecl]string),
+		scope:     universe(),
+	}
+	g.declareTypes(prog)
+	g.program(prog)
+
+	return g.source()
+}
+
+type generator struct {
+	info *checker.Info
+
+	// Every identifier of the program, which the names of temporaries avoid
+	names map[string]bool
+
+	// The structs and enums of the program, by name, and the Go name of
+	// each operator method
+	structs   map[string]*structType
+	enums     map[string]*enumType
+	operators map[*ast.FuncDecl]string
+
+	// Package-level declarations in source order, the variables the top
+	// level declares, and the body of main
+	decls   []string
+	globals []string
+	out     *strings.Builder
+
+	// Code decided once the whole program is generated, such as whether a
+	// variable is used, marked in the output by its index
+	lazy []func() string
+
+	scope *scope
+	fn    *funcState
+
+	// The node being generated, for errors
+	node ast.Node
+}
+
+// funcState is the function whose body is being generated.
+type funcState struct {
+	decl *ast.FuncDecl
+
+	// The result type of the function, nil if none, and whether its Go
+	// function returns the elements of a tuple result
+	result checker.Type
+	multi  bool
+
+	// The iterators of the for loops around the statement being generated,
+	// which a return stops
+	iterators []string
+
+	// The switches the function ends with, which must return
+	terminal map[*ast.Switch]bool
+}
+
+type genError struct {
+	line   int
+	column int
+	msg    string
+}
+
+func (e *genError) Error() string {
+	return fmt.Sprintf("Go generation error at line %d:%d: %s", e.line, e.column, e.msg)
+}
+
+func (g *generator) errorf(format string, args ...interface{}) {
+	pos := g.node.Pos()
+	panic(&genError{line: pos.Line, column: pos.Column, msg: fmt.Sprintf(format, args...)})
+}
+
+// scope holds the variables a block declares.
+type scope struct {
+	vars  map[string]*variable
+	outer *scope
+
+	// Names of the temporaries the block declares
+	temps map[string]bool
+}
+
+// variable is what a name refers to in Go.
+type variable struct {
+	// The Go expression of the variable, usually its name, and its type
+	code string
+	typ  checker.Type
+
+	// Whether the variable is read; Go rejects unused local variables
+	used bool
+
+	// The function a name declares, and the scope it was declared in, where
+	// its default values are evaluated
+	fn    *ast.FuncDecl
+	outer *scope
+
+	// The builtin or module a name refers to
+	builtin string
+}
+
+func universe() *scope {
+	s := newScope(nil)
+	s.vars["println"] = &variable{builtin: "println"}
+	s.vars["Future"] = &variable{builtin: "Future"}
+	return s
+}
+
+func newScope(outer *scope) *scope {
+	return &scope{vars: make(map[string]*variable), outer: outer, temps: make(map[string]bool)}
+}
+
+// block generates what gen does in a new scope.
+func (g *generator) block(gen func()) {
+	g.scope = newScope(g.scope)
+	defer func() { g.scope = g.scope.outer }()
+
+	gen()
+}
+
+func (g *generator) lookup(name string) *variable {
+	for s := g.scope; s != nil; s = s.outer {
+		if v, ok := s.vars[name]; ok {
+			return v
+		}
+	}
+	g.errorf("undefined: %s", name)
+	return nil
+}
+
+// declare binds a Bo name to a variable of the same name in Go, unless that
+// name means something else in Go.
+func (g *generator) declare(name string, t checker.Type) *variable {
+	v := &variable{code: goName(name), typ: t}
+	g.scope.vars[name] = v
+	return v
+}
+
+// temp returns a fresh name for a temporary, which no identifier of the
+// program or temporary in scope has.
+func (g *generator) temp(base string) string {
+	for i := 1; ; i++ {
+		name := base
+		if i > 1 {
+			name += strconv.Itoa(i)
+		}
+		if !g.names[name] && !g.isTemp(name) && !reserved[name] {
+			g.scope.temps[name] = true
+			return name
+		}
+	}
+}
+
+func (g *generator) isTemp(name string) bool {
+	for s := g.scope; s != nil; s = s.outer {
+		if s.temps[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// reserved holds the names that mean something in Go, or are the names of
+// the packages the program imports, which a Bo name is renamed from.
+var reserved = map[string]bool{}
+
+func init() {
+	for _, name := range []string{
+		// Keywords
+		"break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "for",
+		"func", "go", "goto", "if", "import", "interface", "map", "package", "range", "return",
+		"select", "struct", "switch", "type", "var",
+
+		// Predeclared identifiers
+		"any", "bool", "byte", "comparable", "complex64", "complex128", "error", "float32", "float64",
+		"int", "int8", "int16", "int32", "int64", "rune", "string", "uint", "uint8", "uint16", "uint32",
+		"uint64", "uintptr", "true", "false", "iota", "nil", "append", "cap", "clear", "close",
+		"complex", "copy", "delete", "imag", "len", "make", "max", "min", "new", "panic", "print",
+		"println", "real", "recover",
+
+		// Packages, and the names main needs
+		"rt", "big", "decimal", "sync", "main", "init", "yield",
+	} {
+		reserved[name] = true
+	}
+}
+
+func goName(name string) string {
+	if reserved[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// exported returns the Go name of a field, which is exported so that the
+// runtime can compare and print values with reflection.
+func exported(name string) string {
+	if name[0] == '_' {
+		return "F" + name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// later marks where the result of code goes in the output, once the whole
+// program is generated.
+func (g *generator) later(code func() string) string {
+	g.lazy = append(g.lazy, code)
+	return "\x00" + strconv.Itoa(len(g.lazy)-1) + "\x00"
+}
+
+// line writes a line of Go to the body being generated.
+func (g *generator) line(format string, args ...interface{}) {
+	fmt.Fprintf(g.out, format, args...)
+	g.out.WriteByte('\n')
+}
+
+// capture returns the code gen writes.
+func (g *generator) capture(gen func()) string {
+	out := g.out
+	g.out = &strings.Builder{}
+	defer func() { g.out = out }()
+
+	gen()
+	return g.out.String()
+}
+
+// program generates the statements of the top level, declarations as
+// package-level declarations and the others as the body of main.
+func (g *generator) program(prog *ast.Program) {
+	g.out = &strings.Builder{}
+	for _, stmt := range prog.Stmts {
+		g.node = stmt
+		switch stmt := stmt.(type) {
+		case *ast.StructDecl:
+			g.structDecl(stmt)
+		case *ast.EnumDecl:
+			g.enumDecl(stmt)
+		case *ast.FuncDecl:
+			g.funcDecl(stmt)
+		case *ast.VarDecl:
+			g.globalVars(stmt)
+		case *ast.Destructure:
+			g.destructure(stmt, true)
+		default:
+			g.stmt(stmt)
+		}
+	}
+
+	g.decls = append(g.decls, "func main() {\n"+g.out.String()+"}\n")
+}
+
+// source puts the program together, with the imports its code uses.
+func (g *generator) source() ([]byte, error) {
+	var body strings.Builder
+	if len(g.globals) > 0 {
+		body.WriteString("var (\n")
+		for _, global := range g.globals {
+			body.WriteString(global + "\n")
+		}
+		body.WriteString(")\n\n")
+	}
+	body.WriteString(strings.Join(g.decls, "\n"))
+
+	code := body.String()
+	for strings.Contains(code, "\x00") {
+		var b strings.Builder
+		parts := strings.Split(code, "\x00")
+		for i, part := range parts {
+			if i%2 == 0 {
+				b.WriteString(part)
+				continue
+			}
+			n, _ := strconv.Atoi(part)
+			b.WriteString(g.lazy[n]())
+		}
+		code = b.String()
+	}
+
+	imports, err := usedPackages(code)
+	if err != nil {
+		return nil, err
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by bo gogen. DO NOT EDIT.\n\npackage main\n\n")
+	if len(imports) > 0 {
+		src.WriteString("import (\n")
+		for _, path := range imports {
+			fmt.Fprintf(&src, "%q\n", path)
+		}
+		src.WriteString(")\n\n")
+	}
+	src.WriteString(code)
+
+	out, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("Go generation error: invalid Go code: %s", err)
+	}
+	return out, nil
+}
+
+// packages holds the import path of each package generated code may use,
+// by name.
+var packages = map[string]string{
+	"rt":      "bo/gogen/rt",
+	"big":     "math/big",
+	"decimal": "bo/decimal",
+	"sync":    "sync",
+}
+
+// usedPackages returns the import paths of the packages code refers to,
+// which no Bo name shadows as they are renamed.
+func usedPackages(code string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package main\n"+code, 0)
+	if err != nil {
+		return nil, fmt.Errorf("Go generation error: invalid Go code: %s", err)
+	}
+
+	used := make(map[string]bool)
+	goast.Inspect(file, func(n goast.Node) bool {
+		if sel, ok := n.(*goast.SelectorExpr); ok {
+			if id, ok := sel.X.(*goast.Ident); ok && packages[id.Name] != "" {
+				used[packages[id.Name]] = true
+			}
+		}
+		return true
+	})
+
+	var paths []string
+	for path := range used {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths, nil
+}
@@ -0,0 +1,55 @@
+package gogen_test
+
+import (
+	"bo/gogen"
+	"bo/internal/botest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerate translates each program to Go, builds it in a module that
+// requires this one and checks that it prints what bo run prints.
+func TestGenerate(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("no go command")
+	}
+
+	dir := t.TempDir()
+	sum, err := os.ReadFile(filepath.Join(botest.Root(), "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod := "module gen\n\ngo 1.22\n\nrequire bo v0.0.0\n\nreplace bo => " + botest.Root() + "\n"
+	write(t, filepath.Join(dir, "go.mod"), []byte(mod))
+	write(t, filepath.Join(dir, "go.sum"), sum)
+
+	for _, p := range botest.Programs(t, "testdata") {
+		t.Run(p.Name, func(t *testing.T) {
+			t.Parallel()
+			src, err := gogen.Generate(p.Prog, p.Info)
+			if err != nil {
+				t.Fatal(err)
+			}
+			write(t, filepath.Join(dir, p.Name, "main.go"), src)
+
+			bin := filepath.Join(dir, p.Name, p.Name)
+			cmd := exec.Command("go", "build", "-mod=mod", "-o", bin, "./"+p.Name)
+			cmd.Dir = dir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("go build: %v\n%s", err, out)
+			}
+			botest.Compare(t, botest.Exec(t, bin), botest.Run(t, p.File))
+		})
+	}
+}
+
+func write(t *testing.T, file string, data []byte) {
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
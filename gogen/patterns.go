@@ -0,0 +1,234 @@
+package gogen
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"strconv"
+	"strings"
+)
+
+// binding is a variable a pattern binds, and the code of its value.
+type binding struct {
+	name string
+	code goExpr
+	typ  checker.Type
+}
+
+// pattern returns the conditions under which a pattern matches a value of
+// type t, whose code is subject, and the variables it binds. Patterns
+// other than bindings, wildcards and nil match the element of an optional
+// value, which must not be nil.
+func (g *generator) pattern(p ast.Pattern, subject goExpr, t checker.Type) ([]string, []binding) {
+	switch p := p.(type) {
+	case *ast.WildcardPattern:
+		return nil, nil
+	case *ast.BindingPattern:
+		return nil, []binding{{p.Name.Name, subject, t}}
+	case *ast.LiteralPattern:
+		if lit, ok := p.Value.(*ast.BasicLit); ok && lit.Kind == ast.Nil {
+			return []string{subject.paren(precCompare+1) + " == nil"}, nil
+		}
+	}
+
+	var conds []string
+	if o, ok := t.(*checker.Optional); ok && !isAny(o.Elem) {
+		conds = append(conds, subject.paren(precCompare+1)+" != nil")
+		subject, t = goExpr{code: "*" + subject.paren(precUnary), prec: precUnary}, o.Elem
+	}
+	x := subject.paren(precPrimary)
+
+	var binds []binding
+	sub := func(p ast.Pattern, code string, t checker.Type) {
+		c, b := g.pattern(p, primary(code), t)
+		conds, binds = append(conds, c...), append(binds, b...)
+	}
+
+	switch p := p.(type) {
+	case *ast.LiteralPattern:
+		conds = append(conds, g.literalCond(p, subject, t))
+	case *ast.CasePattern:
+		enum := t.(*checker.Enum)
+		name := g.enums[enum.Name].cases[p.Case.Name]
+		conds = append(conds, "rt.Is["+name+"]("+subject.code+")")
+		for i, field := range p.Fields {
+			sub(field, x+".("+name+").F"+strconv.Itoa(i), enum.Case(p.Case.Name).Fields[i])
+		}
+	case *ast.ListPattern:
+		list := t.(*checker.List)
+		n := len(p.Elems)
+		rest, hasRest := p.Elems[max(n-1, 0):], n > 0 && isRest(p.Elems[n-1])
+		switch {
+		case !hasRest:
+			conds = append(conds, "len("+subject.code+") == "+strconv.Itoa(n))
+		case n > 1:
+			conds = append(conds, "len("+subject.code+") >= "+strconv.Itoa(n-1))
+		}
+		for i, elem := range p.Elems {
+			if hasRest && i == n-1 {
+				if name := rest[0].(*ast.RestPattern).Name; name != nil {
+					binds = append(binds, binding{name.Name, primary(x + "[" + strconv.Itoa(i) + ":]"), list})
+				}
+				continue
+			}
+			sub(elem, x+"["+strconv.Itoa(i)+"]", list.Elem)
+		}
+	case *ast.MapPattern:
+		m := t.(*checker.Map)
+		for _, entry := range p.Entries {
+			key := g.literal(entry.Key.(*ast.LiteralPattern), m.Key)
+			get := x + ".Get(" + key.code + ")"
+			switch {
+			case isInterface(m.Value) && !isEnum(m.Value):
+				get = "rt.Or(" + get + ", nil)"
+			case isOptional(m.Value):
+				get = "rt.Flatten(" + get + ")"
+			}
+			sub(entry.Value, get, checker.OptionalOf(m.Value))
+		}
+	case *ast.TuplePattern:
+		tuple := t.(*checker.Tuple)
+		for i, elem := range p.Elems {
+			sub(elem, x+".V"+strconv.Itoa(i), tuple.Elems[i])
+		}
+	case *ast.StructPattern:
+		st := t.(*checker.Struct)
+		for _, field := range p.Fields {
+			code := x + "." + exported(field.Name.Name)
+			typ := st.Field(field.Name.Name).Type
+			if field.Pattern == nil {
+				binds = append(binds, binding{field.Name.Name, primary(code), typ})
+				continue
+			}
+			sub(field.Pattern, code, typ)
+		}
+	default:
+		g.errorf("unhandled pattern %s", ast.String(p))
+	}
+	return conds, binds
+}
+
+func isRest(p ast.Pattern) bool {
+	_, ok := p.(*ast.RestPattern)
+	return ok
+}
+
+// literal returns the code of the value of a literal pattern, as a value of
+// type t.
+func (g *generator) literal(p *ast.LiteralPattern, t checker.Type) goExpr {
+	switch lit := p.Value.(type) {
+	case *ast.StringLit:
+		return g.stringLit(lit)
+	case *ast.BasicLit:
+		switch lit.Kind {
+		case ast.Bool:
+			return primary(lit.Value)
+		case ast.BigInt:
+			t = checker.BigInt
+		case ast.Decimal:
+			t = checker.Decimal
+		}
+		return g.constant(lit, p.Neg, t)
+	}
+
+	g.errorf("unhandled pattern %s", ast.String(p))
+	return goExpr{}
+}
+
+// literalCond returns the condition under which a literal pattern matches
+// a value of type t.
+func (g *generator) literalCond(p *ast.LiteralPattern, subject goExpr, t checker.Type) string {
+	if lit, ok := p.Value.(*ast.BasicLit); ok && lit.Kind == ast.Bool {
+		if lit.Value == "true" {
+			return subject.code
+		}
+		return "!" + subject.paren(precUnary)
+	}
+
+	value := g.literal(p, t)
+	if b, ok := t.(*checker.Basic); ok && b.IsBig() {
+		return subject.paren(precPrimary) + ".Cmp(" + value.code + ") == 0"
+	}
+	return subject.paren(precCompare+1) + " == " + value.paren(precCompare+1)
+}
+
+// condition returns the conjunction of conditions, true if there are none.
+func condition(conds []string) string {
+	if len(conds) == 0 {
+		return "true"
+	}
+	return strings.Join(conds, " && ")
+}
+
+// arm is an arm of a switch or match: the conditions under which it runs,
+// and the code it runs with the variables of its pattern in scope.
+type arm struct {
+	cond string
+	code string
+}
+
+// arms generates the arms of a switch or match on subject, a value of type
+// t, and returns them with the variables their patterns bind. The guard of
+// an arm refers to those variables by their values, as they are only
+// declared for the body.
+func (g *generator) arms(subject goExpr, t checker.Type, patterns []ast.Pattern, guards []ast.Expr, body func(i int)) ([]arm, []*variable) {
+	arms := make([]arm, len(patterns))
+	var vars []*variable
+	for i, p := range patterns {
+		g.node = p
+		conds, binds := g.pattern(p, subject, t)
+
+		if guard := guards[i]; guard != nil {
+			g.block(func() {
+				for _, b := range binds {
+					v := g.declare(b.name, b.typ)
+					v.code = b.code.paren(precPrimary)
+				}
+				conds = append(conds, g.expr(guard).paren(precAnd))
+			})
+		}
+
+		arms[i].cond = condition(conds)
+		g.block(func() {
+			arms[i].code = g.capture(func() {
+				vars = append(vars, g.bind(binds, false)...)
+				body(i)
+			})
+		})
+	}
+	return arms, vars
+}
+
+// bind declares the variables a pattern binds, as package variables set by
+// main if global.
+func (g *generator) bind(binds []binding, global bool) []*variable {
+	var vars []*variable
+	for _, b := range binds {
+		v := g.declare(b.name, b.typ)
+		vars = append(vars, v)
+		code := b.code.code
+		if global {
+			v.used = true
+			g.globals = append(g.globals, v.code+" "+g.goType(b.typ))
+			g.line("%s = %s", v.code, code)
+			continue
+		}
+		g.line("%s", g.later(func() string {
+			if !v.used {
+				return ""
+			}
+			return v.code + " := " + code
+		}))
+	}
+	return vars
+}
+
+// anyUsed reports whether any of vars is used, once the whole program is
+// generated.
+func anyUsed(vars []*variable) bool {
+	for _, v := range vars {
+		if v.used {
+			return true
+		}
+	}
+	return false
+}
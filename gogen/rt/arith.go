@@ -0,0 +1,170 @@
+package rt
+
+import (
+	"bo/checker"
+	"bo/decimal"
+	"fmt"
+	"math/big"
+
+	"bo/value"
+)
+
+// Integer arithmetic is checked, as in Bo: a result that overflows its type
+// is a runtime error. The wrapping operators (+%, -%, *%) are Go's own.
+
+type Signed interface {
+	~int8 | ~int16 | ~int32 | ~int64
+}
+
+type Unsigned interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Var returns x as a value rather than a constant. Go computes operations
+// on constants exactly when it compiles a program, where Bo computes them
+// in the type of their operands when it runs.
+func Var[T any](x T) T {
+	return x
+}
+
+func overflow[T Integer](x T, op string, y T) {
+	panic(fmt.Sprintf("Binary -> integer overflow: %s %s %s", String(x), op, String(y)))
+}
+
+func Add[T Integer](x, y T) T {
+	z := x + y
+	if (z > x) != (y > 0) {
+		overflow(x, "+", y)
+	}
+	return z
+}
+
+func Sub[T Integer](x, y T) T {
+	z := x - y
+	if (z < x) != (y > 0) {
+		overflow(x, "-", y)
+	}
+	return z
+}
+
+func Mul[T Integer](x, y T) T {
+	if x == 0 || y == 0 {
+		return 0
+	}
+	z := x * y
+	if z/y != x || x < 0 && y < 0 && z < 0 {
+		overflow(x, "*", y)
+	}
+	return z
+}
+
+func Div[T Integer](x, y T) T {
+	if y == 0 {
+		panic("Binary -> integer division by zero")
+	}
+	z := x / y
+	if x < 0 && y < 0 && z < 0 {
+		overflow(x, "/", y)
+	}
+	return z
+}
+
+func Rem[T Integer](x, y T) T {
+	if y == 0 {
+		panic("Binary -> integer division by zero")
+	}
+	return x % y
+}
+
+// Neg negates x. Negating the smallest value of its type overflows.
+func Neg[T Signed](x T) T {
+	if x != 0 && x == -x {
+		panic(fmt.Sprintf("Neg -> integer overflow: -(%s)", String(x)))
+	}
+	return -x
+}
+
+func QuoBig(x, y *big.Int) *big.Int {
+	if y.Sign() == 0 {
+		panic("Binary -> integer division by zero")
+	}
+	return new(big.Int).Quo(x, y)
+}
+
+func RemBig(x, y *big.Int) *big.Int {
+	if y.Sign() == 0 {
+		panic("Binary -> integer division by zero")
+	}
+	return new(big.Int).Rem(x, y)
+}
+
+func QuoDecimal(x, y decimal.Decimal) decimal.Decimal {
+	if y.Sign() == 0 {
+		panic("Binary -> decimal division by zero")
+	}
+	return x.Div(y)
+}
+
+func RemDecimal(x, y decimal.Decimal) decimal.Decimal {
+	if y.Sign() == 0 {
+		panic("Binary -> decimal division by zero")
+	}
+	return x.Rem(y)
+}
+
+// Convert returns the numeric value x as a T, the Go type of a Bo numeric
+// type. A value that does not fit T is a runtime error.
+func Convert[T any](x any) T {
+	var t checker.Type
+	switch any(*new(T)).(type) {
+	case int8:
+		t = checker.Int8
+	case int16:
+		t = checker.Int16
+	case int32:
+		t = checker.Int32
+	case int64:
+		t = checker.Int
+	case uint8:
+		t = checker.Uint8
+	case uint16:
+		t = checker.Uint16
+	case uint32:
+		t = checker.Uint32
+	case uint64:
+		t = checker.Uint64
+	case float32:
+		t = checker.Float32
+	case float64:
+		t = checker.Float
+	case *big.Int:
+		t = checker.BigInt
+	case decimal.Decimal:
+		t = checker.Decimal
+	default:
+		panic(fmt.Sprintf("Convert -> unhandled type: %T", *new(T)))
+	}
+	return value.Convert(x, t).(T)
+}
+
+// BigInt returns the value of an integer literal too large for an int64.
+func BigInt(s string) *big.Int {
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic(fmt.Sprintf("BigInt -> invalid integer: %s", s))
+	}
+	return i
+}
+
+// Decimal returns the value of a decimal literal.
+func Decimal(s string) decimal.Decimal {
+	d, err := decimal.Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("Decimal -> %s", err))
+	}
+	return d
+}
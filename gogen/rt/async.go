@@ -0,0 +1,75 @@
+package rt
+
+import (
+	"context"
+
+	"bo/value"
+)
+
+// Future is the value of a Future[T] type, the result of an async function
+// or of a combination of futures.
+type Future[T any] struct {
+	f *value.Future
+}
+
+// Start runs run as a task of its own and returns the future of its result.
+func Start[T any](run func() T) *Future[T] {
+	return &Future[T]{f: value.Start(context.Background(), func() any {
+		return run()
+	})}
+}
+
+// Await waits for f and returns its value, or raises the error it failed
+// with in the awaiting task.
+func (f *Future[T]) Await() T {
+	return as[T](f.f.Await(context.Background()))
+}
+
+func (f *Future[T]) String() string {
+	return "Future"
+}
+
+// futures calls a member of Future with futures and other arguments.
+func futures(name string, args ...any) *value.Future {
+	fn := value.Builtins["Future"].(*value.Module).Members[name].(value.Builtin)
+	return fn([]any{value.List(args)}).(*value.Future)
+}
+
+// All returns a future of the values of fs, which fails as soon as one of
+// them does, with its error.
+func All[T any](fs ...*Future[T]) *Future[[]T] {
+	return &Future[[]T]{f: futures("all", unwrap(fs)...)}
+}
+
+// AnyOf returns a future done as the first of fs to be done is.
+func AnyOf[T any](fs ...*Future[T]) *Future[T] {
+	return &Future[T]{f: futures("any", unwrap(fs)...)}
+}
+
+// Timeout returns f, failing if it takes longer than ms milliseconds.
+func Timeout[T any](f *Future[T], ms int64) *Future[T] {
+	return &Future[T]{f: futures("timeout", f.f, ms)}
+}
+
+func unwrap[T any](fs []*Future[T]) []any {
+	args := make([]any, len(fs))
+	for i, f := range fs {
+		args[i] = f.f
+	}
+	return args
+}
+
+// Receive receives a value from ch, or nil once ch is closed and drained.
+func Receive[T any](ch chan T) *T {
+	v, ok := <-ch
+	return Received(v, ok)
+}
+
+// Received returns the value a receive in a select statement got, or nil
+// if the channel was closed.
+func Received[T any](v T, ok bool) *T {
+	if !ok {
+		return nil
+	}
+	return &v
+}
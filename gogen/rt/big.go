@@ -0,0 +1,54 @@
+package rt
+
+import (
+	"bo/decimal"
+	"math/big"
+
+	"bo/value"
+)
+
+// The functions of bo/math/big. Parameters left out take their default
+// values where the call is generated.
+
+// The rounding modes of BigRound and BigDiv.
+const (
+	HalfEven = int64(decimal.HalfEven)
+	HalfUp   = int64(decimal.HalfUp)
+	HalfDown = int64(decimal.HalfDown)
+	Up       = int64(decimal.Up)
+	Down     = int64(decimal.Down)
+	Ceiling  = int64(decimal.Ceiling)
+	Floor    = int64(decimal.Floor)
+)
+
+func bigCall(name string, args ...any) any {
+	return value.StdModules["bo/math/big"].Members[name].(value.Builtin)(args)
+}
+
+func BigPow(x *big.Int, n int64) *big.Int {
+	return bigCall("pow", x, n).(*big.Int)
+}
+
+func BigModPow(x, y, m *big.Int) *big.Int {
+	return bigCall("modpow", x, y, m).(*big.Int)
+}
+
+func BigMod(x, m *big.Int) *big.Int {
+	return bigCall("mod", x, m).(*big.Int)
+}
+
+func BigGCD(x, y *big.Int) *big.Int {
+	return bigCall("gcd", x, y).(*big.Int)
+}
+
+func BigAbs(x *big.Int) *big.Int {
+	return bigCall("abs", x).(*big.Int)
+}
+
+func BigRound(d decimal.Decimal, scale, mode int64) decimal.Decimal {
+	return bigCall("round", d, scale, mode).(decimal.Decimal)
+}
+
+func BigDiv(x, y decimal.Decimal, scale, mode int64) decimal.Decimal {
+	return bigCall("div", x, y, scale, mode).(decimal.Decimal)
+}
@@ -0,0 +1,221 @@
+package rt
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"bo/value"
+)
+
+// Range is the value of Range, the same as the interpreters'.
+type Range = value.Range
+
+// NewRange returns the range from..to, or from..<to if exclusive.
+func NewRange(from, to int64, exclusive bool) Range {
+	return value.NewRange(from, to, exclusive)
+}
+
+// Index returns the element of list at index i.
+func Index[T any](list []T, i int64) T {
+	if i < 0 || i >= int64(len(list)) {
+		panic(fmt.Sprintf("Index -> index out of range [%d] with length %d", i, len(list)))
+	}
+	return list[i]
+}
+
+// Slice returns the elements of list at the indices in r.
+func Slice[T any](list []T, r Range) []T {
+	s := make([]T, 0, r.Len())
+	for i := int64(0); i < r.Len(); i++ {
+		j := r.At(i)
+		if j < 0 || j >= int64(len(list)) {
+			panic(fmt.Sprintf("Slice -> slice bounds out of range [%s] with length %d", r, len(list)))
+		}
+		s = append(s, list[j])
+	}
+	return s
+}
+
+// Iterator is the value of an Iterator[T] type. A for loop goes through
+// its values with Scan and Value, like a bufio.Scanner, and stops it once
+// done with it.
+type Iterator[T any] struct {
+	it    *value.IterValue
+	value T
+}
+
+func newIterator[T any](it value.Iterator) *Iterator[T] {
+	if iv, ok := it.(*value.IterValue); ok {
+		return &Iterator[T]{it: iv}
+	}
+	return &Iterator[T]{it: value.NewIterValue(it)}
+}
+
+// Scan moves to the next value, reporting whether there was one.
+func (it *Iterator[T]) Scan() bool {
+	v, ok := it.it.Next()
+	if ok {
+		it.value = as[T](v)
+	}
+	return ok
+}
+
+// Value returns the value Scan moved to.
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Next returns the next value, or nil once there are no more, as Bo's
+// next method does.
+func (it *Iterator[T]) Next() *T {
+	v, ok := it.it.Next()
+	if !ok {
+		return nil
+	}
+	t := as[T](v)
+	return &t
+}
+
+func (it *Iterator[T]) HasNext() bool {
+	return it.it.Method("hasNext")(nil).(bool)
+}
+
+// Stop lets go of an iterator that is no longer needed, ending the
+// generator behind it, if any.
+func (it *Iterator[T]) Stop() {
+	it.it.Stop()
+}
+
+func (it *Iterator[T]) String() string {
+	return "Iterator"
+}
+
+// as returns a value the value package made as a T: nil as its zero value,
+// and its lists and tuples as slices and TupleN.
+func as[T any](v any) T {
+	if t, ok := v.(T); ok || v == nil {
+		return t
+	}
+	return convertTo(reflect.ValueOf(v), reflect.TypeFor[T]()).Interface().(T)
+}
+
+func convertTo(v reflect.Value, t reflect.Type) reflect.Value {
+	for v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return reflect.Zero(t)
+	}
+
+	switch elems := v.Interface().(type) {
+	case value.List:
+		s := reflect.MakeSlice(t, len(elems), len(elems))
+		for i, elem := range elems {
+			s.Index(i).Set(convertTo(reflect.ValueOf(elem), t.Elem()))
+		}
+		return s
+	case value.Tuple:
+		tuple := reflect.New(t).Elem()
+		for i, elem := range elems {
+			tuple.Field(i).Set(convertTo(reflect.ValueOf(elem), t.Field(i).Type))
+		}
+		return tuple
+	}
+	return v
+}
+
+// Generate returns an iterator over the values body yields, running it as
+// a task of its own as the values are asked for.
+func Generate[T any](body func(yield func(T))) *Iterator[T] {
+	return newIterator[T](value.Generate(context.Background(), func(g *value.Generator) {
+		body(func(v T) {
+			g.Yield(context.Background(), v)
+		})
+	}))
+}
+
+// Values returns an iterator over the elements of a list.
+func Values[T any](list []T) *Iterator[T] {
+	i := 0
+	return newIterator[T](&value.IteratorFunc{NextFunc: func() (any, bool) {
+		if i == len(list) {
+			return nil, false
+		}
+		i++
+		return list[i-1], true
+	}})
+}
+
+// RangeValues returns an iterator over the values of a range.
+func RangeValues(r Range) *Iterator[int64] {
+	return newIterator[int64](r.Iterate())
+}
+
+// Iterate returns an iterator over the entries of m, as (key, value)
+// tuples.
+func (m *Map[K, V]) Iterate() *Iterator[Tuple2[K, V]] {
+	return Values(m.Entries())
+}
+
+// iter calls a function of bo/iter with iterators and other arguments.
+func iter(name string, args ...any) value.Iterator {
+	for i, arg := range args {
+		if it, ok := arg.(interface{ iterValue() *value.IterValue }); ok {
+			args[i] = it.iterValue()
+		}
+	}
+
+	fn := value.StdModules["bo/iter"].Members[name].(value.Builtin)
+	return fn([]any{value.List(args)}).(value.Iterator)
+}
+
+func (it *Iterator[T]) iterValue() *value.IterValue {
+	return it.it
+}
+
+// Take returns an iterator over the first n values of it.
+func Take[T any](it *Iterator[T], n int64) *Iterator[T] {
+	return newIterator[T](iter("take", it, n))
+}
+
+// Enumerate returns an iterator over tuples of the index and the value of
+// the values of it.
+func Enumerate[T any](it *Iterator[T]) *Iterator[Tuple2[int64, T]] {
+	return newIterator[Tuple2[int64, T]](iter("enumerate", it))
+}
+
+// Chain returns an iterator over the values of each of its, one after the
+// other.
+func Chain[T any](its ...*Iterator[T]) *Iterator[T] {
+	args := make([]any, len(its))
+	for i, it := range its {
+		args[i] = it
+	}
+	return newIterator[T](iter("chain", args...))
+}
+
+// Zip2 returns an iterator over tuples of the values of a and b, until one
+// of them ends. Zip3 and Zip4 zip more iterators.
+func Zip2[A, B any](a *Iterator[A], b *Iterator[B]) *Iterator[Tuple2[A, B]] {
+	return newIterator[Tuple2[A, B]](iter("zip", a, b))
+}
+
+func Zip3[A, B, C any](a *Iterator[A], b *Iterator[B], c *Iterator[C]) *Iterator[Tuple3[A, B, C]] {
+	return newIterator[Tuple3[A, B, C]](iter("zip", a, b, c))
+}
+
+func Zip4[A, B, C, D any](a *Iterator[A], b *Iterator[B], c *Iterator[C], d *Iterator[D]) *Iterator[Tuple4[A, B, C, D]] {
+	return newIterator[Tuple4[A, B, C, D]](iter("zip", a, b, c, d))
+}
+
+// Collect returns a list of the values of it, which must end.
+func Collect[T any](it *Iterator[T]) []T {
+	defer it.Stop()
+
+	var list []T
+	for it.Scan() {
+		list = append(list, it.Value())
+	}
+	return list
+}
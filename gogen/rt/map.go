@@ -0,0 +1,92 @@
+package rt
+
+import (
+	"bo/decimal"
+	"math/big"
+	"strings"
+)
+
+// Map is the value of a map type. Its entries keep the order in which
+// their keys were first added. Maps are immutable once built.
+type Map[K, V any] struct {
+	keys    []K
+	values  []V
+	indices map[any]int
+}
+
+func NewMap[K, V any]() *Map[K, V] {
+	return &Map[K, V]{indices: make(map[any]int)}
+}
+
+// mapKey returns a comparable key that is the same for equal keys.
+func mapKey(key any) any {
+	switch key := key.(type) {
+	case *big.Int:
+		return "n" + key.String()
+	case decimal.Decimal:
+		s := key.String()
+		if strings.Contains(s, ".") {
+			s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+		}
+		return "m" + s
+	}
+	return key
+}
+
+// Set adds an entry to m, or replaces the value of its key, and returns m
+// so that a literal is built with a chain of calls.
+func (m *Map[K, V]) Set(key K, value V) *Map[K, V] {
+	if i, ok := m.indices[mapKey(key)]; ok {
+		m.values[i] = value
+		return m
+	}
+
+	m.indices[mapKey(key)] = len(m.keys)
+	m.keys = append(m.keys, key)
+	m.values = append(m.values, value)
+	return m
+}
+
+// Get returns the value of key, or nil if m has no such key.
+func (m *Map[K, V]) Get(key K) *V {
+	i, ok := m.indices[mapKey(key)]
+	if !ok {
+		return nil
+	}
+	return &m.values[i]
+}
+
+func (m *Map[K, V]) Len() int {
+	return len(m.keys)
+}
+
+// Entries returns the entries of m as (key, value) tuples, in order.
+func (m *Map[K, V]) Entries() []Tuple2[K, V] {
+	entries := make([]Tuple2[K, V], len(m.keys))
+	for i, key := range m.keys {
+		entries[i] = Tuple2[K, V]{key, m.values[i]}
+	}
+	return entries
+}
+
+func (m *Map[K, V]) equal(other any) bool {
+	o := other.(*Map[K, V])
+	if len(m.keys) != len(o.keys) {
+		return false
+	}
+	for i, key := range m.keys {
+		val := o.Get(key)
+		if val == nil || !Equal(m.values[i], *val) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Map[K, V]) String() string {
+	entries := make([]string, len(m.keys))
+	for i, key := range m.keys {
+		entries[i] = String(key) + ": " + String(m.values[i])
+	}
+	return "{" + strings.Join(entries, ", ") + "}"
+}
@@ -0,0 +1,235 @@
+// Package rt is the runtime support of the Go programs the gogen package
+// generates from Bo programs. Bo values are mapped to plain Go values: an
+// optional T? is a *T, a list []T a slice, a map an ordered *Map and a
+// tuple a TupleN. The operations Go does not have, or has with another
+// meaning, such as checked integer arithmetic and the canonical string form
+// of values, are implemented here, mostly by the value package the
+// interpreters share.
+package rt
+
+import (
+	"bo/decimal"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	"bo/value"
+)
+
+// Println prints each of its arguments on a line of its own.
+func Println(values ...any) {
+	for _, v := range values {
+		fmt.Println(String(v))
+	}
+}
+
+// String returns the canonical string form of a value, used both by
+// println and by string interpolation.
+func String(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "nil"
+	case string, int8, int16, int32, int64, uint8, uint16, uint32, uint64, float32, float64, *big.Int, decimal.Decimal, bool:
+		return value.ToString(v)
+	case *sync.WaitGroup:
+		return "sync.WaitGroup"
+	case *sync.Mutex:
+		return "sync.Mutex"
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return "nil"
+		}
+		return String(rv.Elem().Interface())
+	case reflect.Slice:
+		elems := make([]string, rv.Len())
+		for i := range elems {
+			elems[i] = String(rv.Index(i).Interface())
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case reflect.Chan:
+		return fmt.Sprintf("chan(%d/%d)", rv.Len(), rv.Cap())
+	}
+
+	panic(fmt.Sprintf("String -> unhandled value type: %T", v))
+}
+
+// Struct returns the string form of a value of a struct without a string
+// operator, from its name and the names and values of its fields.
+func Struct(name string, fields ...any) string {
+	parts := make([]string, 0, len(fields)/2)
+	for i := 0; i < len(fields); i += 2 {
+		parts = append(parts, fields[i].(string)+": "+String(fields[i+1]))
+	}
+	return name + "{" + strings.Join(parts, ", ") + "}"
+}
+
+// Case returns the string form of a value of an enum, from the name of its
+// case and the values it carries.
+func Case(name string, fields ...any) string {
+	if len(fields) == 0 {
+		return name
+	}
+
+	parts := make([]string, len(fields))
+	for i, field := range fields {
+		parts[i] = String(field)
+	}
+	return name + "(" + strings.Join(parts, ", ") + ")"
+}
+
+// Is reports whether v, a value of an enum, is of the case T.
+func Is[T any](v any) bool {
+	_, ok := v.(T)
+	return ok
+}
+
+// Some returns v as a value of the optional type T?.
+func Some[T any](v T) *T {
+	return &v
+}
+
+// Or returns the value of x, or y if x is nil.
+func Or[T any](x *T, y T) T {
+	if x != nil {
+		return *x
+	}
+	return y
+}
+
+// OrElse returns the value of x, or else calls y, which is only evaluated
+// when x is nil.
+func OrElse[T any](x *T, y func() T) T {
+	if x != nil {
+		return *x
+	}
+	return y()
+}
+
+// Then calls f with the value of x and returns its result, or nil if x is
+// nil, as x?.field does.
+func Then[T, U any](x *T, f func(T) U) *U {
+	if x == nil {
+		return nil
+	}
+	u := f(*x)
+	return &u
+}
+
+// ThenOptional is Then for an f whose result is optional itself.
+func ThenOptional[T, U any](x *T, f func(T) *U) *U {
+	if x == nil {
+		return nil
+	}
+	return f(*x)
+}
+
+// Flatten returns the value of an optional whose values are optional
+// themselves, as T? and T?? are the same type.
+func Flatten[T any](x **T) *T {
+	if x == nil {
+		return nil
+	}
+	return *x
+}
+
+// Equal reports whether two values of the same type are equal.
+func Equal(x, y any) bool {
+	return equal(reflect.ValueOf(x), reflect.ValueOf(y))
+}
+
+var bigIntType = reflect.TypeFor[*big.Int]()
+
+func equal(x, y reflect.Value) bool {
+	// Optionals, and any values, may be nil
+	for x.Kind() == reflect.Interface && !x.IsNil() {
+		x = x.Elem()
+	}
+	for y.Kind() == reflect.Interface && !y.IsNil() {
+		y = y.Elem()
+	}
+	if isNil(x) || isNil(y) {
+		return isNil(x) == isNil(y)
+	}
+	if x.Type() != y.Type() {
+		// A value an optional points to is the value itself
+		if x.Kind() == reflect.Pointer && x.Type() != bigIntType {
+			return equal(x.Elem(), y)
+		}
+		if y.Kind() == reflect.Pointer && y.Type() != bigIntType {
+			return equal(x, y.Elem())
+		}
+		return false
+	}
+
+	switch x := x.Interface().(type) {
+	case *big.Int:
+		return x.Cmp(y.Interface().(*big.Int)) == 0
+	case decimal.Decimal:
+		return x.Cmp(y.Interface().(decimal.Decimal)) == 0
+	case Range:
+		return x == y.Interface()
+	case equaler:
+		return x.equal(y.Interface())
+	}
+
+	switch x.Kind() {
+	case reflect.Pointer:
+		// Values of the runtime, such as futures, are only equal to
+		// themselves
+		if pkg := x.Type().Elem().PkgPath(); pkg == "sync" || pkg == "bo/gogen/rt" {
+			return x.Pointer() == y.Pointer()
+		}
+		return equal(x.Elem(), y.Elem())
+	case reflect.Slice:
+		if x.Len() != y.Len() {
+			return false
+		}
+		for i := 0; i < x.Len(); i++ {
+			if !equal(x.Index(i), y.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < x.NumField(); i++ {
+			if !equal(x.Field(i), y.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return x.Interface() == y.Interface()
+}
+
+// equaler is a value of the runtime that compares itself with another of
+// its type.
+type equaler interface {
+	equal(other any) bool
+}
+
+// isNil reports whether v is nil, or a nil optional.
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Pointer, reflect.Interface, reflect.Chan:
+		return v.IsNil()
+	}
+	return false
+}
+
+// OrOptional returns x, or else calls y, whose value may be nil too.
+func OrOptional[T any](x *T, y func() *T) *T {
+	if x != nil {
+		return x
+	}
+	return y()
+}
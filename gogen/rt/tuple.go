@@ -0,0 +1,100 @@
+package rt
+
+// TupleN is the value of a tuple type of N elements, made with NewTupleN.
+// Functions returning a tuple return its elements as Go results, which
+// Unpack gets back.
+
+type Tuple2[A, B any] struct {
+	V0 A
+	V1 B
+}
+
+func NewTuple2[A, B any](v0 A, v1 B) Tuple2[A, B] {
+	return Tuple2[A, B]{v0, v1}
+}
+
+func (t Tuple2[A, B]) Unpack() (A, B) {
+	return t.V0, t.V1
+}
+
+func (t Tuple2[A, B]) String() string {
+	return "(" + String(t.V0) + ", " + String(t.V1) + ")"
+}
+
+type Tuple3[A, B, C any] struct {
+	V0 A
+	V1 B
+	V2 C
+}
+
+func NewTuple3[A, B, C any](v0 A, v1 B, v2 C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{v0, v1, v2}
+}
+
+func (t Tuple3[A, B, C]) Unpack() (A, B, C) {
+	return t.V0, t.V1, t.V2
+}
+
+func (t Tuple3[A, B, C]) String() string {
+	return "(" + String(t.V0) + ", " + String(t.V1) + ", " + String(t.V2) + ")"
+}
+
+type Tuple4[A, B, C, D any] struct {
+	V0 A
+	V1 B
+	V2 C
+	V3 D
+}
+
+func NewTuple4[A, B, C, D any](v0 A, v1 B, v2 C, v3 D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{v0, v1, v2, v3}
+}
+
+func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return t.V0, t.V1, t.V2, t.V3
+}
+
+func (t Tuple4[A, B, C, D]) String() string {
+	return "(" + String(t.V0) + ", " + String(t.V1) + ", " + String(t.V2) + ", " + String(t.V3) + ")"
+}
+
+type Tuple5[A, B, C, D, E any] struct {
+	V0 A
+	V1 B
+	V2 C
+	V3 D
+	V4 E
+}
+
+func NewTuple5[A, B, C, D, E any](v0 A, v1 B, v2 C, v3 D, v4 E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{v0, v1, v2, v3, v4}
+}
+
+func (t Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return t.V0, t.V1, t.V2, t.V3, t.V4
+}
+
+func (t Tuple5[A, B, C, D, E]) String() string {
+	return "(" + String(t.V0) + ", " + String(t.V1) + ", " + String(t.V2) + ", " + String(t.V3) + ", " + String(t.V4) + ")"
+}
+
+type Tuple6[A, B, C, D, E, F any] struct {
+	V0 A
+	V1 B
+	V2 C
+	V3 D
+	V4 E
+	V5 F
+}
+
+func NewTuple6[A, B, C, D, E, F any](v0 A, v1 B, v2 C, v3 D, v4 E, v5 F) Tuple6[A, B, C, D, E, F] {
+	return Tuple6[A, B, C, D, E, F]{v0, v1, v2, v3, v4, v5}
+}
+
+func (t Tuple6[A, B, C, D, E, F]) Unpack() (A, B, C, D, E, F) {
+	return t.V0, t.V1, t.V2, t.V3, t.V4, t.V5
+}
+
+func (t Tuple6[A, B, C, D, E, F]) String() string {
+	return "(" + String(t.V0) + ", " + String(t.V1) + ", " + String(t.V2) + ", " + String(t.V3) + ", " + String(t.V4) + ", " + String(t.V5) + ")"
+}
@@ -0,0 +1,603 @@
+package gogen
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"strconv"
+	"strings"
+)
+
+// stmts returns the code of statements.
+func (g *generator) stmts(stmts []ast.Stmt) string {
+	return g.capture(func() {
+		for _, stmt := range stmts {
+			g.stmt(stmt)
+		}
+	})
+}
+
+// body returns the code of a block, in a scope of its own.
+func (g *generator) body(block *ast.Block) string {
+	var code string
+	g.block(func() {
+		code = g.stmts(block.Stmts)
+	})
+	return code
+}
+
+func (g *generator) stmt(stmt ast.Stmt) {
+	node := g.node
+	g.node = stmt
+	defer func() { g.node = node }()
+
+	switch s := stmt.(type) {
+	case *ast.Require:
+		g.require(s)
+	case *ast.StructDecl, *ast.EnumDecl:
+		g.errorf("types must be declared at the top level")
+	case *ast.FuncDecl:
+		g.closure(s)
+	case *ast.Return:
+		g.ret(s)
+	case *ast.Defer:
+		g.line("defer %s", g.callStmt(s.Call))
+	case *ast.Yield:
+		elem := g.info.Types[g.fn.decl.Result].(*checker.Iterator).Elem
+		g.line("yield(%s)", g.exprAs(s.Value, elem).code)
+	case *ast.For:
+		g.forStmt(s)
+	case *ast.VarDecl:
+		g.varDecl(s)
+	case *ast.Destructure:
+		g.destructure(s, false)
+	case *ast.Switch:
+		g.switchStmt(s)
+	case *ast.Spawn:
+		g.line("go %s", g.callStmt(s.Call))
+	case *ast.Send:
+		elem := g.info.Types[s.Chan].(*checker.Chan).Elem
+		g.line("%s <- %s", g.expr(s.Chan).code, g.exprAs(s.Value, elem).code)
+	case *ast.Select:
+		g.selectStmt(s)
+	case *ast.AwaitStmt:
+		g.line("%s.Await()", g.expr(s.X).paren(precPrimary))
+	case *ast.CallStmt:
+		g.line("%s", g.callStmt(s.Call))
+	default:
+		g.errorf("unhandled statement %s", ast.String(stmt))
+	}
+}
+
+// require prints that a module is imported, as the interpreters do. The
+// members of a standard module are generated where they are used.
+func (g *generator) require(req *ast.Require) {
+	if !req.Std {
+		g.line("rt.Println(%s)", strconv.Quote("Importing module: "+strconv.Quote(req.Path)))
+		return
+	}
+	g.line("rt.Println(%s)", strconv.Quote("Importing module: <"+req.Path+">"))
+	g.scope.vars[req.Path[strings.LastIndex(req.Path, "/")+1:]] = &variable{builtin: req.Path}
+}
+
+// callStmt returns the code of a call whose result is discarded, which
+// may call a method of nil with ?.
+func (g *generator) callStmt(call *ast.Call) string {
+	member, ok := call.Fun.(*ast.Member)
+	if !ok || !member.Safe || !isOptional(g.info.Types[member.X]) {
+		code, _, _ := g.call(call)
+		if !strings.HasSuffix(code.code, ")") || strings.HasPrefix(code.code, "new(") {
+			return "_ = " + code.code
+		}
+		return code.code
+	}
+
+	// if x != nil { x.m() }, with x used as its value in the call
+	var code string
+	x := g.expr(member.X)
+	elem := elemType(g.info.Types[member.X])
+	g.block(func() {
+		id, isIdent := member.X.(*ast.Ident)
+		if !isIdent {
+			t := g.temp("x")
+			id = &ast.Ident{Name: t}
+			g.scope.vars[t] = &variable{code: t, typ: g.info.Types[member.X], used: true}
+		}
+		g.info.Types[id] = elem
+		fun := &ast.Member{Span: member.Span, X: id, Name: member.Name}
+		narrowed := &ast.Call{Span: call.Span, Fun: fun, Args: call.Args}
+		g.info.Calls[narrowed] = g.info.Calls[call]
+		c, _, _ := g.call(narrowed)
+
+		if isIdent {
+			code = "if " + x.paren(precCompare+1) + " != nil {\n" + c.code + "\n}"
+		} else {
+			code = "if " + id.Name + " := " + x.code + "; " + id.Name + " != nil {\n" + c.code + "\n}"
+		}
+	})
+	return code
+}
+
+// ret generates a return statement, which stops the iterators of the loops
+// it leaves first.
+func (g *generator) ret(ret *ast.Return) {
+	fn := g.fn
+	var results []string
+	switch {
+	case fn.result == nil:
+	case fn.result == checker.Nil && len(ret.Results) == 0:
+		results = []string{"nil"}
+	case fn.multi && len(ret.Results) == 1:
+		results = g.unpack(ret.Results[0], len(fn.result.(*checker.Tuple).Elems))
+	case len(ret.Results) > 1 && !fn.multi:
+		results = []string{g.goType(fn.result) + "{" + g.valuesAs(ret.Results, fn.result.(*checker.Tuple).Elems...) + "}"}
+	case fn.multi:
+		results = []string{g.valuesAs(ret.Results, fn.result.(*checker.Tuple).Elems...)}
+	default:
+		results = []string{g.exprAs(ret.Results[0], fn.result).code}
+	}
+
+	if len(fn.iterators) > 0 && len(results) > 0 && !allPure(ret.Results) {
+		n := 1
+		if fn.multi {
+			n = len(fn.result.(*checker.Tuple).Elems)
+		}
+		temps := make([]string, n)
+		for i := range temps {
+			temps[i] = g.temp("result")
+		}
+		g.line("%s := %s", strings.Join(temps, ", "), strings.Join(results, ", "))
+		results = temps
+	}
+	for i := len(fn.iterators) - 1; i >= 0; i-- {
+		g.line("%s.Stop()", fn.iterators[i])
+	}
+
+	if len(results) == 0 {
+		g.line("return")
+		return
+	}
+	g.line("return %s", strings.Join(results, ", "))
+}
+
+func allPure(exprs []ast.Expr) bool {
+	for _, e := range exprs {
+		if !pure(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// unpack returns the code of the n elements of a tuple value: the results
+// of a call of a function returning them, the elements of a literal or
+// those of a tuple.
+func (g *generator) unpack(e ast.Expr, n int) []string {
+	switch e := e.(type) {
+	case *ast.Call:
+		code, t, multi := g.call(e)
+		if multi && t == g.info.Types[e] {
+			return []string{code.code}
+		}
+	case *ast.TupleLit:
+		var values []string
+		for _, elem := range e.Elems {
+			values = append(values, g.value(elem).code)
+		}
+		return values
+	}
+	return []string{g.expr(e).paren(precPrimary) + ".Unpack()"}
+}
+
+// varDecl generates the declaration of local variables, which Go rejects
+// if they are not used: the value of one that is not is only evaluated.
+func (g *generator) varDecl(decl *ast.VarDecl) {
+	if len(decl.Vars) > 1 {
+		values := strings.Join(g.unpack(decl.Value, len(decl.Vars)), ", ")
+		vars := make([]*variable, len(decl.Vars))
+		for i, v := range decl.Vars {
+			vars[i] = g.declare(v.Name.Name, g.info.Types[v.Type])
+		}
+		g.line("%s", g.later(func() string {
+			names := make([]string, len(vars))
+			op := " = "
+			for i, v := range vars {
+				names[i] = "_"
+				if v.used {
+					names[i], op = v.code, " := "
+				}
+			}
+			return strings.Join(names, ", ") + op + values
+		}))
+		return
+	}
+
+	t := g.info.Types[decl.Vars[0].Type]
+	value := g.exprAs(decl.Value, t)
+	v := g.declare(decl.Vars[0].Name.Name, t)
+	g.line("%s", g.later(func() string {
+		switch {
+		case !v.used && pure(decl.Value):
+			return ""
+		case !v.used:
+			return "_ = " + value.code
+		case value.untyped != "" && value.untyped != g.goType(t) || value.concrete || value.isNil || isInterface(t):
+			return "var " + v.code + " " + g.goType(t) + " = " + value.code
+		}
+		return v.code + " := " + value.code
+	}))
+}
+
+// globalVars generates the declaration of variables of the top level, as
+// package variables set by main.
+func (g *generator) globalVars(decl *ast.VarDecl) {
+	var names []string
+	for _, v := range decl.Vars {
+		t := g.info.Types[v.Type]
+		global := g.declare(v.Name.Name, t)
+		global.used = true
+		g.globals = append(g.globals, global.code+" "+g.goType(t))
+		names = append(names, global.code)
+	}
+
+	if len(names) > 1 {
+		g.line("%s = %s", strings.Join(names, ", "), strings.Join(g.unpack(decl.Value, len(names)), ", "))
+		return
+	}
+	g.line("%s = %s", names[0], g.exprAs(decl.Value, g.info.Types[decl.Vars[0].Type]).code)
+}
+
+// subject returns the code of a value matched against patterns, a
+// temporary holding it unless it is a variable, and the declaration of
+// the temporary, if any.
+func (g *generator) subject(e ast.Expr, x goExpr) (goExpr, string) {
+	if _, ok := e.(*ast.Ident); ok {
+		return x, ""
+	}
+	t := g.temp(tempName(e))
+	return primary(t), t
+}
+
+// tempName returns the name of a temporary holding the value of e.
+func tempName(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Call:
+		if id, ok := e.Fun.(*ast.Ident); ok {
+			return id.Name
+		}
+		if m, ok := e.Fun.(*ast.Member); ok {
+			return m.Name.Name
+		}
+	case *ast.Member:
+		return e.Name.Name
+	}
+	return "v"
+}
+
+// destructure generates the declaration of the variables a pattern binds,
+// which panics if the value does not match.
+func (g *generator) destructure(d *ast.Destructure, global bool) {
+	t := g.info.Types[d.Value]
+	value := g.expr(d.Value)
+	subject, temp := g.subject(d.Value, value)
+	conds, binds := g.pattern(d.Pattern, subject, t)
+
+	var vars []*variable
+	if temp != "" {
+		decl := temp + " := " + value.code
+		if global {
+			g.globals = append(g.globals, temp+" "+g.goType(t))
+			decl = temp + " = " + value.code
+		}
+		g.line("%s", g.later(func() string {
+			switch {
+			case global || len(conds) > 0 || anyUsed(vars):
+				return decl
+			case pure(d.Value):
+				return ""
+			}
+			return "_ = " + value.code
+		}))
+	}
+
+	if len(conds) > 0 {
+		g.line("if !(%s) {", condition(conds))
+		g.line("panic(%s)", g.mismatch(subject, t, d.Pattern))
+		g.line("}")
+	}
+	vars = g.bind(binds, global)
+}
+
+// mismatch returns the code of the message of the panic of a value that
+// does not match a pattern.
+func (g *generator) mismatch(subject goExpr, t checker.Type, p ast.Pattern) string {
+	return `"match -> " + rt.String(` + g.typed(subject, t).code + `) + ` + strconv.Quote(" does not match "+ast.String(p))
+}
+
+// switchStmt generates a switch statement as a Go switch on the conditions
+// of its arms. An arm that matches any value is the default.
+func (g *generator) switchStmt(s *ast.Switch) {
+	t := g.info.Types[s.X]
+	value := g.expr(s.X)
+	subject, temp := g.subject(s.X, value)
+
+	var patterns []ast.Pattern
+	var guards []ast.Expr
+	for _, arm := range s.Arms {
+		patterns, guards = append(patterns, arm.Pattern), append(guards, arm.Guard)
+	}
+	arms, vars := g.arms(subject, t, patterns, guards, func(i int) {
+		g.out.WriteString(g.body(s.Arms[i].Body))
+	})
+
+	defaulted := false
+	var b strings.Builder
+	for i, arm := range arms {
+		if arm.cond == "true" && i == len(arms)-1 {
+			b.WriteString("default:\n" + arm.code)
+			defaulted = true
+			continue
+		}
+		b.WriteString("case " + arm.cond + ":\n" + arm.code)
+	}
+
+	if temp == "" {
+		g.line("switch {")
+	} else {
+		g.line("%s", g.later(func() string {
+			for _, arm := range arms {
+				if arm.cond != "true" {
+					return "switch " + temp + " := " + value.code + "; {"
+				}
+			}
+			if anyUsed(vars) {
+				return "switch " + temp + " := " + value.code + "; {"
+			}
+			return "switch _ = " + value.code + "; {"
+		}))
+	}
+	g.out.WriteString(b.String())
+	g.line("}")
+
+	if g.fn != nil && g.fn.terminal[s] && !defaulted {
+		g.line(`panic("unreachable")`)
+	}
+}
+
+// match returns the code of a match expression: a function literal that
+// returns the value of the first arm that matches.
+func (g *generator) match(m *ast.Match) (goExpr, checker.Type) {
+	t := g.info.Types[m.X]
+	result := g.info.Types[m.Arms[0].Value]
+
+	var code string
+	g.block(func() {
+		value := g.expr(m.X)
+		subject, temp := g.subject(m.X, value)
+
+		var patterns []ast.Pattern
+		var guards []ast.Expr
+		for _, arm := range m.Arms {
+			patterns, guards = append(patterns, arm.Pattern), append(guards, arm.Guard)
+		}
+		fn := g.fn
+		g.fn = nil
+		arms, vars := g.arms(subject, t, patterns, guards, func(i int) {
+			g.line("return %s", g.expr(m.Arms[i].Value).code)
+		})
+		g.fn = fn
+
+		var b strings.Builder
+		b.WriteString("func() " + g.goType(result) + " {\n")
+		if temp != "" {
+			b.WriteString(g.later(func() string {
+				for _, arm := range arms {
+					if arm.cond != "true" || anyUsed(vars) {
+						return temp + " := " + value.code
+					}
+				}
+				return "_ = " + value.code
+			}) + "\n")
+		}
+		b.WriteString("switch {\n")
+		defaulted := false
+		for i, arm := range arms {
+			if arm.cond == "true" && i == len(arms)-1 {
+				b.WriteString("default:\n" + arm.code)
+				defaulted = true
+				continue
+			}
+			b.WriteString("case " + arm.cond + ":\n" + arm.code)
+		}
+		b.WriteString("}\n")
+		if !defaulted {
+			b.WriteString(`panic("match -> no arm matched " + rt.String(` + g.typed(subject, t).code + "))\n")
+		}
+		b.WriteString("}()")
+		code = b.String()
+	})
+	return primary(code), result
+}
+
+// forStmt generates a for loop over the values of a list, map, range or
+// iterator, each matched against the pattern of the loop.
+func (g *generator) forStmt(f *ast.For) {
+	t := g.info.Types[f.X]
+
+	// for i := a; i < b; i++
+	if r, ok := f.X.(*ast.Range); ok && pure(r.From) && pure(r.To) && (r.Exclusive || isConstant(r.To)) {
+		g.block(func() {
+			i := g.loopVar(f.Pattern, "i")
+			from := g.typed(g.expr(r.From), checker.Int).code
+			op := "<="
+			if r.Exclusive {
+				op = "<"
+			}
+			g.line("for %s := %s; %s %s %s; %s++ {", i.code, from, i.code, op, g.expr(r.To).code, i.code)
+			g.loopBody(f, i, checker.Int)
+		})
+		return
+	}
+
+	x := g.expr(f.X)
+	switch tt := t.(type) {
+	case *checker.List:
+		g.block(func() {
+			v := g.loopVar(f.Pattern, "v")
+			g.line("for %s := range %s {", g.later(func() string {
+				if v.used {
+					return "_, " + v.code
+				}
+				return "_"
+			}), x.code)
+			g.loopBody(f, v, tt.Elem)
+		})
+		return
+	case *checker.Map:
+		g.block(func() {
+			e := g.loopVar(f.Pattern, "e")
+			g.line("for %s := range %s.Entries() {", g.later(func() string {
+				if e.used {
+					return "_, " + e.code
+				}
+				return "_"
+			}), x.paren(precPrimary))
+			g.loopBody(f, e, checker.TupleOf(tt.Key, tt.Value))
+		})
+		return
+	}
+
+	if t == checker.Range {
+		r := g.temp("r")
+		g.block(func() {
+			i := g.temp("i")
+			g.line("%s := %s", r, x.code)
+			g.line("for %s := int64(0); %s < %s.Len(); %s++ {", i, i, r, i)
+			v := g.loopVar(f.Pattern, "v")
+			g.line("%s", g.later(func() string {
+				if !v.used {
+					return ""
+				}
+				return v.code + " := " + r + ".At(" + i + ")"
+			}))
+			g.loopBody(f, v, checker.Int)
+		})
+		return
+	}
+
+	// it := x; for it.Scan() { v := it.Value() }; it.Stop()
+	var elem checker.Type
+	switch tt := t.(type) {
+	case *checker.Iterator:
+		elem = tt.Elem
+	case *checker.Struct:
+		x = primary(x.paren(precPrimary) + "." + g.structs[tt.Name].methods["iter"].code + "()")
+		elem = tt.Methods["iter"].Result.(*checker.Iterator).Elem
+	}
+	it := g.temp("it")
+	g.block(func() {
+		g.line("%s := %s", it, x.code)
+		g.line("for %s.Scan() {", it)
+		v := g.loopVar(f.Pattern, "v")
+		g.line("%s", g.later(func() string {
+			if !v.used {
+				return ""
+			}
+			return v.code + " := " + it + ".Value()"
+		}))
+		if g.fn != nil {
+			g.fn.iterators = append(g.fn.iterators, it)
+			defer func() { g.fn.iterators = g.fn.iterators[:len(g.fn.iterators)-1] }()
+		}
+		g.loopBody(f, v, elem)
+		g.line("%s.Stop()", it)
+	})
+}
+
+func isConstant(e ast.Expr) bool {
+	_, _, ok := constant(e)
+	return ok
+}
+
+// loopVar returns the variable of a loop that holds each value: the one of
+// its pattern if it binds one, a temporary otherwise.
+func (g *generator) loopVar(p ast.Pattern, base string) *variable {
+	if b, ok := p.(*ast.BindingPattern); ok {
+		return &variable{code: goName(b.Name.Name)}
+	}
+	name := g.temp(base)
+	return &variable{code: name}
+}
+
+// loopBody generates the body of a loop whose variable v holds each value,
+// of type t, and closes it.
+func (g *generator) loopBody(f *ast.For, v *variable, t checker.Type) {
+	g.block(func() {
+		if b, ok := f.Pattern.(*ast.BindingPattern); ok {
+			g.scope.vars[b.Name.Name] = v
+			v.typ = t
+		} else {
+			conds, binds := g.pattern(f.Pattern, primary(v.code), t)
+			if len(conds) > 0 {
+				v.used = true
+				g.line("if !(%s) {", condition(conds))
+				g.line("panic(%s)", g.mismatch(primary(v.code), t, f.Pattern))
+				g.line("}")
+			}
+			vars := g.bind(binds, false)
+			defer func() {
+				if anyUsed(vars) {
+					v.used = true
+				}
+			}()
+		}
+		g.out.WriteString(g.stmts(f.Body.Stmts))
+	})
+	g.line("}")
+}
+
+// selectStmt generates a select statement. A receive arm gets nil once the
+// channel is closed.
+func (g *generator) selectStmt(s *ast.Select) {
+	g.line("select {")
+	for _, a := range s.Arms {
+		switch a := a.(type) {
+		case *ast.ReceiveArm:
+			ch := g.expr(a.Chan).code
+			elem := g.info.Types[a.Chan].(*checker.Chan).Elem
+			g.block(func() {
+				if a.Name == nil {
+					g.line("case <-%s:", ch)
+					g.out.WriteString(g.body(a.Body))
+					return
+				}
+
+				n := g.declare(a.Name.Name, checker.OptionalOf(elem))
+				v, ok := g.temp("v"), g.temp("ok")
+				received := "rt.Received(" + v + ", " + ok + ")"
+				switch {
+				case isInterface(elem) && !isEnum(elem):
+					received = v
+				case isOptional(elem):
+					received = "rt.Flatten(" + received + ")"
+				}
+				g.line("%s", g.later(func() string {
+					switch {
+					case !n.used:
+						return "case <-" + ch + ":"
+					case received == v:
+						return "case " + v + " := <-" + ch + ":\n" + n.code + " := " + v
+					}
+					return "case " + v + ", " + ok + " := <-" + ch + ":\n" + n.code + " := " + received
+				}))
+				g.out.WriteString(g.body(a.Body))
+			})
+		case *ast.SendArm:
+			g.line("case %s <- %s:", g.expr(a.Chan).code, g.expr(a.Value).code)
+			g.out.WriteString(g.body(a.Body))
+		case *ast.DefaultArm:
+			g.line("default:")
+			g.out.WriteString(g.body(a.Body))
+		}
+	}
+	g.line("}")
+}
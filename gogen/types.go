@@ -0,0 +1,222 @@
+package gogen
+
+import (
+	"bo/checker"
+	"strconv"
+	"strings"
+)
+
+// goType returns the Go type of the values of a Bo type.
+func (g *generator) goType(t checker.Type) string {
+	switch t := t.(type) {
+	case *checker.Basic:
+		switch t.Kind() {
+		case checker.IntKind:
+			return "int64"
+		case checker.FloatKind:
+			return "float64"
+		case checker.BigIntKind:
+			return "*big.Int"
+		case checker.DecimalKind:
+			return "decimal.Decimal"
+		case checker.NilKind, checker.AnyKind:
+			return "any"
+		}
+		return t.String()
+	case *checker.Optional:
+		if isAny(t.Elem) {
+			return "any"
+		}
+		return "*" + g.goType(t.Elem)
+	case *checker.List:
+		return "[]" + g.goType(t.Elem)
+	case *checker.Map:
+		return "*rt.Map[" + g.goType(t.Key) + ", " + g.goType(t.Value) + "]"
+	case *checker.Tuple:
+		if len(t.Elems) > 6 {
+			g.errorf("tuples of more than 6 values are not supported")
+		}
+		return "rt.Tuple" + strconv.Itoa(len(t.Elems)) + "[" + g.goTypes(t.Elems) + "]"
+	case *checker.Chan:
+		return "chan " + g.goType(t.Elem)
+	case *checker.Future:
+		return "*rt.Future[" + g.goType(t.Elem) + "]"
+	case *checker.Iterator:
+		return "*rt.Iterator[" + g.goType(t.Elem) + "]"
+	case *checker.Struct:
+		return g.structs[t.Name].name
+	case *checker.Enum:
+		return g.enums[t.Name].name
+	case *checker.Opaque:
+		switch t {
+		case checker.Range:
+			return "rt.Range"
+		case checker.WaitGroup:
+			return "*sync.WaitGroup"
+		case checker.Mutex:
+			return "*sync.Mutex"
+		}
+	}
+
+	g.errorf("%s values are not supported", t)
+	return ""
+}
+
+func (g *generator) goTypes(types []checker.Type) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = g.goType(t)
+	}
+	return strings.Join(names, ", ")
+}
+
+func isAny(t checker.Type) bool {
+	return t == checker.Any || t == checker.Nil
+}
+
+// isInterface reports whether the Go type of t is an interface type, whose
+// values have a more specific type of their own.
+func isInterface(t checker.Type) bool {
+	switch t := t.(type) {
+	case *checker.Enum:
+		return true
+	case *checker.Optional:
+		return isAny(t.Elem)
+	}
+	return isAny(t)
+}
+
+func elemType(t checker.Type) checker.Type {
+	if optional, ok := t.(*checker.Optional); ok {
+		return optional.Elem
+	}
+	return t
+}
+
+// Go precedences of the operators of an expression, from lowest to highest.
+const (
+	precOr = iota + 1
+	precAnd
+	precCompare
+	precAdd
+	precMul
+	precUnary
+	precPrimary
+)
+
+// goExpr is the code of an expression in Go.
+type goExpr struct {
+	code string
+	prec int
+
+	// The type Go gives the expression, an untyped constant, if it is not
+	// the one its Bo type maps to
+	untyped string
+
+	// Whether the expression is a value of a case of an enum rather than of
+	// the enum, or the nil literal
+	concrete bool
+	isNil    bool
+}
+
+func primary(code string) goExpr {
+	return goExpr{code: code, prec: precPrimary}
+}
+
+// paren returns the code of e as an operand of an operator of precedence
+// prec.
+func (e goExpr) paren(prec int) string {
+	if e.prec < prec {
+		return "(" + e.code + ")"
+	}
+	return e.code
+}
+
+// typed returns e as a value whose Go type is that of the Bo type t, for
+// contexts where Go infers the type from the value.
+func (g *generator) typed(e goExpr, t checker.Type) goExpr {
+	switch {
+	case e.isNil:
+		return primary("(" + g.goType(t) + ")(nil)")
+	case e.untyped != "" && e.untyped != g.goType(t), e.concrete:
+		return primary(g.goType(t) + "(" + e.code + ")")
+	}
+	return e
+}
+
+// convert returns e, a value of type from, as a value of type to, which it
+// widens to without loss.
+func (g *generator) convert(e goExpr, from, to checker.Type) goExpr {
+	if from == to {
+		return e
+	}
+
+	switch {
+	case isAny(to) || isInterface(to) && !isInterface(from):
+		if from == checker.Nil {
+			return primary("nil")
+		}
+		return g.typed(e, from)
+	case from == checker.Nil:
+		return goExpr{code: "nil", prec: precPrimary, isNil: true}
+	}
+
+	if o, ok := to.(*checker.Optional); ok {
+		if f, ok := from.(*checker.Optional); ok {
+			v := g.temp("v")
+			value := g.convert(primary(v), f.Elem, o.Elem)
+			return primary("rt.Then(" + e.code + ", func(" + v + " " + g.goType(f.Elem) + ") " + g.goType(o.Elem) + " { return " + value.code + " })")
+		}
+		if _, ok := o.Elem.(*checker.Enum); ok {
+			return primary("rt.Some[" + g.goType(o.Elem) + "](" + g.convert(e, from, o.Elem).code + ")")
+		}
+		return primary("rt.Some(" + g.typed(g.convert(e, from, o.Elem), o.Elem).code + ")")
+	}
+
+	f, fOk := from.(*checker.Basic)
+	t, tOk := to.(*checker.Basic)
+	if fOk && tOk && f.IsNumeric() && t.IsNumeric() {
+		if t.IsBig() {
+			return primary("rt.Convert[" + g.goType(t) + "](" + g.typed(e, f).code + ")")
+		}
+		return primary(g.goType(t) + "(" + e.code + ")")
+	}
+
+	g.errorf("cannot convert %s value to %s", from, to)
+	return goExpr{}
+}
+
+// explicit returns e, a value of type from, converted to type to with a
+// conversion, which checks at runtime that the value fits.
+func (g *generator) explicit(e goExpr, from, to checker.Type) goExpr {
+	f, fOk := from.(*checker.Basic)
+	t, tOk := elemType(to).(*checker.Basic)
+	if !fOk || !tOk || !f.IsNumeric() || !t.IsNumeric() || from == to || g.widens(from, to) {
+		return g.convert(e, from, to)
+	}
+
+	converted := primary("rt.Convert[" + g.goType(t) + "](" + g.typed(e, f).code + ")")
+	return g.convert(converted, t, to)
+}
+
+// widens reports whether values of the numeric type from convert to type
+// to without loss, so that a Go conversion will do.
+func (g *generator) widens(from, to checker.Type) bool {
+	f, t := from.(*checker.Basic), elemType(to).(*checker.Basic)
+	switch {
+	case t.IsBig() || f.IsBig():
+		return false
+	case f.IsFloat():
+		return t.IsFloat() && t.Bits() >= f.Bits()
+	case t.IsFloat():
+		mantissa := 53
+		if t == checker.Float32 {
+			mantissa = 24
+		}
+		return f.Bits() < mantissa
+	case f.IsUnsigned() == t.IsUnsigned():
+		return t.Bits() >= f.Bits()
+	default:
+		return f.IsUnsigned() && t.Bits() > f.Bits()
+	}
+}
@@ -6,30 +6,275 @@ program
 
 statement
     : requireStatement
+    | enumDeclaration
+    | structDeclaration
+    | functionDeclaration
+    | returnStatement
+    | deferStatement
+    | yieldStatement
+    | forStatement
     | variableDeclaration
+    | destructuringDeclaration
+    | switchStatement
+    | spawnStatement
+    | sendStatement
+    | selectStatement
+    | awaitStatement
     | functionCall
     ;
 
+block
+    : LBRACE statement* RBRACE
+    ;
+
 expression
-    : INT | FLOAT | STRING | BOOL | ID
+    : primary                                                 # primaryExpression
+    | MATCH expression LBRACE matchArm (COMMA matchArm)* COMMA? RBRACE # matchExpression
+    | typeSpec LPAREN expression RPAREN                       # conversionExpression
+    | expression (PERIOD | SAFE_PERIOD) ID                    # memberExpression
+    | expression functionParameters                           # callExpression
+    | expression LBRACK expression RBRACK                     # indexExpression
+    | (SUB | NOT) expression                                  # unaryExpression
+    | RECEIVE expression                                      # receiveExpression
+    | AWAIT expression                                        # awaitExpression
+    | expression (MUL | DIV | MOD | MUL_WRAP) expression      # multiplicativeExpression
+    | expression (ADD | SUB | ADD_WRAP | SUB_WRAP) expression # additiveExpression
+    | expression (RANGE | RANGE_EXCL) expression              # rangeExpression
+    | expression STEP expression                              # stepExpression
+    | expression (LT | LE | GT | GE) expression               # relationalExpression
+    | expression (EQ | NE) expression                         # equalityExpression
+    | expression AND expression                               # andExpression
+    | expression OR expression                                # orExpression
+    | expression COALESCE expression                          # coalesceExpression
+    ;
+
+primary
+    : INT | FLOAT | BIGINT | DECIMAL | STRING | BOOL | NIL | ID
+    | LPAREN expression RPAREN
+    | FUTURE // Future.all(a, b)
+    | listLiteral
+    | mapLiteral
+    | tupleLiteral
+    | structLiteral
+    ;
+
+listLiteral
+    : LBRACK (expression (COMMA expression)* COMMA?)? RBRACK // [1, 2, 3]
+    ;
+
+mapLiteral
+    : LBRACE (mapEntry (COMMA mapEntry)* COMMA?)? RBRACE // {"a": 1, "b": 2}
+    ;
+
+mapEntry
+    : expression COLON expression
+    ;
+
+tupleLiteral
+    : LPAREN expression (COMMA expression)+ RPAREN // (1, "a")
+    ;
+
+structLiteral
+    : ID LBRACE (fieldValue (COMMA fieldValue)* COMMA?)? RBRACE // Point{x: 1, y: 2}
+    ;
+
+fieldValue
+    : ID COLON expression
+    ;
+
+// Entry point for the expressions embedded in interpolated strings.
+embeddedExpression
+    : expression EOF
     ;
 
 functionParameters
-    : LPAREN (expression (COMMA expression)*)? RPAREN
+    : LPAREN (argument (COMMA argument)*)? RPAREN
+    ;
+
+argument
+    : (ID COLON)? expression // level: "warn"
     ;
 
 functionCall
     : ID functionParameters // foo(1, 2, 3);
-    | expression PERIOD ID functionParameters // foo.bar(1, 2, 3); | "foo".bar(1, 2, 3);
+    | expression (PERIOD | SAFE_PERIOD) ID functionParameters // foo.bar(1, 2, 3); | "foo".bar(1, 2, 3); | foo?.bar();
+    ;
+
+functionDeclaration
+    : ASYNC? FUNC (LPAREN receiver RPAREN)? (ID | operator) LPAREN (parameter (COMMA parameter)*)? RPAREN typeSpec? block // func parse(string s) (int, string) { ... }
+    ;
+
+// func (Vec a) + (Vec b) Vec overloads + for Vec values. Comparisons derive
+// from < and ==, string(v) from string
+operator
+    : ADD | SUB | MUL | DIV | MOD | EQ | LT | LBRACK RBRACK | 'string'
+    ;
+
+// func (Point p) norm() float { ... } is a method of Point
+receiver
+    : typeName ID
+    ;
+
+parameter
+    : typeSpec ID (ASSIGN expression)? // string level = "info"
+    | ELLIPSIS typeSpec ID             // ...any extra
+    ;
+
+returnStatement
+    : RETURN (expression (COMMA expression)*)? // return n, ""
+    ;
+
+// yield n produces the next value of a generator
+yieldStatement
+    : YIELD expression
+    ;
+
+// for (i, x) in enumerate(xs) { ... }
+forStatement
+    : FOR pattern IN expression block
+    ;
+
+// defer mu.unlock() runs the call when the function returns
+deferStatement
+    : DEFER functionCall
+    ;
+
+enumDeclaration
+    : ENUM ID LBRACE enumCase (COMMA enumCase)* COMMA? RBRACE // enum Color { Red, Green, Blue }
+    ;
+
+enumCase
+    : ID (LPAREN typeSpec (COMMA typeSpec)* RPAREN)? // Rgb(int, int, int)
+    ;
+
+structDeclaration
+    : STRUCT ID LBRACE (structField COMMA?)* RBRACE // struct Point { int x, int y }
+    ;
+
+structField
+    : typeSpec ID
+    ;
+
+// match color { Color.Rgb(r, _, _) if r > 127 => "reddish", _ => "other" }
+matchArm
+    : pattern guard? ARROW expression
+    ;
+
+// switch color { case .Red { println("red") } case _ {} }
+switchStatement
+    : SWITCH expression LBRACE switchArm* RBRACE
+    ;
+
+switchArm
+    : CASE pattern guard? block
+    ;
+
+guard
+    : IF expression
+    ;
+
+// Concurrency: spawn f(x) runs a call as a task of its own
+spawnStatement
+    : SPAWN functionCall
+    ;
+
+// await f() waits for a future whose value is not needed
+awaitStatement
+    : AWAIT expression
+    ;
+
+sendStatement
+    : expression RECEIVE expression // ch <- 1
+    ;
+
+// select { case v = <-ch { ... } case ch <- 1 { ... } default { ... } }
+selectStatement
+    : SELECT LBRACE selectArm* RBRACE
+    ;
+
+selectArm
+    : CASE (ID ASSIGN)? RECEIVE expression block              # receiveArm
+    | CASE expression RECEIVE expression block                # sendArm
+    | DEFAULT block                                           # defaultArm
+    ;
+
+pattern
+    : UNDERSCORE                                              # wildcardPattern
+    | (SUB? (INT | FLOAT | BIGINT | DECIMAL) | STRING | BOOL | NIL) # literalPattern
+    | ID? PERIOD ID (LPAREN (pattern (COMMA pattern)*)? RPAREN)? # casePattern
+    | LBRACK (pattern (COMMA pattern)*)? RBRACK               # listPattern
+    | ELLIPSIS ID?                                            # restPattern
+    | LBRACE (entryPattern (COMMA entryPattern)*)? RBRACE     # mapPattern
+    | LPAREN pattern (COMMA pattern)+ RPAREN                  # tuplePattern
+    | ID LBRACE (fieldPattern (COMMA fieldPattern)*)? RBRACE  # structPattern
+    | ID                                                      # bindingPattern
+    ;
+
+entryPattern
+    : pattern COLON pattern // "name": n
+    ;
+
+fieldPattern
+    : ID (COLON pattern)? // x, or x: pattern
     ;
 
 variableDeclaration
-    : typeSpec ID ASSIGN expression // int a = 1;
+    : typeSpec ID (COMMA typeSpec ID)* ASSIGN expression // int a = 1; int n, string err = parse(s);
+    ;
+
+destructuringDeclaration
+    : pattern ASSIGN expression // [a, b, ...rest] = list
     ;
 
 typeSpec
+    : (basicType | typeName | listType | mapType | tupleType | chanType | futureType | iteratorType) QUESTION? // int? holds an int or nil
+    ;
+
+typeName
+    : ID (PERIOD ID)? // Point, sync.WaitGroup
+    ;
+
+listType
+    : LBRACK RBRACK typeSpec // []int
+    ;
+
+mapType
+    : MAP LBRACK typeSpec RBRACK typeSpec // map[string]int
+    ;
+
+chanType
+    : CHAN LBRACK typeSpec RBRACK // chan[int]
+    ;
+
+futureType
+    : FUTURE LBRACK typeSpec RBRACK // Future[int]
+    ;
+
+iteratorType
+    : ITERATOR LBRACK typeSpec RBRACK // Iterator[int]
+    ;
+
+tupleType
+    : LPAREN typeSpec (COMMA typeSpec)+ RPAREN // (int, string)
+    ;
+
+basicType
     : 'int'
+    | 'int8'
+    | 'int16'
+    | 'int32'
+    | 'int64'
+    | 'uint8'
+    | 'uint16'
+    | 'uint32'
+    | 'uint64'
     | 'float'
+    | 'float32'
+    | 'bigint'
+    | 'decimal'
+    | 'byte'
+    | 'char'
+    | 'rune'
     | 'string'
     | 'bool'
     ;
@@ -43,27 +288,92 @@ importPath
     | STRING
     ;
 
+LE              : '<=';
+GE              : '>=';
+EQ              : '==';
+NE              : '!=';
 LT              : '<';
 GT              : '>';
 ASSIGN          : '=';
+ARROW           : '=>';
+RECEIVE         : '<-';
+
+ADD             : '+';
+SUB             : '-';
+MUL             : '*';
+DIV             : '/';
+MOD             : '%';
+AND             : '&&';
+OR              : '||';
+NOT             : '!';
+
+// Wrapping arithmetic: two's complement overflow instead of a runtime error
+ADD_WRAP        : '+%';
+SUB_WRAP        : '-%';
+MUL_WRAP        : '*%';
 
 LPAREN          : '(';
 RPAREN          : ')';
 LBRACE          : '{';
 RBRACE          : '}';
+LBRACK          : '[';
+RBRACK          : ']';
+COLON           : ':';
+ELLIPSIS        : '...';
+RANGE_EXCL      : '..<';
+RANGE           : '..';
 PERIOD          : '.';
 COMMA           : ',';
 SEMICOLON       : ';';
 
+// Optionals: int?, a?.b and a ?? b
+QUESTION        : '?';
+SAFE_PERIOD     : '?.';
+COALESCE        : '??';
+
 REQUIRE         : 'require';
+ENUM            : 'enum';
+MATCH           : 'match';
+SWITCH          : 'switch';
+CASE            : 'case';
+IF              : 'if';
+STRUCT          : 'struct';
+MAP             : 'map';
+FUNC            : 'func';
+RETURN          : 'return';
+SPAWN           : 'spawn';
+SELECT          : 'select';
+DEFAULT         : 'default';
+CHAN            : 'chan';
+ASYNC           : 'async';
+DEFER           : 'defer';
+AWAIT           : 'await';
+FUTURE          : 'Future';
+YIELD           : 'yield';
+FOR             : 'for';
+IN              : 'in';
+ITERATOR        : 'Iterator';
+STEP            : 'step';
 
-INT             : [0-9]+;
-FLOAT           : [0-9]+ '.' [0-9]*;
+INT             : DECIMALS
+                | '0' [xX] '_'? HEX_DIGITS
+                | '0' [oO] '_'? OCTAL_DIGITS
+                | '0' [bB] '_'? BINARY_DIGITS
+                ;
+// 1.5 rather than 1., so that 0..10 is a range
+FLOAT           : DECIMALS '.' DECIMALS EXPONENT?
+                | DECIMALS EXPONENT
+                ;
+// Arbitrary-precision literals: 12n is a bigint, 12.50m a decimal
+BIGINT          : INT 'n';
+DECIMAL         : DECIMALS ('.' DECIMALS)? 'm';
 BOOL            : 'true' | 'false';
-STRING          : '"' (ESC | ~["\\])* '"'
+NIL             : 'nil';
+STRING          : '"' (ESC | INTERPOLATION | ~["\\])* '"'
                 | '\'' (ESC | ~['\\])* '\''
                 ;
 
+UNDERSCORE      : '_';
 ID              : [a-zA-Z_][a-zA-Z0-9_]*;
 WS              : [ \t\r\n]+ -> skip;
 
@@ -71,7 +381,25 @@ WS              : [ \t\r\n]+ -> skip;
 S_COMMENT       : '//' ~[\r\n]* '\r'? '\n' -> channel(HIDDEN);
 M_COMMENT       : '/*' .*? '*/' -> channel(HIDDEN);
 
-fragment ESC    : '\\' (["\\/bfnrt] | UNICODE);
+fragment ESC    : '\\' (["\\/$bfnrt] | UNICODE);
 fragment UNICODE: 'u' HEX HEX HEX HEX;
 fragment HEX    : [0-9a-fA-F];
+
+// Digits may be separated by single underscores, as in 1_000_000
+fragment DECIMALS       : [0-9] ('_'? [0-9])*;
+fragment HEX_DIGITS     : HEX ('_'? HEX)*;
+fragment OCTAL_DIGITS   : [0-7] ('_'? [0-7])*;
+fragment BINARY_DIGITS  : [01] ('_'? [01])*;
+fragment EXPONENT       : [eE] [+-]? DECIMALS;
+
+// ${expression} inside a double-quoted string; braces and nested strings
+// are balanced so the embedded expression may contain both.
+fragment INTERPOLATION
+                : '${' INTERPOLATION_BODY* '}'
+                ;
+fragment INTERPOLATION_BODY
+                : STRING
+                | '{' INTERPOLATION_BODY* '}'
+                | ~["'{}]
+                ;
 fragment DIGIT  : INT | FLOAT;
\ No newline at end of file
@@ -0,0 +1,188 @@
+// Package botest runs Bo programs for the tests of the back ends and of the
+// optimizer, which check that a program prints what bo run prints and stops
+// with the same runtime error.
+package botest
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"bo/parser"
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Result is what a run of a program printed, and the message of the runtime
+// error it stopped with, "" if none.
+type Result struct {
+	Output string
+	Panic  string
+}
+
+// Program is a program of the corpus, checked.
+type Program struct {
+	Name string
+	File string
+	Prog *ast.Program
+	Info *checker.Info
+}
+
+// Root returns the directory of the module.
+func Root() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..")
+}
+
+// parseOnly are the files of parser/testdata that only test the parser,
+// and are not meant to check.
+var parseOnly = map[string]bool{
+	"lookahead.bo": true,
+	"syntax.bo":    true,
+}
+
+// Programs returns the programs of parser/testdata, but for those that
+// only test the parser, then those of dirs, each parsed again so that the
+// caller may change it. A program that does not check fails the test.
+func Programs(t testing.TB, dirs ...string) []*Program {
+	corpus := filepath.Join(Root(), "parser", "testdata")
+	var progs []*Program
+	for _, dir := range append([]string{corpus}, dirs...) {
+		files, err := filepath.Glob(filepath.Join(dir, "*.bo"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range files {
+			if dir == corpus && parseOnly[filepath.Base(file)] {
+				continue
+			}
+			p, err := Load(file)
+			if err != nil {
+				t.Fatalf("%s: %v", file, err)
+			}
+			progs = append(progs, p)
+		}
+	}
+	if len(progs) == 0 {
+		t.Fatal("no programs")
+	}
+	return progs
+}
+
+// Load parses and checks a program.
+func Load(file string) (*Program, error) {
+	prog, err := parser.ParseFile(file)
+	if err != nil {
+		return nil, err
+	}
+	info, err := checker.Check(prog)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSuffix(filepath.Base(file), ".bo")
+	return &Program{Name: name, File: file, Prog: prog, Info: info}, nil
+}
+
+var bo struct {
+	once sync.Once
+	path string
+	err  error
+}
+
+// Bo returns the path of the bo command, built once for all the tests of a
+// package.
+func Bo(t testing.TB) string {
+	bo.once.Do(func() {
+		dir, err := os.MkdirTemp("", "botest")
+		if err != nil {
+			bo.err = err
+			return
+		}
+		bo.path = filepath.Join(dir, "bo")
+		cmd := exec.Command("go", "build", "-o", bo.path, ".")
+		cmd.Dir = Root()
+		if out, err := cmd.CombinedOutput(); err != nil {
+			bo.err = errors.New(string(out))
+		}
+	})
+	if bo.err != nil {
+		t.Fatalf("building bo: %v", bo.err)
+	}
+	return bo.path
+}
+
+// Run runs a program with bo run and flags.
+func Run(t testing.TB, file string, flags ...string) Result {
+	return Exec(t, Bo(t), append(append([]string{"run"}, flags...), file)...)
+}
+
+// Exec runs a command that runs a program. The command fails the test if it
+// fails other than by a runtime error, which bo run and a generated C
+// program report as Runtime error: message on their standard error, and a
+// generated Go program as panic: message.
+func Exec(t testing.TB, name string, args ...string) Result {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	err := cmd.Run()
+
+	r := Result{Output: stdout.String()}
+	for _, line := range strings.Split(stderr.String(), "\n") {
+		msg, ok := strings.CutPrefix(line, "Runtime error: ")
+		if !ok {
+			msg, ok = strings.CutPrefix(line, "panic: ")
+		}
+		if ok {
+			r.Panic = msg
+			break
+		}
+	}
+	if err != nil && r.Panic == "" {
+		t.Fatalf("%s: %v\n%s", filepath.Base(name), err, stderr.String())
+	}
+	return r
+}
+
+// Compare fails the test if got is not want, showing the first line of
+// output that differs.
+func Compare(t testing.TB, got, want Result) {
+	t.Helper()
+	if got.Output != want.Output {
+		gotLines, wantLines := strings.Split(got.Output, "\n"), strings.Split(want.Output, "\n")
+		i := 0
+		for i < len(gotLines) && i < len(wantLines) && gotLines[i] == wantLines[i] {
+			i++
+		}
+		t.Errorf("line %d: got %q, want %q", i+1, line(gotLines, i), line(wantLines, i))
+	}
+	if got.Panic != want.Panic {
+		t.Errorf("got panic %q, want %q", got.Panic, want.Panic)
+	}
+}
+
+func line(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return "<end>"
+}
+
+// Unsupported reports whether err is a back end rejecting what it does not
+// translate, rather than failing.
+func Unsupported(err error) bool {
+	msg := err.Error()
+	return slices.ContainsFunc([]string{"not supported", "are supported", "must be"}, func(s string) bool {
+		return strings.Contains(msg, s)
+	})
+}
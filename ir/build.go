@@ -0,0 +1,288 @@
+package ir
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"fmt"
+	"slices"
+)
+
+type builder struct {
+	info *checker.Info
+	prog *Program
+
+	// The function being built, and main
+	fn   *funcState
+	main *funcState
+}
+
+// funcState is a function being built.
+type funcState struct {
+	fn *Func
+
+	// The block values are added to, nil once it ends
+	block *Block
+
+	// The variables and functions declared in each block of the function,
+	// innermost last. The outermost block of main holds those of the top
+	// level
+	scopes []map[string]*binding
+	main   bool
+}
+
+// binding is what a name refers to: a variable, the value it was declared
+// with, or a function.
+type binding struct {
+	value *Value
+	fn    *Func
+	decl  *ast.FuncDecl
+
+	// The name of a variable of the top level, and the global functions
+	// read it through, once one does
+	name   string
+	top    bool
+	global *Global
+}
+
+// Build lowers a checked program to SSA form. The blocks no path from the
+// entry of their function reaches, such as those after a return, are left
+// out.
+func Build(prog *ast.Program, info *checker.Info) (p *Program, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if bErr, ok := r.(*buildError); ok {
+				p, err = nil, bErr
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	b := &builder{info: info, prog: &Program{}}
+	main := b.function("main")
+	b.fn = &funcState{fn: main, scopes: []map[string]*binding{{}}, main: true}
+	b.main = b.fn
+	b.start(b.newBlock())
+	for _, stmt := range prog.Stmts {
+		b.stmt(stmt)
+	}
+	b.ret(nil)
+
+	for _, fn := range b.prog.Funcs {
+		finish(fn)
+	}
+	return b.prog, nil
+}
+
+// function adds a function to the program, named name unless another one
+// is.
+func (b *builder) function(name string) *Func {
+	fn := &Func{Name: unique(name, func(name string) bool {
+		for _, fn := range b.prog.Funcs {
+			if fn.Name == name {
+				return true
+			}
+		}
+		return false
+	})}
+	b.prog.Funcs = append(b.prog.Funcs, fn)
+	return fn
+}
+
+// unique returns name, or name with a number added if it is taken.
+func unique(name string, taken func(string) bool) string {
+	u := name
+	for i := 2; taken(u); i++ {
+		u = fmt.Sprintf("%s_%d", name, i)
+	}
+	return u
+}
+
+// finish leaves out the blocks of a function its entry does not reach,
+// and the phi operands that come from them, numbers its blocks and values
+// in order, and computes its dominator tree.
+func finish(fn *Func) {
+	reached := make(map[*Block]bool)
+	for _, b := range fn.Postorder() {
+		reached[b] = true
+	}
+
+	var blocks []*Block
+	for _, b := range fn.Blocks {
+		if !reached[b] {
+			continue
+		}
+		var preds []*Block
+		for _, v := range b.Values {
+			if v.Op != OpPhi {
+				continue
+			}
+			var args []*Value
+			for i, arg := range v.Args {
+				if reached[b.Preds[i]] {
+					args = append(args, arg)
+				}
+			}
+			v.Args = args
+		}
+		for _, p := range b.Preds {
+			if reached[p] {
+				preds = append(preds, p)
+			}
+		}
+		b.Preds = preds
+		blocks = append(blocks, b)
+	}
+	fn.Blocks = blocks
+
+	fn.nextID = 0
+	for i, b := range fn.Blocks {
+		b.ID = i
+		for _, v := range b.Values {
+			v.ID = fn.nextID
+			fn.nextID++
+		}
+	}
+	fn.ComputeDom()
+}
+
+// newBlock returns a block of the function being built, which is added to
+// it once started.
+func (b *builder) newBlock() *Block {
+	return &Block{Func: b.fn.fn}
+}
+
+// start makes a block the one values are added to.
+func (b *builder) start(block *Block) {
+	b.fn.fn.Blocks = append(b.fn.fn.Blocks, block)
+	b.fn.block = block
+}
+
+// current returns the block values are added to. Once it ends, the code
+// that follows cannot run, and goes to a block nothing jumps to.
+func (b *builder) current() *Block {
+	if b.fn.block == nil {
+		b.start(b.newBlock())
+	}
+	return b.fn.block
+}
+
+func (b *builder) emit(op Op, t checker.Type, aux interface{}, args ...*Value) *Value {
+	return b.current().NewValue(op, t, aux, args...)
+}
+
+// jump ends the current block with a jump to another.
+func (b *builder) jump(to *Block) {
+	block := b.current()
+	block.Kind = Plain
+	block.AddEdge(to)
+	b.fn.block = nil
+}
+
+// branch ends the current block with a branch on cond.
+func (b *builder) branch(cond *Value, then, els *Block) {
+	block := b.current()
+	block.Kind, block.Control = If, cond
+	block.AddEdge(then)
+	block.AddEdge(els)
+	b.fn.block = nil
+}
+
+// ret ends the current block with a return of v, nil for no value.
+func (b *builder) ret(v *Value) {
+	block := b.current()
+	block.Kind, block.Control = Return, v
+	b.fn.block = nil
+}
+
+// unreachable ends the current block, which never ends.
+func (b *builder) unreachable() {
+	b.current().Kind = Unreachable
+	b.fn.block = nil
+}
+
+func (b *builder) scope(f func()) {
+	b.fn.scopes = append(b.fn.scopes, make(map[string]*binding))
+	defer func() {
+		b.fn.scopes = b.fn.scopes[:len(b.fn.scopes)-1]
+	}()
+	f()
+}
+
+// topLevel reports whether the code being built is in the outermost block
+// of the top level.
+func (b *builder) topLevel() bool {
+	return b.fn.main && len(b.fn.scopes) == 1
+}
+
+// declare declares a variable with value v in the innermost block.
+func (b *builder) declare(name string, v *Value) {
+	b.fn.scopes[len(b.fn.scopes)-1][name] = &binding{value: v, name: name, top: b.topLevel()}
+}
+
+// lookup returns what name refers to, searching the blocks of the function
+// being built and then the top level.
+func (b *builder) lookup(node ast.Node, name string) *binding {
+	for i := len(b.fn.scopes) - 1; i >= 0; i-- {
+		if v, ok := b.fn.scopes[i][name]; ok {
+			return v
+		}
+	}
+	if !b.fn.main {
+		if v, ok := b.main.scopes[0][name]; ok {
+			return v
+		}
+	}
+	errorf(node, "%s is not supported", name)
+	return nil
+}
+
+// variable returns the value of a variable in the function being built.
+// A function reads a variable of the top level from a global, which main
+// stores right after computing its value.
+func (b *builder) variable(v *binding) *Value {
+	if !v.top || b.fn.main {
+		return v.value
+	}
+	if v.global == nil {
+		v.global = &Global{Name: unique(v.name, func(name string) bool {
+			for _, g := range b.prog.Globals {
+				if g.Name == name {
+					return true
+				}
+			}
+			return false
+		}), Type: v.value.Type}
+		b.prog.Globals = append(b.prog.Globals, v.global)
+		insertAfter(v.value, &Value{Op: OpStore, Aux: v.global, Args: []*Value{v.value}})
+	}
+	return b.emit(OpLoad, v.global.Type, v.global)
+}
+
+// insertAfter inserts a value into the block of another, after it and the
+// phis.
+func insertAfter(after, v *Value) {
+	block := after.Block
+	i := slices.Index(block.Values, after) + 1
+	for i < len(block.Values) && block.Values[i].Op == OpPhi {
+		i++
+	}
+	v.ID, v.Block = block.Func.nextID, block
+	block.Func.nextID++
+	block.Values = append(block.Values[:i], append([]*Value{v}, block.Values[i:]...)...)
+}
+
+type buildError struct {
+	line   int
+	column int
+	msg    string
+}
+
+func (e *buildError) Error() string {
+	return fmt.Sprintf("IR error at line %d:%d: %s", e.line, e.column, e.msg)
+}
+
+func errorf(node ast.Node, format string, args ...interface{}) {
+	pos := node.Pos()
+	panic(&buildError{line: pos.Line, column: pos.Column, msg: fmt.Sprintf(format, args...)})
+}
@@ -0,0 +1,103 @@
+package ir
+
+// ComputeDom computes the dominator tree of a function: the immediate
+// dominator of each block its entry reaches, and the blocks each
+// immediately dominates. It follows Cooper, Harvey and Kennedy, "A Simple,
+// Fast Dominance Algorithm", which refines the dominators of the blocks in
+// reverse postorder until none changes.
+func (f *Func) ComputeDom() {
+	for _, b := range f.Blocks {
+		b.Idom, b.Dominated, b.pre, b.post = nil, nil, 0, 0
+	}
+	if len(f.Blocks) == 0 {
+		return
+	}
+
+	order := f.Postorder()
+	index := make(map[*Block]int, len(order))
+	for i, b := range order {
+		index[b] = i
+	}
+
+	entry := f.Blocks[0]
+	idom := make(map[*Block]*Block, len(order))
+	idom[entry] = entry
+	intersect := func(a, b *Block) *Block {
+		for a != b {
+			for index[a] < index[b] {
+				a = idom[a]
+			}
+			for index[b] < index[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := len(order) - 2; i >= 0; i-- {
+			b := order[i]
+			var dom *Block
+			for _, p := range b.Preds {
+				if idom[p] == nil {
+					continue
+				}
+				if dom == nil {
+					dom = p
+				} else {
+					dom = intersect(p, dom)
+				}
+			}
+			if idom[b] != dom {
+				idom[b], changed = dom, true
+			}
+		}
+	}
+
+	for _, b := range f.Blocks {
+		if b != entry && idom[b] != nil {
+			b.Idom = idom[b]
+			b.Idom.Dominated = append(b.Idom.Dominated, b)
+		}
+	}
+	n := 0
+	var number func(b *Block)
+	number = func(b *Block) {
+		n++
+		b.pre = n
+		for _, d := range b.Dominated {
+			number(d)
+		}
+		n++
+		b.post = n
+	}
+	number(entry)
+}
+
+// Dominates reports whether every path from the entry of the function to
+// b goes through a, as ComputeDom last found. A block dominates itself.
+func (a *Block) Dominates(b *Block) bool {
+	return a.pre > 0 && b.pre > 0 && a.pre <= b.pre && b.post <= a.post
+}
+
+// Postorder returns the blocks the entry of a function reaches, each after
+// the blocks it reaches first.
+func (f *Func) Postorder() []*Block {
+	var order []*Block
+	if len(f.Blocks) == 0 {
+		return order
+	}
+	seen := make(map[*Block]bool)
+	var walk func(b *Block)
+	walk = func(b *Block) {
+		seen[b] = true
+		for _, s := range b.Succs {
+			if !seen[s] {
+				walk(s)
+			}
+		}
+		order = append(order, b)
+	}
+	walk(f.Blocks[0])
+	return order
+}
@@ -0,0 +1,287 @@
+package ir
+
+import (
+	"bo/ast"
+	"bo/checker"
+)
+
+// expr builds an expression and returns its value, of the type the
+// expression has before the checker converts it for where it is used.
+func (b *builder) expr(e ast.Expr) *Value {
+	t := b.info.Types[e]
+
+	switch e := e.(type) {
+	case *ast.Paren:
+		return b.expr(e.X)
+	case *ast.BasicLit:
+		return b.constant(e, false, t)
+	case *ast.Ident:
+		v := b.lookup(e, e.Name)
+		if v.fn != nil {
+			errorf(e, "functions as values are not supported")
+		}
+		return b.variable(v)
+	case *ast.Unary:
+		return b.unary(e, t)
+	case *ast.Binary:
+		return b.binary(e)
+	case *ast.Conversion:
+		return b.conversion(e)
+	case *ast.Call:
+		v := b.call(e)
+		if v == nil {
+			errorf(e, "%s does not return a value", ast.String(e.Fun))
+		}
+		return v
+	case *ast.Match:
+		return b.match(e, t)
+	case *ast.StringLit:
+		errorf(e, "strings are only supported as arguments of println")
+	default:
+		errorf(e, "%s is not supported", ast.Kind(e))
+	}
+	return nil
+}
+
+// exprAs builds an expression as a value of type t, converting an int to a
+// float.
+func (b *builder) exprAs(e ast.Expr, t checker.Type) *Value {
+	if lit, neg, ok := ast.NumericLiteral(e); ok {
+		return b.constant(lit, neg, t)
+	}
+	v := b.expr(e)
+	if v.Type == checker.Int && t == checker.Float {
+		return b.emit(OpIntToFloat, checker.Float, nil, v)
+	}
+	return v
+}
+
+// constant builds a literal, maybe negated, as a value of type t.
+func (b *builder) constant(lit *ast.BasicLit, neg bool, t checker.Type) *Value {
+	switch {
+	case lit.Kind == ast.Bool:
+		return b.emit(OpConst, checker.Bool, lit.Value == "true")
+	case t == checker.Float:
+		f := ast.FloatValue(lit)
+		if neg {
+			f = -f
+		}
+		return b.emit(OpConst, checker.Float, f)
+	case t == checker.Int:
+		n, err := ast.IntLiteral(lit.Value, neg)
+		if err != nil {
+			errorf(lit, "%s", err)
+		}
+		return b.emit(OpConst, checker.Int, n)
+	}
+	errorf(lit, "%s values are not supported", t)
+	return nil
+}
+
+func (b *builder) unary(e *ast.Unary, t checker.Type) *Value {
+	if lit, neg, ok := ast.NumericLiteral(e); ok {
+		return b.constant(lit, neg, t)
+	}
+
+	if e.Op == "!" {
+		return b.emit(OpNot, checker.Bool, nil, b.exprAs(e.X, checker.Bool))
+	}
+	x := b.expr(e.X)
+	return b.emit(OpNeg, x.Type, nil, x)
+}
+
+// The operations of the operators on ints and floats, and of those that
+// compare them.
+var (
+	arithOps = map[string]Op{
+		"+": OpAdd, "-": OpSub, "*": OpMul, "/": OpDiv, "%": OpRem,
+		"+%": OpAddWrap, "-%": OpSubWrap, "*%": OpMulWrap,
+	}
+	compareOps = map[string]Op{
+		"==": OpEq, "!=": OpNe, "<": OpLt, "<=": OpLe, ">": OpGt, ">=": OpGe,
+	}
+)
+
+func (b *builder) binary(e *ast.Binary) *Value {
+	if b.info.Operators[e] != nil {
+		errorf(e, "operator methods are not supported")
+	}
+
+	switch e.Op {
+	case "&&":
+		return b.logical(e, true)
+	case "||":
+		return b.logical(e, false)
+	case "??":
+		errorf(e, "optional values are not supported")
+	}
+
+	// The operands of a comparison are compared as floats if either is one
+	operands := b.info.Types[e.X]
+	if b.info.Types[e.Y] == checker.Float {
+		operands = checker.Float
+	}
+	scalar(e, operands)
+	x := b.exprAs(e.X, operands)
+	y := b.exprAs(e.Y, operands)
+
+	if op, ok := compareOps[e.Op]; ok {
+		return b.emit(op, checker.Bool, nil, x, y)
+	}
+	return b.emit(arithOps[e.Op], operands, nil, x, y)
+}
+
+// logical builds x && y, when and is true, or x || y as a branch on x to
+// a block that computes y. Their value is a phi of x, on the edge that
+// skips y, and y.
+func (b *builder) logical(e *ast.Binary, and bool) *Value {
+	x := b.exprAs(e.X, checker.Bool)
+	rhs, end := b.newBlock(), b.newBlock()
+	if and {
+		b.branch(x, rhs, end)
+	} else {
+		b.branch(x, end, rhs)
+	}
+
+	b.start(rhs)
+	y := b.exprAs(e.Y, checker.Bool)
+	b.jump(end)
+
+	b.start(end)
+	return b.emit(OpPhi, checker.Bool, nil, x, y)
+}
+
+// conversion converts between ints and floats. A float converts to the
+// int it truncates to, if there is one.
+func (b *builder) conversion(e *ast.Conversion) *Value {
+	t := b.info.Types[e.Type]
+	scalar(e, t)
+	if lit, neg, ok := ast.NumericLiteral(e.X); ok && (t == checker.Float || lit.Kind == ast.Int) {
+		return b.constant(lit, neg, t)
+	}
+
+	x := b.expr(e.X)
+	switch {
+	case x.Type == checker.Int && t == checker.Float:
+		return b.emit(OpIntToFloat, checker.Float, nil, x)
+	case x.Type == checker.Float && t == checker.Int:
+		return b.emit(OpFloatToInt, checker.Int, nil, x)
+	case x.Type != t:
+		errorf(e, "converting %s to %s is not supported", x.Type, t)
+	}
+	return x
+}
+
+// call builds a call of println or of a function of the top level, and
+// returns its result, nil if none.
+func (b *builder) call(call *ast.Call) *Value {
+	id, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		errorf(call.Fun, "calling %s is not supported", ast.String(call.Fun))
+	}
+	if id.Name == "println" && !b.declared(id.Name) {
+		b.println(b.info.Calls[call].Rest)
+		return nil
+	}
+
+	v := b.lookup(id, id.Name)
+	if v.fn == nil {
+		errorf(id, "calling %s is not supported", id.Name)
+	}
+	// The arguments given are built in the order they are written, then
+	// the default values
+	c := b.info.Calls[call]
+	args := make([]*Value, len(c.Args))
+	for _, i := range c.Written() {
+		args[i] = b.exprAs(c.Args[i], b.info.Types[v.decl.Params[i].Type])
+	}
+	for i, arg := range c.Args {
+		param := v.decl.Params[i]
+		if arg == nil {
+			if !ast.IsConstant(param.Default) {
+				errorf(call, "the default value of %s must be a constant", param.Name.Name)
+			}
+			args[i] = b.exprAs(param.Default, b.info.Types[param.Type])
+		}
+	}
+
+	result := b.emit(OpCall, v.fn.Result, v.fn, args...)
+	if v.fn.Result == nil {
+		return nil
+	}
+	return result
+}
+
+// declared reports whether the program declares name where it is used.
+func (b *builder) declared(name string) bool {
+	for _, scope := range b.fn.scopes {
+		if _, ok := scope[name]; ok {
+			return true
+		}
+	}
+	_, ok := b.main.scopes[0][name]
+	return ok
+}
+
+// println writes each value on a line of its own. The values are all
+// computed first, as they may print themselves.
+func (b *builder) println(args []ast.Expr) {
+	type part struct {
+		text  string
+		value *Value
+	}
+
+	var lines [][]part
+	for _, arg := range args {
+		var line []part
+		if lit, ok := ast.Unparen(arg).(*ast.StringLit); ok {
+			for _, p := range lit.Parts {
+				if p.Expr == nil {
+					line = append(line, part{text: p.Text})
+				} else {
+					line = append(line, part{value: b.expr(p.Expr)})
+				}
+			}
+		} else {
+			line = append(line, part{value: b.expr(arg)})
+		}
+		lines = append(lines, line)
+	}
+
+	for _, line := range lines {
+		for _, p := range line {
+			if p.value == nil {
+				b.emit(OpWriteString, nil, p.text)
+			} else {
+				b.emit(OpWrite, nil, nil, p.value)
+			}
+		}
+		b.emit(OpPrintln, nil, nil)
+	}
+}
+
+// match builds a match as a test of each arm in turn, which goes to the
+// end of the match with its value if the pattern matches and the guard
+// holds. The value of the match is a phi of those of the arms, and it
+// panics if none matches.
+func (b *builder) match(e *ast.Match, t checker.Type) *Value {
+	scalar(e, t)
+	subject := b.expr(e.X)
+	end := b.newBlock()
+	var values []*Value
+	for _, arm := range e.Arms {
+		b.scope(func() {
+			b.arm(arm.Pattern, arm.Guard, subject, func() {
+				values = append(values, b.exprAs(arm.Value, t))
+				b.jump(end)
+			})
+		})
+	}
+	if b.fn.block != nil {
+		b.emit(OpNoMatch, nil, nil, subject)
+		b.unreachable()
+	}
+
+	b.start(end)
+	return b.emit(OpPhi, t, nil, values...)
+}
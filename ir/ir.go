@@ -0,0 +1,208 @@
+// Package ir is a typed intermediate representation of checked programs in
+// static single assignment (SSA) form, between the syntax tree and the
+// backends. A function is a graph of basic blocks, each a list of values
+// that an operation computes once from other values, and ends by jumping
+// to a block, branching to one of two, returning or never ending. Where
+// control flow merges, a phi value picks the value of the block it came
+// from, so that every value is defined in one place, which dominates its
+// uses.
+//
+// Build lowers the scalar subset of the language, which the wasm and cgen
+// backends translate from this form: functions of int, float and bool
+// values, switches and matches on them, loops over ranges, and println.
+// The optimize package rewrites the syntax tree instead, as the engines
+// run the whole language from it.
+//
+// Bo variables are never assigned again, so a variable is the value it is
+// declared with, and phis only come from loops, matches and the && and ||
+// operators. Verify checks that a program is well formed, and WriteText
+// writes it in the text form bo ir prints.
+package ir
+
+import (
+	"bo/checker"
+	"fmt"
+)
+
+// Program is the functions of a program, the first of which, main, runs
+// its top-level statements, and the globals through which functions read
+// the variables of the top level.
+type Program struct {
+	Funcs   []*Func
+	Globals []*Global
+}
+
+// Global is a top-level variable a function reads. Main stores it once,
+// where the variable is declared.
+type Global struct {
+	Name string
+	Type checker.Type
+}
+
+// Func is a function. Its first block is its entry, whose first values are
+// its parameters.
+type Func struct {
+	Name   string
+	Params []*Value
+	Result checker.Type // nil when the function returns no value
+	Blocks []*Block
+
+	// The ID of the next value of the function
+	nextID int
+}
+
+// BlockKind is how a block ends.
+type BlockKind int
+
+const (
+	Plain       BlockKind = iota // jumps to its successor
+	If                           // branches to its first successor if Control is true, else to its second
+	Return                       // returns Control, or no value if nil
+	Unreachable                  // never ends, as it panics or cannot run
+)
+
+var blockKinds = [...]string{Plain: "plain", If: "if", Return: "return", Unreachable: "unreachable"}
+
+func (k BlockKind) String() string {
+	if int(k) < len(blockKinds) {
+		return blockKinds[k]
+	}
+	return fmt.Sprintf("BlockKind(%d)", int(k))
+}
+
+// Block is a basic block: values computed in order, phis first, and how
+// it ends.
+type Block struct {
+	ID      int
+	Kind    BlockKind
+	Values  []*Value
+	Control *Value
+	Preds   []*Block
+	Succs   []*Block
+	Func    *Func
+
+	// The immediate dominator of the block, nil for the entry, and the
+	// blocks it immediately dominates, set by ComputeDom
+	Idom      *Block
+	Dominated []*Block
+
+	// The numbers of the block in a walk of the dominator tree before and
+	// after those it dominates
+	pre, post int
+}
+
+// Value is the result of an operation, computed once. The phi operands of
+// a phi are in the order of the predecessors of its block.
+type Value struct {
+	ID    int
+	Op    Op
+	Type  checker.Type // nil when the operation is only done for its effect
+	Args  []*Value
+	Aux   interface{}
+	Block *Block
+}
+
+// Op is an operation. Int arithmetic panics on overflow and division by
+// zero, as Bo's does, but for the wrapping operations. Float arithmetic
+// follows IEEE 754.
+type Op int
+
+const (
+	OpConst   Op = iota // Aux: int64, float64 or bool
+	OpParam             // Aux: the name of the parameter
+	OpPhi               // one argument for each predecessor
+	OpAdd               // int or float
+	OpSub               // int or float
+	OpMul               // int or float
+	OpDiv               // int or float
+	OpRem               // int
+	OpAddWrap           // int
+	OpSubWrap           // int
+	OpMulWrap           // int
+	OpNeg               // int or float
+	OpNot               // bool
+	OpEq
+	OpNe
+	OpLt // int or float, and the other comparisons
+	OpLe
+	OpGt
+	OpGe
+	OpIntToFloat
+	OpFloatToInt // panics if the float truncates to no int
+	OpCall       // Aux: the *Func called
+	OpLoad       // Aux: the *Global read
+	OpStore      // Aux: the *Global written
+	OpWrite      // writes a value to the line println prints
+	OpWriteString
+	OpPrintln // prints the line written, and a newline
+	OpNoMatch // panics as no arm of a match matched its argument
+)
+
+var opNames = [...]string{
+	OpConst: "const", OpParam: "param", OpPhi: "phi",
+	OpAdd: "add", OpSub: "sub", OpMul: "mul", OpDiv: "div", OpRem: "rem",
+	OpAddWrap: "addwrap", OpSubWrap: "subwrap", OpMulWrap: "mulwrap",
+	OpNeg: "neg", OpNot: "not",
+	OpEq: "eq", OpNe: "ne", OpLt: "lt", OpLe: "le", OpGt: "gt", OpGe: "ge",
+	OpIntToFloat: "inttofloat", OpFloatToInt: "floattoint",
+	OpCall: "call", OpLoad: "load", OpStore: "store",
+	OpWrite: "write", OpWriteString: "writestring", OpPrintln: "println",
+	OpNoMatch: "nomatch",
+}
+
+func (op Op) String() string {
+	if int(op) < len(opNames) && opNames[op] != "" {
+		return opNames[op]
+	}
+	return fmt.Sprintf("Op(%d)", int(op))
+}
+
+// NewBlock adds an empty block to the end of a function.
+func (f *Func) NewBlock() *Block {
+	b := &Block{ID: len(f.Blocks), Func: f}
+	f.Blocks = append(f.Blocks, b)
+	return b
+}
+
+// NewValue adds a value to the end of a block.
+func (b *Block) NewValue(op Op, t checker.Type, aux interface{}, args ...*Value) *Value {
+	v := &Value{ID: b.Func.nextID, Op: op, Type: t, Args: args, Aux: aux, Block: b}
+	b.Func.nextID++
+	b.Values = append(b.Values, v)
+	return v
+}
+
+// AddEdge makes to a successor of b.
+func (b *Block) AddEdge(to *Block) {
+	b.Succs = append(b.Succs, to)
+	to.Preds = append(to.Preds, b)
+}
+
+// PredIndex returns the index among the predecessors of the successor i
+// of b of the edge from b, which may go to it more than once: the index of
+// the operands of its phis that come from b.
+func (b *Block) PredIndex(i int) int {
+	to, n := b.Succs[i], 0
+	for _, s := range b.Succs[:i] {
+		if s == to {
+			n++
+		}
+	}
+	for j, p := range to.Preds {
+		if p == b {
+			if n == 0 {
+				return j
+			}
+			n--
+		}
+	}
+	panic(fmt.Sprintf("PredIndex -> %s is not a predecessor of %s", b, to))
+}
+
+func (b *Block) String() string {
+	return fmt.Sprintf("b%d", b.ID)
+}
+
+func (v *Value) String() string {
+	return fmt.Sprintf("v%d", v.ID)
+}
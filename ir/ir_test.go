@@ -0,0 +1,212 @@
+package ir_test
+
+import (
+	"bo/checker"
+	"bo/internal/botest"
+	"bo/ir"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestBuild builds the programs of parser/testdata, cgen/testdata and
+// wasm/testdata that keep to the scalar subset, and checks that each is
+// well formed.
+func TestBuild(t *testing.T) {
+	built := 0
+	dirs := []string{filepath.Join("..", "cgen", "testdata"), filepath.Join("..", "wasm", "testdata")}
+	for _, p := range botest.Programs(t, dirs...) {
+		prog, err := ir.Build(p.Prog, p.Info)
+		if err != nil {
+			if !botest.Unsupported(err) {
+				t.Errorf("%s: %v", p.Name, err)
+			}
+			continue
+		}
+		built++
+		if err := ir.Verify(prog); err != nil {
+			t.Errorf("%s: %v", p.Name, err)
+		}
+	}
+	if built == 0 {
+		t.Error("no program built")
+	}
+}
+
+// abs is a function that returns the absolute value of its parameter,
+// with a phi where its two branches merge:
+//
+//	b0: v0 = param n; v1 = const 0; v2 = lt v0 v1; if v2 b1 b2
+//	b1: v3 = neg v0; jump b3
+//	b2: jump b3
+//	b3: v4 = phi v3 v0; return v4
+type abs struct {
+	prog           *ir.Program
+	fn             *ir.Func
+	b0, b1, b2, b3 *ir.Block
+	n, zero, less  *ir.Value
+	neg, phi       *ir.Value
+}
+
+func newAbs() *abs {
+	a := &abs{fn: &ir.Func{Name: "abs", Result: checker.Int}}
+	a.prog = &ir.Program{Funcs: []*ir.Func{a.fn}}
+	a.b0, a.b1, a.b2, a.b3 = a.fn.NewBlock(), a.fn.NewBlock(), a.fn.NewBlock(), a.fn.NewBlock()
+
+	a.n = a.b0.NewValue(ir.OpParam, checker.Int, "n")
+	a.fn.Params = []*ir.Value{a.n}
+	a.zero = a.b0.NewValue(ir.OpConst, checker.Int, int64(0))
+	a.less = a.b0.NewValue(ir.OpLt, checker.Bool, nil, a.n, a.zero)
+	a.b0.Kind, a.b0.Control = ir.If, a.less
+	a.b0.AddEdge(a.b1)
+	a.b0.AddEdge(a.b2)
+
+	a.neg = a.b1.NewValue(ir.OpNeg, checker.Int, nil, a.n)
+	a.b1.AddEdge(a.b3)
+	a.b2.AddEdge(a.b3)
+
+	a.phi = a.b3.NewValue(ir.OpPhi, checker.Int, nil, a.neg, a.n)
+	a.b3.Kind, a.b3.Control = ir.Return, a.phi
+	return a
+}
+
+// TestVerify checks that Verify accepts a well formed function, and finds
+// what is wrong with each of a number of broken ones.
+func TestVerify(t *testing.T) {
+	if err := ir.Verify(newAbs().prog); err != nil {
+		t.Fatalf("well formed: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		breaks func(a *abs)
+		want   string
+	}{
+		{"no blocks", func(a *abs) { a.fn.Blocks = nil }, "func abs: no blocks"},
+		{"two functions named alike", func(a *abs) {
+			a.prog.Funcs = append(a.prog.Funcs, &ir.Func{Name: "abs"})
+		}, "two functions are named abs"},
+		{"missing successor", func(a *abs) { a.b1.Succs = nil }, "b1: plain block with 0 successors"},
+		{"missing predecessor", func(a *abs) { a.b3.Preds = a.b3.Preds[1:] }, "b1: successor b3 does not list it as a predecessor"},
+		{"successor of another function", func(a *abs) {
+			other := (&ir.Func{Name: "other"}).NewBlock()
+			a.b2.Succs[0] = other
+		}, "b2: successor b0 is not a block of the function"},
+		{"unreached block", func(a *abs) {
+			a.fn.NewBlock().Kind = ir.Unreachable
+		}, "b4: not reached from the entry"},
+		{"entry with predecessors", func(a *abs) { a.b2.Succs[0], a.b0.Preds = a.b0, []*ir.Block{a.b2} }, "b0: the entry has predecessors"},
+		{"parameter out of the entry", func(a *abs) { a.fn.Params = []*ir.Value{a.zero} }, "parameter 0 is not value 0 of the entry"},
+		{"phi with too few operands", func(a *abs) { a.phi.Args = a.phi.Args[:1] }, "phi with 1 operands in a block with 2 predecessors"},
+		{"phi after another value", func(a *abs) {
+			a.b3.Values = nil
+			a.b3.NewValue(ir.OpPrintln, nil, nil)
+			a.b3.Values = append(a.b3.Values, a.phi)
+		}, "phi after a value that is not one"},
+		{"operand that does not dominate its use", func(a *abs) {
+			a.b2.NewValue(ir.OpWrite, nil, nil, a.neg)
+		}, "operand v3 does not dominate it"},
+		{"operand used before it is computed", func(a *abs) {
+			a.b0.Values[1], a.b0.Values[2] = a.b0.Values[2], a.b0.Values[1]
+		}, "operand v1 does not dominate it"},
+		{"phi operand that does not dominate its predecessor", func(a *abs) {
+			a.phi.Args[0], a.phi.Args[1] = a.phi.Args[1], a.phi.Args[0]
+		}, "operand v3 does not dominate predecessor b2"},
+		{"operand of another function", func(a *abs) {
+			other := (&ir.Func{Name: "other"}).NewBlock()
+			a.neg.Args[0] = other.NewValue(ir.OpConst, checker.Int, int64(1))
+		}, "is not a value of the function"},
+		{"operands of mixed types", func(a *abs) {
+			a.zero.Type, a.zero.Aux = checker.Float, 0.0
+		}, "operand v1 of lt is a float, not a int"},
+		{"constant of the wrong type", func(a *abs) { a.zero.Aux = 0.0 }, "const of type int"},
+		{"if on an int", func(a *abs) { a.b0.Control = a.n }, "b0: if block without a bool control"},
+		{"return of the wrong type", func(a *abs) { a.b3.Control = a.less }, "b3: returns a bool from a function with a int result"},
+		{"return of no value", func(a *abs) { a.b3.Control = nil }, "returns no value from a function with a int result"},
+		{"call of a function of another program", func(a *abs) {
+			a.b1.NewValue(ir.OpCall, nil, &ir.Func{Name: "elsewhere"})
+		}, "call of a function that is not one of the program"},
+		{"call with too few arguments", func(a *abs) {
+			a.b1.NewValue(ir.OpCall, checker.Int, a.fn)
+		}, "call takes 1 operands, not 0"},
+		{"load of an unknown global", func(a *abs) {
+			a.b1.NewValue(ir.OpLoad, checker.Int, &ir.Global{Name: "g", Type: checker.Int})
+		}, "load of a global that is not one of the program"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := newAbs()
+			test.breaks(a)
+			err := ir.Verify(a.prog)
+			if err == nil {
+				t.Fatalf("no error, want one with %q", test.want)
+			}
+			if !strings.Contains(err.Error(), test.want) {
+				t.Errorf("error %q, want one with %q", err, test.want)
+			}
+		})
+	}
+}
+
+// TestDom checks the dominator trees of a loop nested in another, with a
+// branch out of the inner one, and of a block the entry does not reach:
+//
+//	b0 -> b1
+//	b1 -> b2, b6   outer loop header
+//	b2 -> b3       inner loop header
+//	b3 -> b4, b5
+//	b4 -> b3, b6   back to the inner header, or out of both loops
+//	b5 -> b1       back to the outer header
+//	b6             return
+//	b7 -> b6       not reached
+func TestDom(t *testing.T) {
+	fn := &ir.Func{Name: "loops"}
+	b := make([]*ir.Block, 8)
+	for i := range b {
+		b[i] = fn.NewBlock()
+	}
+	edges := [][]int{{1}, {2, 6}, {3}, {4, 5}, {3, 6}, {1}, {}, {6}}
+	for from, tos := range edges {
+		for _, to := range tos {
+			b[from].AddEdge(b[to])
+		}
+		if len(tos) == 2 {
+			b[from].Kind = ir.If
+		}
+	}
+	b[6].Kind = ir.Return
+	fn.ComputeDom()
+
+	idoms := map[int]int{1: 0, 2: 1, 3: 2, 4: 3, 5: 3, 6: 1}
+	for i, block := range b {
+		want, ok := idoms[i]
+		switch {
+		case !ok && block.Idom != nil:
+			t.Errorf("idom of b%d is %s, want none", i, block.Idom)
+		case ok && block.Idom != b[want]:
+			t.Errorf("idom of b%d is %v, want b%d", i, block.Idom, want)
+		}
+	}
+	if got := b[3].Dominated; !slices.Equal(got, []*ir.Block{b[4], b[5]}) {
+		t.Errorf("b3 immediately dominates %v, want [b4 b5]", got)
+	}
+
+	dominates := []struct {
+		a, b int
+		want bool
+	}{
+		{0, 6, true}, {1, 1, true}, {1, 5, true}, {2, 4, true},
+		{2, 6, false}, {3, 6, false}, {4, 5, false}, {5, 1, false},
+		{7, 6, false}, {6, 7, false}, {7, 7, false},
+	}
+	for _, d := range dominates {
+		if got := b[d.a].Dominates(b[d.b]); got != d.want {
+			t.Errorf("b%d dominates b%d: %v, want %v", d.a, d.b, got, d.want)
+		}
+	}
+
+	if got := fn.Postorder(); len(got) != 7 || got[len(got)-1] != b[0] || slices.Contains(got, b[7]) {
+		t.Errorf("postorder %v, want the 7 reached blocks, b0 last", got)
+	}
+}
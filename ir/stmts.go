@@ -0,0 +1,209 @@
+package ir
+
+import (
+	"bo/ast"
+	"bo/checker"
+)
+
+func (b *builder) stmt(stmt ast.Stmt) {
+	switch stmt := stmt.(type) {
+	case *ast.FuncDecl:
+		b.funcDecl(stmt)
+	case *ast.Return:
+		switch len(stmt.Results) {
+		case 0:
+			b.ret(nil)
+		case 1:
+			b.ret(b.exprAs(stmt.Results[0], b.fn.fn.Result))
+		default:
+			errorf(stmt, "returning several values is not supported")
+		}
+	case *ast.VarDecl:
+		if len(stmt.Vars) > 1 {
+			errorf(stmt, "declaring several variables at once is not supported")
+		}
+		v := stmt.Vars[0]
+		t := b.info.Types[v.Type]
+		scalar(v, t)
+		b.declare(v.Name.Name, b.exprAs(stmt.Value, t))
+	case *ast.CallStmt:
+		b.call(stmt.Call)
+	case *ast.Switch:
+		b.switchStmt(stmt)
+	case *ast.For:
+		b.forStmt(stmt)
+	default:
+		errorf(stmt, "%s is not supported", ast.Kind(stmt))
+	}
+}
+
+func (b *builder) blockStmt(block *ast.Block) {
+	b.scope(func() {
+		for _, stmt := range block.Stmts {
+			b.stmt(stmt)
+		}
+	})
+}
+
+// funcDecl builds a function of the top level. A function with a result
+// ends in an unreachable block if its end is reached, as the checker made
+// sure it returns before.
+func (b *builder) funcDecl(decl *ast.FuncDecl) {
+	switch {
+	case !b.topLevel():
+		errorf(decl, "functions must be declared at the top level")
+	case decl.Recv != nil:
+		errorf(decl, "methods are not supported")
+	case decl.Async:
+		errorf(decl, "async functions are not supported")
+	case b.info.Generators[decl]:
+		errorf(decl, "generators are not supported")
+	}
+
+	fn := b.function(decl.Name.Name)
+	b.fn.scopes[0][decl.Name.Name] = &binding{fn: fn, decl: decl}
+
+	enclosing := b.fn
+	b.fn = &funcState{fn: fn, scopes: []map[string]*binding{{}}}
+	defer func() { b.fn = enclosing }()
+
+	b.start(b.newBlock())
+	for _, param := range decl.Params {
+		if param.Variadic {
+			errorf(param, "variadic parameters are not supported")
+		}
+		t := b.info.Types[param.Type]
+		scalar(param, t)
+		v := b.emit(OpParam, t, param.Name.Name)
+		fn.Params = append(fn.Params, v)
+		b.declare(param.Name.Name, v)
+	}
+	if decl.Result != nil {
+		fn.Result = b.info.Types[decl.Result]
+		scalar(decl.Result, fn.Result)
+	}
+
+	b.blockStmt(decl.Body)
+	if b.fn.block != nil {
+		if fn.Result != nil {
+			b.unreachable()
+		} else {
+			b.ret(nil)
+		}
+	}
+}
+
+// switchStmt builds a switch as a test of each arm in turn, which runs its
+// body and goes on after the switch if the pattern matches and the guard
+// holds.
+func (b *builder) switchStmt(stmt *ast.Switch) {
+	subject := b.expr(stmt.X)
+	end := b.newBlock()
+	for _, arm := range stmt.Arms {
+		b.scope(func() {
+			b.arm(arm.Pattern, arm.Guard, subject, func() {
+				b.blockStmt(arm.Body)
+				if b.fn.block != nil {
+					b.jump(end)
+				}
+			})
+		})
+	}
+	if b.fn.block != nil {
+		b.jump(end)
+	}
+	b.start(end)
+}
+
+// forStmt builds a loop over a range as a header that tests whether the
+// counter, a phi of the first value and the next, is in the range, and
+// the body. After the last value of an inclusive range, the loop ends
+// before the counter would overflow.
+func (b *builder) forStmt(stmt *ast.For) {
+	r, ok := ast.Unparen(stmt.X).(*ast.Range)
+	if !ok {
+		errorf(stmt.X, "only loops over a range are supported")
+	}
+	from := b.exprAs(r.From, checker.Int)
+	to := b.exprAs(r.To, checker.Int)
+
+	header, body, end := b.newBlock(), b.newBlock(), b.newBlock()
+	b.jump(header)
+	b.start(header)
+	i := b.emit(OpPhi, checker.Int, nil, from)
+	cmp := OpLe
+	if r.Exclusive {
+		cmp = OpLt
+	}
+	b.branch(b.emit(cmp, checker.Bool, nil, i, to), body, end)
+
+	b.start(body)
+	b.scope(func() {
+		switch p := stmt.Pattern.(type) {
+		case *ast.BindingPattern:
+			b.declare(p.Name.Name, i)
+		case *ast.WildcardPattern:
+		default:
+			errorf(p, "%s is not supported", ast.Kind(p))
+		}
+		b.blockStmt(stmt.Body)
+	})
+
+	if b.fn.block != nil {
+		if !r.Exclusive {
+			next := b.newBlock()
+			b.branch(b.emit(OpEq, checker.Bool, nil, i, to), end, next)
+			b.start(next)
+		}
+		one := b.emit(OpConst, checker.Int, int64(1))
+		i.Args = append(i.Args, b.emit(OpAddWrap, checker.Int, nil, i, one))
+		b.jump(header)
+	}
+	b.start(end)
+}
+
+// arm binds the variable of the pattern of an arm to the subject, and
+// runs body if the pattern matches and the guard holds, going on with the
+// next arm otherwise.
+func (b *builder) arm(pattern ast.Pattern, guard ast.Expr, subject *Value, body func()) {
+	var conds []func() *Value
+	switch p := pattern.(type) {
+	case *ast.WildcardPattern:
+	case *ast.BindingPattern:
+		b.declare(p.Name.Name, subject)
+	case *ast.LiteralPattern:
+		lit, ok := p.Value.(*ast.BasicLit)
+		if !ok || lit.Kind == ast.Nil {
+			errorf(p, "%s patterns are not supported", ast.String(p))
+		}
+		conds = append(conds, func() *Value {
+			return b.emit(OpEq, checker.Bool, nil, subject, b.constant(lit, p.Neg, subject.Type))
+		})
+	default:
+		errorf(p, "%s is not supported", ast.Kind(p))
+	}
+	if guard != nil {
+		conds = append(conds, func() *Value { return b.exprAs(guard, checker.Bool) })
+	}
+
+	next := b.newBlock()
+	for _, cond := range conds {
+		then := b.newBlock()
+		b.branch(cond(), then, next)
+		b.start(then)
+	}
+	body()
+	if len(conds) > 0 {
+		b.start(next)
+	}
+}
+
+// scalar reports an error unless t is the type of int, float or bool
+// values.
+func scalar(node ast.Node, t checker.Type) {
+	switch t {
+	case checker.Int, checker.Float, checker.Bool:
+		return
+	}
+	errorf(node, "%s values are not supported", t)
+}
@@ -0,0 +1,135 @@
+package ir
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// WriteText writes the text form of a program: its globals, then each
+// function with its blocks in order. A block is headed by its
+// predecessors, in the order of the operands of its phis, and its
+// immediate dominator.
+func WriteText(w io.Writer, p *Program) {
+	for _, g := range p.Globals {
+		fmt.Fprintf(w, "global %s %s\n", g.Name, g.Type)
+	}
+	for i, fn := range p.Funcs {
+		if i > 0 || len(p.Globals) > 0 {
+			fmt.Fprintln(w)
+		}
+		writeFunc(w, fn)
+	}
+}
+
+func writeFunc(w io.Writer, fn *Func) {
+	var params []string
+	for _, param := range fn.Params {
+		params = append(params, fmt.Sprintf("%s %s", param.Aux, param.Type))
+	}
+	fmt.Fprintf(w, "func %s(%s)", fn.Name, strings.Join(params, ", "))
+	if fn.Result != nil {
+		fmt.Fprintf(w, " %s", fn.Result)
+	}
+	fmt.Fprintln(w, " {")
+
+	for _, b := range fn.Blocks {
+		fmt.Fprintf(w, "%s:", b)
+		var notes []string
+		if len(b.Preds) > 0 {
+			notes = append(notes, "preds "+blockList(b.Preds))
+		}
+		if b.Idom != nil {
+			notes = append(notes, "idom "+b.Idom.String())
+		}
+		if len(notes) > 0 {
+			fmt.Fprintf(w, " ; %s", strings.Join(notes, ", "))
+		}
+		fmt.Fprintln(w)
+
+		for _, v := range b.Values {
+			fmt.Fprintf(w, "\t%s\n", valueText(v))
+		}
+		fmt.Fprintf(w, "\t%s\n", endText(b))
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// valueText returns the text form of a value, as the value it defines, if
+// any, its operation and type, and its operands.
+func valueText(v *Value) string {
+	var b strings.Builder
+	if v.Type != nil {
+		fmt.Fprintf(&b, "%s = %s <%s>", v, v.Op, v.Type)
+	} else {
+		b.WriteString(v.Op.String())
+	}
+
+	switch aux := v.Aux.(type) {
+	case nil:
+	case float64:
+		b.WriteString(" " + floatText(aux))
+	case string:
+		if v.Op == OpWriteString {
+			b.WriteString(" " + strconv.Quote(aux))
+		} else {
+			b.WriteString(" " + aux)
+		}
+	case *Func:
+		b.WriteString(" " + aux.Name)
+	case *Global:
+		b.WriteString(" " + aux.Name)
+	default:
+		fmt.Fprintf(&b, " %v", aux)
+	}
+	for _, arg := range v.Args {
+		b.WriteString(" " + arg.String())
+	}
+	return b.String()
+}
+
+// endText returns the text form of how a block ends.
+func endText(b *Block) string {
+	switch b.Kind {
+	case Plain:
+		if len(b.Succs) == 1 {
+			return "jump " + b.Succs[0].String()
+		}
+	case If:
+		if b.Control != nil && len(b.Succs) == 2 {
+			return fmt.Sprintf("if %s %s %s", b.Control, b.Succs[0], b.Succs[1])
+		}
+	case Return:
+		if b.Control != nil {
+			return "return " + b.Control.String()
+		}
+		return "return"
+	}
+	if len(b.Succs) > 0 {
+		return b.Kind.String() + " " + blockList(b.Succs)
+	}
+	return b.Kind.String()
+}
+
+func blockList(blocks []*Block) string {
+	names := make([]string, len(blocks))
+	for i, b := range blocks {
+		names[i] = b.String()
+	}
+	return strings.Join(names, " ")
+}
+
+// floatText returns the text form of a float constant.
+func floatText(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "nan"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
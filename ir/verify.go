@@ -0,0 +1,371 @@
+package ir
+
+import (
+	"bo/checker"
+	"fmt"
+	"slices"
+)
+
+// Verify checks that a program is well formed, and returns an error that
+// says where the first problem it finds is, if any. Every block of a
+// function must be reached from its entry, and end as its kind says with
+// the successors that kind has, which list it among their predecessors.
+// Every value must have the operands and types its operation takes, and be
+// defined in a block that dominates its uses: for an operand of a phi, the
+// end of the predecessor it comes from. Verify recomputes the dominator
+// tree of each function.
+func Verify(p *Program) error {
+	names := make(map[string]bool)
+	for _, fn := range p.Funcs {
+		if names[fn.Name] {
+			return fmt.Errorf("ir: two functions are named %s", fn.Name)
+		}
+		names[fn.Name] = true
+	}
+	for _, fn := range p.Funcs {
+		if err := verifyFunc(p, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type verifyError struct {
+	fn    *Func
+	block *Block
+	value *Value
+	msg   string
+}
+
+func (e *verifyError) Error() string {
+	where := "func " + e.fn.Name
+	if e.block != nil {
+		where += ": " + e.block.String()
+	}
+	if e.value != nil {
+		where += ": " + e.value.String()
+	}
+	return fmt.Sprintf("ir: %s: %s", where, e.msg)
+}
+
+func verifyFunc(p *Program, fn *Func) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if vErr, ok := r.(*verifyError); ok {
+				err = vErr
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	fail := func(b *Block, v *Value, format string, args ...interface{}) {
+		panic(&verifyError{fn: fn, block: b, value: v, msg: fmt.Sprintf(format, args...)})
+	}
+
+	if len(fn.Blocks) == 0 {
+		fail(nil, nil, "no blocks")
+	}
+	entry := fn.Blocks[0]
+	if len(entry.Preds) > 0 {
+		fail(entry, nil, "the entry has predecessors")
+	}
+	for i, param := range fn.Params {
+		if i >= len(entry.Values) || entry.Values[i] != param || param.Op != OpParam {
+			fail(entry, param, "parameter %d is not value %d of the entry", i, i)
+		}
+	}
+
+	// The edges, which the dominator tree depends on
+	blocks := make(map[*Block]bool)
+	for _, b := range fn.Blocks {
+		if blocks[b] {
+			fail(b, nil, "listed twice")
+		}
+		blocks[b] = true
+	}
+	for _, b := range fn.Blocks {
+		if b.Func != fn {
+			fail(b, nil, "belongs to another function")
+		}
+		succs := map[BlockKind]int{Plain: 1, If: 2, Return: 0, Unreachable: 0}
+		n, ok := succs[b.Kind]
+		if !ok {
+			fail(b, nil, "unknown kind %s", b.Kind)
+		}
+		if len(b.Succs) != n {
+			fail(b, nil, "%s block with %d successors", b.Kind, len(b.Succs))
+		}
+		for _, s := range b.Succs {
+			if !blocks[s] {
+				fail(b, nil, "successor %s is not a block of the function", s)
+			}
+			if count(s.Preds, b) != count(b.Succs, s) {
+				fail(b, nil, "successor %s does not list it as a predecessor", s)
+			}
+		}
+		for _, pred := range b.Preds {
+			if !blocks[pred] {
+				fail(b, nil, "predecessor %s is not a block of the function", pred)
+			}
+			if count(pred.Succs, b) != count(b.Preds, pred) {
+				fail(b, nil, "predecessor %s does not list it as a successor", pred)
+			}
+		}
+	}
+
+	fn.ComputeDom()
+	for _, b := range fn.Blocks {
+		if b.pre == 0 {
+			fail(b, nil, "not reached from the entry")
+		}
+	}
+
+	// The values, which must be defined before they are used
+	defined := make(map[*Value]bool)
+	index := make(map[*Value]int)
+	ids := make(map[int]bool)
+	for _, b := range fn.Blocks {
+		for i, v := range b.Values {
+			if defined[v] {
+				fail(b, v, "listed twice")
+			}
+			if ids[v.ID] {
+				fail(b, v, "two values have ID %d", v.ID)
+			}
+			defined[v], index[v], ids[v.ID] = true, i, true
+			if v.Block != b {
+				fail(b, v, "belongs to another block")
+			}
+			if v.Op == OpPhi && i > 0 && b.Values[i-1].Op != OpPhi {
+				fail(b, v, "phi after a value that is not one")
+			}
+		}
+	}
+	dominates := func(def *Value, b *Block, i int) bool {
+		if def.Block == b {
+			return index[def] < i
+		}
+		return def.Block.Dominates(b)
+	}
+
+	for _, b := range fn.Blocks {
+		for i, v := range b.Values {
+			for j, arg := range v.Args {
+				switch {
+				case arg == nil:
+					fail(b, v, "operand %d is nil", j)
+				case !defined[arg]:
+					fail(b, v, "operand %s is not a value of the function", arg)
+				case v.Op == OpPhi:
+					if j < len(b.Preds) && !dominates(arg, b.Preds[j], len(b.Preds[j].Values)) {
+						fail(b, v, "operand %s does not dominate predecessor %s", arg, b.Preds[j])
+					}
+				case !dominates(arg, b, i):
+					fail(b, v, "operand %s does not dominate it", arg)
+				}
+			}
+			if msg := checkValue(p, v); msg != "" {
+				fail(b, v, "%s", msg)
+			}
+		}
+
+		if b.Control != nil && !defined[b.Control] {
+			fail(b, nil, "control %s is not a value of the function", b.Control)
+		}
+		if b.Control != nil && !dominates(b.Control, b, len(b.Values)) {
+			fail(b, nil, "control %s does not dominate it", b.Control)
+		}
+		switch b.Kind {
+		case If:
+			if b.Control == nil || b.Control.Type != checker.Bool {
+				fail(b, nil, "if block without a bool control")
+			}
+		case Return:
+			if b.Control == nil && fn.Result != nil {
+				fail(b, nil, "returns no value from a function with a %s result", fn.Result)
+			}
+			if b.Control != nil && b.Control.Type != fn.Result {
+				fail(b, nil, "returns a %s from a function with a %v result", b.Control.Type, fn.Result)
+			}
+		default:
+			if b.Control != nil {
+				fail(b, nil, "%s block with a control", b.Kind)
+			}
+		}
+	}
+	return nil
+}
+
+// checkValue returns what is wrong with the operands and type of a value,
+// or "" if nothing is.
+func checkValue(p *Program, v *Value) string {
+	types := func(want ...checker.Type) string {
+		if len(v.Args) != len(want) {
+			return fmt.Sprintf("%s takes %d operands, not %d", v.Op, len(want), len(v.Args))
+		}
+		for i, arg := range v.Args {
+			if arg.Type != want[i] {
+				return fmt.Sprintf("operand %s of %s is a %v, not a %v", arg, v.Op, arg.Type, want[i])
+			}
+		}
+		return ""
+	}
+	result := func(allowed ...checker.Type) string {
+		if !slices.Contains(allowed, v.Type) {
+			return fmt.Sprintf("%s of type %v", v.Op, v.Type)
+		}
+		return ""
+	}
+	first := func() checker.Type {
+		if len(v.Args) == 0 {
+			return nil
+		}
+		return v.Args[0].Type
+	}
+	scalars := []checker.Type{checker.Int, checker.Float, checker.Bool}
+
+	switch v.Op {
+	case OpConst:
+		if msg := types(); msg != "" {
+			return msg
+		}
+		switch v.Aux.(type) {
+		case int64:
+			return result(checker.Int)
+		case float64:
+			return result(checker.Float)
+		case bool:
+			return result(checker.Bool)
+		}
+		return fmt.Sprintf("constant %v of type %T", v.Aux, v.Aux)
+	case OpParam:
+		if _, ok := v.Aux.(string); !ok {
+			return "parameter without a name"
+		}
+		if msg := types(); msg != "" {
+			return msg
+		}
+		if v.Block != v.Block.Func.Blocks[0] || !slices.Contains(v.Block.Func.Params, v) {
+			return "parameter that is not one of the function"
+		}
+		return result(scalars...)
+	case OpPhi:
+		if len(v.Args) != len(v.Block.Preds) {
+			return fmt.Sprintf("phi with %d operands in a block with %d predecessors", len(v.Args), len(v.Block.Preds))
+		}
+		for _, arg := range v.Args {
+			if arg.Type != v.Type {
+				return fmt.Sprintf("phi of type %v with an operand of type %v", v.Type, arg.Type)
+			}
+		}
+		return result(scalars...)
+	case OpAdd, OpSub, OpMul, OpDiv, OpNeg:
+		if msg := result(checker.Int, checker.Float); msg != "" {
+			return msg
+		}
+		if v.Op == OpNeg {
+			return types(v.Type)
+		}
+		return types(v.Type, v.Type)
+	case OpRem, OpAddWrap, OpSubWrap, OpMulWrap:
+		if msg := result(checker.Int); msg != "" {
+			return msg
+		}
+		return types(checker.Int, checker.Int)
+	case OpNot:
+		if msg := result(checker.Bool); msg != "" {
+			return msg
+		}
+		return types(checker.Bool)
+	case OpEq, OpNe, OpLt, OpLe, OpGt, OpGe:
+		if msg := result(checker.Bool); msg != "" {
+			return msg
+		}
+		operands := []checker.Type{checker.Int, checker.Float}
+		if v.Op == OpEq || v.Op == OpNe {
+			operands = scalars
+		}
+		if !slices.Contains(operands, first()) {
+			return fmt.Sprintf("%s of %v values", v.Op, first())
+		}
+		return types(first(), first())
+	case OpIntToFloat:
+		if msg := result(checker.Float); msg != "" {
+			return msg
+		}
+		return types(checker.Int)
+	case OpFloatToInt:
+		if msg := result(checker.Int); msg != "" {
+			return msg
+		}
+		return types(checker.Float)
+	case OpCall:
+		callee, ok := v.Aux.(*Func)
+		if !ok || !slices.Contains(p.Funcs, callee) {
+			return "call of a function that is not one of the program"
+		}
+		var params []checker.Type
+		for _, param := range callee.Params {
+			params = append(params, param.Type)
+		}
+		if msg := types(params...); msg != "" {
+			return msg
+		}
+		if v.Type != callee.Result {
+			return fmt.Sprintf("call of type %v of %s, which returns %v", v.Type, callee.Name, callee.Result)
+		}
+		return ""
+	case OpLoad, OpStore:
+		g, ok := v.Aux.(*Global)
+		if !ok || !slices.Contains(p.Globals, g) {
+			return fmt.Sprintf("%s of a global that is not one of the program", v.Op)
+		}
+		if v.Op == OpLoad {
+			if msg := types(); msg != "" {
+				return msg
+			}
+			if v.Type != g.Type {
+				return fmt.Sprintf("load of type %v of %s, a %s", v.Type, g.Name, g.Type)
+			}
+			return ""
+		}
+		if msg := result(nil); msg != "" {
+			return msg
+		}
+		return types(g.Type)
+	case OpWrite, OpNoMatch:
+		if msg := result(nil); msg != "" {
+			return msg
+		}
+		if !slices.Contains(scalars, first()) {
+			return fmt.Sprintf("%s of a %v value", v.Op, first())
+		}
+		return types(first())
+	case OpWriteString:
+		if _, ok := v.Aux.(string); !ok {
+			return "writestring without a string"
+		}
+		if msg := result(nil); msg != "" {
+			return msg
+		}
+		return types()
+	case OpPrintln:
+		if msg := result(nil); msg != "" {
+			return msg
+		}
+		return types()
+	}
+	return fmt.Sprintf("unknown operation %s", v.Op)
+}
+
+// count returns how many times a block is in a list.
+func count(blocks []*Block, b *Block) int {
+	n := 0
+	for _, x := range blocks {
+		if x == b {
+			n++
+		}
+	}
+	return n
+}
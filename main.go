@@ -1,36 +1,383 @@
+// Command bo runs and compiles Bo programs.
+//
+//	bo run [--engine=tree|vm] [-O0|-O1|-O2] file.bo|file.boc
+//	bo build [-O0|-O1|-O2] file.bo [-o file.boc]
+//	bo disasm [-O0|-O1|-O2] file.bo|file.boc
+//	bo gogen [-O0|-O1|-O2] file.bo [-o file.go]
+//	bo wasm [-O0|-O1|-O2] file.bo [-o file.wasm|file.wat]
+//	bo cgen [-O0|-O1|-O2] file.bo [-o file.c]
+//	bo ir [-O0|-O1|-O2] file.bo
+//
+// The tree engine walks the syntax tree of the program, the vm engine
+// compiles it to bytecode first and runs that. A program built to a .boc
+// file runs on the vm engine without being parsed again. disasm prints the
+// bytecode of a program. gogen translates a program to Go, wasm lowers it
+// to a WebAssembly module and cgen translates it to C. ir prints the SSA
+// form of a program. The -O flags set how much a program is optimized once
+// checked, not at all by default.
 package main
 
 import (
+	"bo/ast"
+	"bo/cgen"
+	"bo/checker"
+	"bo/compiler"
+	"bo/gogen"
+	"bo/ir"
+	"bo/optimize"
 	"bo/parser"
 	"bo/runner"
+	"bo/vm"
+	"bo/wasm"
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
 )
 
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: bo run [--engine=tree|vm] [-O0|-O1|-O2] file.bo|file.boc\n")
+	fmt.Fprintf(os.Stderr, "       bo build [-O0|-O1|-O2] file.bo [-o file.boc]\n")
+	fmt.Fprintf(os.Stderr, "       bo disasm [-O0|-O1|-O2] file.bo|file.boc\n")
+	fmt.Fprintf(os.Stderr, "       bo gogen [-O0|-O1|-O2] file.bo [-o file.go]\n")
+	fmt.Fprintf(os.Stderr, "       bo wasm [-O0|-O1|-O2] file.bo [-o file.wasm|file.wat]\n")
+	fmt.Fprintf(os.Stderr, "       bo cgen [-O0|-O1|-O2] file.bo [-o file.c]\n")
+	fmt.Fprintf(os.Stderr, "       bo ir [-O0|-O1|-O2] file.bo\n")
+}
+
 func main() {
-	input := `
-	require <bo/fmt>
-	require "path/to/file.bo"
-	require 'path/to/file.bo'
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
 
-	// Single line comment
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = run(os.Args[2:])
+	case "build":
+		err = build(os.Args[2:])
+	case "disasm":
+		err = disasm(os.Args[2:])
+	case "gogen":
+		err = goGen(os.Args[2:])
+	case "wasm":
+		err = toWasm(os.Args[2:])
+	case "cgen":
+		err = cGen(os.Args[2:])
+	case "ir":
+		err = printIR(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
 
-	/*
-	 * Multi-line comment
-	 */
+// parseFlags parses the flags of a command, which may come after its
+// arguments, and returns its arguments.
+func parseFlags(flags *flag.FlagSet, args []string) []string {
+	var rest []string
+	for {
+		flags.Parse(args)
+		if flags.NArg() == 0 {
+			return rest
+		}
+		rest = append(rest, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+}
+
+// optFlags adds the -O0, -O1 and -O2 flags to flags, and returns the level
+// they set.
+func optFlags(flags *flag.FlagSet) *optimize.Level {
+	level := new(optimize.Level)
+	for l := optimize.O0; l <= optimize.O2; l++ {
+		flags.Var(levelFlag{level, l}, l.String(), fmt.Sprintf("optimize at level %d", l))
+	}
+	return level
+}
 
-	int a = 1
-	float b = 1.0
-	bool c = true
-	string b = "Hello, World!"
+// levelFlag is a flag that sets level to value.
+type levelFlag struct {
+	level *optimize.Level
+	value optimize.Level
+}
 
-	// Built-in functions
-	println(b)
+func (f levelFlag) String() string { return "" }
 
-	println("x:", x, "y:", y)
-	`
-	prog, err := parser.ParseString(input)
+func (f levelFlag) IsBoolFlag() bool { return true }
+
+func (f levelFlag) Set(s string) error {
+	if s != "true" {
+		return fmt.Errorf("-%s takes no value", f.value)
+	}
+	*f.level = f.value
+	return nil
+}
+
+// check parses and checks a program, then optimizes it at level.
+func check(filename string, level optimize.Level) (*ast.Program, *checker.Info, error) {
+	prog, err := parser.ParseFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := checker.Check(prog)
 	if err != nil {
-		panic(err)
+		return nil, nil, err
+	}
+	optimize.Program(prog, info, level)
+	return prog, info, nil
+}
+
+// compile returns the bytecode of a program, compiling a .bo file
+// optimized at level or loading a .boc file.
+func compile(filename string, level optimize.Level) (*compiler.Program, error) {
+	if filepath.Ext(filename) == ".boc" {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		code, err := compiler.Decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		return code, nil
 	}
 
-	runner.RunProgram(prog)
+	prog, info, err := check(filename, level)
+	if err != nil {
+		return nil, err
+	}
+	return compiler.Compile(prog, info)
+}
+
+// run runs a program with the engine its flags select.
+func run(args []string) error {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	flags.Usage = func() {
+		usage()
+		flags.PrintDefaults()
+	}
+	engine := flags.String("engine", "tree", "the engine that runs the program: tree or vm")
+	level := optFlags(flags)
+	args = parseFlags(flags, args)
+	if len(args) != 1 || *engine != "tree" && *engine != "vm" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	// A compiled program only runs on the vm
+	if filepath.Ext(args[0]) == ".boc" {
+		*engine = "vm"
+	}
+
+	// An interrupt stops the program, and every task it runs. A task
+	// blocked in a Mutex or a WaitGroup does not see it, so a second
+	// interrupt kills the program. When the program ends, the process
+	// does, with the tasks still running, as a Go program would
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	context.AfterFunc(ctx, stop)
+
+	if *engine == "tree" {
+		prog, info, err := check(args[0], *level)
+		if err != nil {
+			return err
+		}
+		return runner.RunProgram(ctx, prog, info)
+	}
+
+	code, err := compile(args[0], *level)
+	if err != nil {
+		return err
+	}
+	return vm.Run(ctx, code)
+}
+
+// build compiles a program to a .boc file, next to it unless -o says
+// where.
+func build(args []string) error {
+	flags := flag.NewFlagSet("build", flag.ExitOnError)
+	flags.Usage = func() {
+		usage()
+		flags.PrintDefaults()
+	}
+	out := flags.String("o", "", "the file to write, the source file with a .boc extension by default")
+	level := optFlags(flags)
+	args = parseFlags(flags, args)
+	if len(args) != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	code, err := compile(args[0], *level)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		*out = strings.TrimSuffix(args[0], filepath.Ext(args[0])) + ".boc"
+	}
+	return os.WriteFile(*out, compiler.Encode(code), 0o644)
+}
+
+// disasm prints the bytecode of a program.
+func disasm(args []string) error {
+	flags := flag.NewFlagSet("disasm", flag.ExitOnError)
+	flags.Usage = func() {
+		usage()
+		flags.PrintDefaults()
+	}
+	level := optFlags(flags)
+	args = parseFlags(flags, args)
+	if len(args) != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	code, err := compile(args[0], *level)
+	if err != nil {
+		return err
+	}
+	compiler.Disassemble(os.Stdout, code)
+	return nil
+}
+
+// goGen translates a program to a Go program, next to it unless -o says
+// where.
+func goGen(args []string) error {
+	flags := flag.NewFlagSet("gogen", flag.ExitOnError)
+	flags.Usage = func() {
+		usage()
+		flags.PrintDefaults()
+	}
+	out := flags.String("o", "", "the file to write, the source file with a .go extension by default")
+	level := optFlags(flags)
+	args = parseFlags(flags, args)
+	if len(args) != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	prog, info, err := check(args[0], *level)
+	if err != nil {
+		return err
+	}
+	src, err := gogen.Generate(prog, info)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		*out = strings.TrimSuffix(args[0], filepath.Ext(args[0])) + ".go"
+	}
+	return os.WriteFile(*out, src, 0o644)
+}
+
+// toWasm lowers the SSA form of a program to a WebAssembly module, next to it unless -o
+// says where. A .wat file gets the text form of the module.
+func toWasm(args []string) error {
+	flags := flag.NewFlagSet("wasm", flag.ExitOnError)
+	flags.Usage = func() {
+		usage()
+		flags.PrintDefaults()
+	}
+	out := flags.String("o", "", "the file to write, the source file with a .wasm extension by default")
+	level := optFlags(flags)
+	args = parseFlags(flags, args)
+	if len(args) != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	prog, info, err := check(args[0], *level)
+	if err != nil {
+		return err
+	}
+	p, err := ir.Build(prog, info)
+	if err != nil {
+		return err
+	}
+	m := wasm.Lower(p)
+
+	if *out == "" {
+		*out = strings.TrimSuffix(args[0], filepath.Ext(args[0])) + ".wasm"
+	}
+	if filepath.Ext(*out) == ".wat" {
+		var b bytes.Buffer
+		wasm.WriteText(&b, m)
+		return os.WriteFile(*out, b.Bytes(), 0o644)
+	}
+	return os.WriteFile(*out, wasm.Encode(m), 0o644)
+}
+
+// cGen translates the SSA form of a program to a C program, next to it
+// unless -o says where, and writes the runtime header it includes beside
+// it.
+func cGen(args []string) error {
+	flags := flag.NewFlagSet("cgen", flag.ExitOnError)
+	flags.Usage = func() {
+		usage()
+		flags.PrintDefaults()
+	}
+	out := flags.String("o", "", "the file to write, the source file with a .c extension by default")
+	level := optFlags(flags)
+	args = parseFlags(flags, args)
+	if len(args) != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	prog, info, err := check(args[0], *level)
+	if err != nil {
+		return err
+	}
+	p, err := ir.Build(prog, info)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		*out = strings.TrimSuffix(args[0], filepath.Ext(args[0])) + ".c"
+	}
+	if err := os.WriteFile(*out, cgen.Generate(p), 0o644); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(filepath.Dir(*out), cgen.HeaderName), cgen.Header, 0o644)
+}
+
+// printIR prints the SSA form of a program, once verified.
+func printIR(args []string) error {
+	flags := flag.NewFlagSet("ir", flag.ExitOnError)
+	flags.Usage = func() {
+		usage()
+		flags.PrintDefaults()
+	}
+	level := optFlags(flags)
+	args = parseFlags(flags, args)
+	if len(args) != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	prog, info, err := check(args[0], *level)
+	if err != nil {
+		return err
+	}
+	p, err := ir.Build(prog, info)
+	if err != nil {
+		return err
+	}
+	if err := ir.Verify(p); err != nil {
+		return err
+	}
+	ir.WriteText(os.Stdout, p)
+	return nil
 }
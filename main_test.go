@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bo/internal/botest"
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the .golden files of testdata with what the tree engine prints")
+
+// TestRun runs the programs of testdata with bo run on both engines, and
+// checks that each prints what its .golden file holds: its output, then
+// the syntax, type or runtime error it stops with, if any.
+func TestRun(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.bo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no programs in testdata")
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".bo")
+		golden := strings.TrimSuffix(file, ".bo") + ".golden"
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if *update {
+				if err := os.WriteFile(golden, runBo(t, file, "tree"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, engine := range []string{"tree", "vm"} {
+				if got := runBo(t, file, engine); !bytes.Equal(got, want) {
+					t.Errorf("%s engine:\n%s\nwant:\n%s", engine, got, want)
+				}
+			}
+		})
+	}
+}
+
+// runBo runs a program on engine, and returns what it printed on its
+// standard output, then on its standard error.
+func runBo(t *testing.T, file, engine string) []byte {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, botest.Bo(t), "run", "--engine="+engine, file)
+	cmd.Stdout, cmd.Stderr = &out, &out
+	if err := cmd.Run(); err != nil {
+		var exit *exec.ExitError
+		if !errors.As(err, &exit) || exit.ExitCode() != 1 {
+			t.Fatalf("%s engine: %v\n%s", engine, err, out.Bytes())
+		}
+	}
+	return out.Bytes()
+}
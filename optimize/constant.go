@@ -0,0 +1,134 @@
+package optimize
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"bo/value"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// constant returns the value of an int, float or bool literal, maybe
+// negated, as the runner evaluates it.
+func constant(info *checker.Info, e ast.Expr) (interface{}, bool) {
+	t := info.Types[e]
+	if !scalar(t) {
+		return nil, false
+	}
+
+	var val interface{}
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		switch e.Kind {
+		case ast.Int:
+			n, err := ast.IntLiteral(e.Value, false)
+			if err != nil {
+				return nil, false
+			}
+			val = n
+		case ast.Float:
+			f, err := ast.FloatLiteral(e.Value)
+			if err != nil {
+				return nil, false
+			}
+			val = f
+		case ast.Bool:
+			val = e.Value == "true"
+		default:
+			return nil, false
+		}
+	case *ast.Unary:
+		lit, ok := e.X.(*ast.BasicLit)
+		if !ok || e.Op != "-" || info.Operators[e] != nil {
+			return nil, false
+		}
+		switch lit.Kind {
+		case ast.Int:
+			n, err := ast.IntLiteral(lit.Value, true)
+			if err != nil {
+				return nil, false
+			}
+			val = n
+		case ast.Float:
+			f, err := ast.FloatLiteral(lit.Value)
+			if err != nil {
+				return nil, false
+			}
+			val = -f
+		default:
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	return convert(val, t)
+}
+
+// scalar reports whether values of type t can be written as literals.
+func scalar(t checker.Type) bool {
+	return t == checker.Int || t == checker.Float || t == checker.Bool
+}
+
+// eval returns what f computes, or false if it fails, as a runtime error
+// would stop the program.
+func eval(f func() interface{}) (val interface{}, ok bool) {
+	defer func() {
+		if recover() != nil {
+			val, ok = nil, false
+		}
+	}()
+	return f(), true
+}
+
+func convert(val interface{}, t checker.Type) (interface{}, bool) {
+	return eval(func() interface{} { return value.Convert(val, t) })
+}
+
+// literal returns a literal of type t with the span of node, written as
+// the parser would, or nil if the value has none.
+func literal(info *checker.Info, node ast.Node, val interface{}, t checker.Type) ast.Expr {
+	span := ast.Span{From: node.Pos(), To: node.End()}
+	lit := &ast.BasicLit{Span: span}
+	neg := false
+
+	switch val := val.(type) {
+	case int64:
+		if t != checker.Int {
+			return nil
+		}
+		lit.Kind = ast.Int
+		lit.Value = strconv.FormatInt(val, 10)
+		if val < 0 {
+			// The smallest int has no positive counterpart
+			lit.Value, neg = strconv.FormatUint(-uint64(val), 10), true
+		}
+	case float64:
+		if t != checker.Float || math.IsInf(val, 0) || math.IsNaN(val) {
+			return nil
+		}
+		lit.Kind = ast.Float
+		lit.Value = strconv.FormatFloat(math.Abs(val), 'g', -1, 64)
+		if !strings.ContainsAny(lit.Value, ".e") {
+			lit.Value += ".0"
+		}
+		neg = math.Signbit(val)
+	case bool:
+		if t != checker.Bool {
+			return nil
+		}
+		lit.Kind = ast.Bool
+		lit.Value = strconv.FormatBool(val)
+	default:
+		return nil
+	}
+
+	info.Types[lit] = t
+	if !neg {
+		return lit
+	}
+	u := &ast.Unary{Span: span, Op: "-", X: lit}
+	info.Types[u] = t
+	return u
+}
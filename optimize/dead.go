@@ -0,0 +1,68 @@
+package optimize
+
+import (
+	"bo/ast"
+	"bo/checker"
+)
+
+// removeDead leaves out the variables no name refers to, if computing their
+// values has no effect, and the functions nothing calls but themselves.
+// Leaving out one may leave others unused, so it goes on until none is.
+func removeDead(prog *ast.Program, info *checker.Info) {
+	for {
+		w := newWalker(info)
+		w.program(prog)
+
+		used := make(map[ast.Node]bool)
+		for id, node := range w.uses {
+			if decl, ok := node.(*ast.FuncDecl); ok && within(id, decl.Body) {
+				continue
+			}
+			used[node] = true
+		}
+
+		removed := false
+		w = newWalker(info)
+		w.stmts = func(stmts []ast.Stmt) []ast.Stmt {
+			var out []ast.Stmt
+			for _, stmt := range stmts {
+				if dead(info, stmt, used) {
+					removed = true
+					continue
+				}
+				out = append(out, stmt)
+			}
+			return out
+		}
+		w.program(prog)
+
+		if !removed {
+			return
+		}
+	}
+}
+
+// dead reports whether a statement declares only what is not used, and
+// has no effect.
+func dead(info *checker.Info, stmt ast.Stmt, used map[ast.Node]bool) bool {
+	switch s := stmt.(type) {
+	case *ast.VarDecl:
+		for _, v := range s.Vars {
+			if used[v] {
+				return false
+			}
+		}
+		return pure(info, s.Value)
+	case *ast.FuncDecl:
+		return s.Recv == nil && !used[s]
+	}
+	return false
+}
+
+// within reports whether a node is in the source of another.
+func within(node, outer ast.Node) bool {
+	pos, from, to := node.Pos(), outer.Pos(), outer.End()
+	after := pos.Line > from.Line || pos.Line == from.Line && pos.Column >= from.Column
+	before := pos.Line < to.Line || pos.Line == to.Line && pos.Column < to.Column
+	return after && before
+}
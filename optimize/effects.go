@@ -0,0 +1,102 @@
+package optimize
+
+import (
+	"bo/ast"
+	"bo/checker"
+)
+
+// pure reports whether evaluating an expression has no effect and cannot
+// fail, so that it may be left out or evaluated elsewhere.
+func pure(info *checker.Info, e ast.Expr) bool {
+	return effectFree(info, e, false)
+}
+
+// effectFree reports whether evaluating an expression has no effect other
+// than maybe a runtime error, which is allowed if failing is set. Operator
+// methods, calls, receives and awaits run code of their own.
+func effectFree(info *checker.Info, e ast.Expr, failing bool) bool {
+	if info.Operators[e] != nil {
+		return false
+	}
+	all := func(exprs ...ast.Expr) bool {
+		for _, e := range exprs {
+			if !effectFree(info, e, failing) {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch e := e.(type) {
+	case *ast.Ident, *ast.BasicLit:
+		return true
+	case *ast.StringLit:
+		// A value of a basic type is written without calling a method
+		for _, part := range e.Parts {
+			if part.Expr == nil {
+				continue
+			}
+			if _, ok := info.Types[part.Expr].(*checker.Basic); !ok || !all(part.Expr) {
+				return false
+			}
+		}
+		return true
+	case *ast.Paren:
+		return all(e.X)
+	case *ast.ListLit:
+		return all(e.Elems...)
+	case *ast.TupleLit:
+		return all(e.Elems...)
+	case *ast.MapLit:
+		for _, entry := range e.Entries {
+			if !all(entry.Key, entry.Value) {
+				return false
+			}
+		}
+		return true
+	case *ast.StructLit:
+		for _, field := range e.Fields {
+			if !all(field.Value) {
+				return false
+			}
+		}
+		return true
+	case *ast.Unary:
+		if e.Op == "!" || isFloat(info.Types[e.X]) {
+			return all(e.X)
+		}
+		return failing && all(e.X)
+	case *ast.Binary:
+		switch e.Op {
+		case "&&", "||", "??", "==", "!=", "<", "<=", ">", ">=", "+%", "-%", "*%":
+			return all(e.X, e.Y)
+		}
+		// The operands have the type the operation is done in
+		if t := info.Types[e.X]; isFloat(t) || t == checker.String {
+			return all(e.X, e.Y)
+		}
+		return failing && all(e.X, e.Y)
+	case *ast.Conversion:
+		to, ok := info.Types[e.Type].(*checker.Basic)
+		if !ok {
+			return false
+		}
+		from := info.Types[e.X]
+		if to == checker.Float && (from == checker.Int || from == checker.Float) {
+			return all(e.X)
+		}
+		return failing && all(e.X)
+	case *ast.Index:
+		return failing && all(e.X, e.Index)
+	case *ast.Member:
+		return failing && all(e.X)
+	case *ast.Range:
+		return failing && all(e.From, e.To)
+	}
+	return false
+}
+
+func isFloat(t checker.Type) bool {
+	b, ok := t.(*checker.Basic)
+	return ok && b.IsFloat()
+}
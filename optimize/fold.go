@@ -0,0 +1,337 @@
+package optimize
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"bo/value"
+)
+
+// fold replaces the operations on constants with their values, and the
+// variables declared as constants with theirs, then leaves out the code
+// that can no longer run: the arms of a switch or match that cannot match
+// a constant, loops over empty ranges and statements after a return.
+func fold(prog *ast.Program, info *checker.Info) {
+	w := newWalker(info)
+	w.expr = func(e ast.Expr) ast.Expr {
+		if folded := foldExpr(w, e); folded != nil {
+			return folded
+		}
+		return e
+	}
+	w.stmts = func(stmts []ast.Stmt) []ast.Stmt {
+		return foldStmts(w, stmts)
+	}
+	w.program(prog)
+}
+
+// foldExpr returns what replaces an expression whose operands are folded,
+// or nil if nothing does.
+func foldExpr(w *walker, e ast.Expr) ast.Expr {
+	info := w.info
+	t := info.Types[e]
+	if info.Operators[e] != nil {
+		return nil
+	}
+
+	var val interface{}
+	ok := false
+	switch e := e.(type) {
+	case *ast.Ident:
+		v, isVar := w.uses[e].(*ast.Var)
+		if !isVar || len(w.decls[v].Vars) > 1 {
+			return nil
+		}
+		if val, ok = constant(info, w.decls[v].Value); ok {
+			val, ok = convert(val, t)
+		}
+	case *ast.Paren:
+		if val, ok = constant(info, e.X); ok {
+			val, ok = convert(val, t)
+		}
+	case *ast.Unary:
+		// A negated literal is a constant already
+		if _, isConst := constant(info, e); isConst {
+			return nil
+		}
+		x, isConst := constant(info, e.X)
+		if !isConst {
+			return nil
+		}
+		val, ok = eval(func() interface{} {
+			if e.Op == "!" {
+				return !x.(bool)
+			}
+			return value.Convert(value.Neg(x), t)
+		})
+	case *ast.Binary:
+		return foldBinary(info, e)
+	case *ast.Conversion:
+		x, isConst := constant(info, e.X)
+		if !isConst {
+			return nil
+		}
+		val, ok = eval(func() interface{} {
+			return value.Convert(value.Convert(x, info.Types[e.Type]), t)
+		})
+	case *ast.Match:
+		return foldMatch(info, e)
+	}
+
+	if !ok {
+		return nil
+	}
+	return literal(info, e, val, t)
+}
+
+func foldBinary(info *checker.Info, e *ast.Binary) ast.Expr {
+	t := info.Types[e]
+	x, xConst := constant(info, e.X)
+	y, yConst := constant(info, e.Y)
+
+	switch e.Op {
+	case "&&", "||":
+		if t != checker.Bool {
+			return nil
+		}
+
+		// The right operand is left as is when the left one does not
+		// decide, or when it is the only one that is constant and may not
+		// be left out
+		short := e.Op == "||"
+		switch {
+		case xConst && x == short:
+			return literal(info, e, short, t)
+		case xConst:
+			return e.Y
+		case yConst && y != short:
+			return e.X
+		case yConst && pure(info, e.X):
+			return literal(info, e, short, t)
+		}
+		return nil
+	case "??":
+		return nil
+	}
+
+	if !xConst || !yConst {
+		return nil
+	}
+	val, ok := eval(func() interface{} {
+		return value.Convert(value.Binary(e.Op, x, y), t)
+	})
+	if !ok {
+		return nil
+	}
+	return literal(info, e, val, t)
+}
+
+// matches reports whether a literal pattern matches a constant, and whether
+// that is known.
+func matches(info *checker.Info, p ast.Pattern, val interface{}) (matched, known bool) {
+	switch p := p.(type) {
+	case *ast.LiteralPattern:
+		lit, ok := p.Value.(*ast.BasicLit)
+		t := info.Types[p]
+		if !ok || !scalar(t) {
+			return false, false
+		}
+		var pv interface{}
+		switch lit.Kind {
+		case ast.Bool:
+			pv = lit.Value == "true"
+		case ast.Int:
+			pv = ast.BigIntLiteral(lit.Value)
+			if p.Neg {
+				pv = value.Neg(pv)
+			}
+		case ast.Float:
+			d, err := ast.DecimalLiteral(lit.Value)
+			if err != nil {
+				return false, false
+			}
+			pv = d
+			if p.Neg {
+				pv = d.Neg()
+			}
+		default:
+			return false, false
+		}
+		pv, ok = convert(pv, t)
+		if !ok {
+			return false, false
+		}
+		return value.Equal(val, pv), true
+	}
+	return false, false
+}
+
+// arms returns the indexes of the arms of a switch or match that may be
+// the one that matches, and whether the last of them surely does. An arm
+// may not match if its guard is false, or its pattern does not match a
+// constant subject, and those after one that surely matches never do.
+func arms(info *checker.Info, subject ast.Expr, patterns []ast.Pattern, guards []ast.Expr) ([]int, bool) {
+	val, isConst := constant(info, subject)
+
+	var kept []int
+	for i, p := range patterns {
+		guarded := false
+		if guards[i] != nil {
+			g, ok := constant(info, guards[i])
+			if ok && g == false {
+				continue
+			}
+			guarded = !ok
+		}
+
+		// A wildcard or binding matches any value
+		matched, known := false, false
+		switch p.(type) {
+		case *ast.WildcardPattern, *ast.BindingPattern:
+			matched, known = true, true
+		default:
+			if isConst {
+				matched, known = matches(info, p, val)
+			}
+		}
+		if known && !matched {
+			continue
+		}
+		kept = append(kept, i)
+		if known && !guarded {
+			return kept, true
+		}
+	}
+	return kept, false
+}
+
+// binds reports whether a pattern binds a name, so that the value of its
+// arm cannot go without it.
+func binds(p ast.Pattern) bool {
+	switch p.(type) {
+	case *ast.WildcardPattern, *ast.LiteralPattern:
+		return false
+	}
+	return true
+}
+
+// foldMatch leaves out the arms of a match that cannot match, and returns
+// the value of the arm that does if that is known.
+func foldMatch(info *checker.Info, e *ast.Match) ast.Expr {
+	var patterns []ast.Pattern
+	var guards []ast.Expr
+	for _, arm := range e.Arms {
+		patterns = append(patterns, arm.Pattern)
+		guards = append(guards, arm.Guard)
+	}
+	kept, sure := arms(info, e.X, patterns, guards)
+
+	// A match none of whose arms matches is a runtime error, left to fail
+	if len(kept) == 0 {
+		return nil
+	}
+	first := e.Arms[kept[0]]
+	if len(kept) == 1 && sure && !binds(first.Pattern) && pure(info, e.X) && info.Types[first.Value] == info.Types[e] {
+		return first.Value
+	}
+
+	var left []*ast.MatchArm
+	for _, i := range kept {
+		left = append(left, e.Arms[i])
+	}
+	e.Arms = left
+	return nil
+}
+
+func foldStmts(w *walker, stmts []ast.Stmt) []ast.Stmt {
+	var out []ast.Stmt
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *ast.Switch:
+			out = append(out, foldSwitch(w.info, s)...)
+		case *ast.For:
+			if !empty(w.info, s.X) {
+				out = append(out, s)
+			}
+		default:
+			out = append(out, stmt)
+		}
+
+		// Nothing after a return runs
+		if len(out) > 0 {
+			if _, ok := out[len(out)-1].(*ast.Return); ok {
+				break
+			}
+		}
+	}
+	return out
+}
+
+// foldSwitch returns what replaces a switch once the arms that cannot
+// match are left out: the body of the arm that surely matches if it binds
+// nothing and declares nothing, or nothing at all if no arm can match.
+func foldSwitch(info *checker.Info, s *ast.Switch) []ast.Stmt {
+	var patterns []ast.Pattern
+	var guards []ast.Expr
+	for _, arm := range s.Arms {
+		patterns = append(patterns, arm.Pattern)
+		guards = append(guards, arm.Guard)
+	}
+	kept, sure := arms(info, s.X, patterns, guards)
+
+	if pure(info, s.X) {
+		switch {
+		case len(kept) == 0:
+			return nil
+		case len(kept) == 1 && sure && !binds(s.Arms[kept[0]].Pattern) && !declares(s.Arms[kept[0]].Body.Stmts):
+			return s.Arms[kept[0]].Body.Stmts
+		}
+	}
+
+	var left []*ast.SwitchArm
+	for _, i := range kept {
+		left = append(left, s.Arms[i])
+	}
+	s.Arms = left
+	return []ast.Stmt{s}
+}
+
+// declares reports whether statements declare a name in their block.
+func declares(stmts []ast.Stmt) bool {
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *ast.VarDecl, *ast.Destructure, *ast.StructDecl, *ast.EnumDecl, *ast.Require:
+			return true
+		case *ast.FuncDecl:
+			if s.Recv == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// empty reports whether a loop is over a constant range with no values.
+func empty(info *checker.Info, x ast.Expr) bool {
+	n, ok := length(info, x)
+	return ok && n == 0
+}
+
+// length returns the number of values of a constant range.
+func length(info *checker.Info, x ast.Expr) (int64, bool) {
+	r, ok := x.(*ast.Range)
+	if !ok {
+		return 0, false
+	}
+	from, fromConst := constant(info, r.From)
+	to, toConst := constant(info, r.To)
+	if !fromConst || !toConst {
+		return 0, false
+	}
+	n, ok := eval(func() interface{} {
+		return value.NewRange(from.(int64), to.(int64), r.Exclusive).Len()
+	})
+	if !ok {
+		return 0, false
+	}
+	return n.(int64), true
+}
@@ -0,0 +1,105 @@
+package optimize
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"fmt"
+)
+
+// hoist moves the variables a loop declares with the same value on every
+// iteration out of it, to just before it. Their values must be ints,
+// floats or bools computed from variables declared outside the loop. One
+// that may fail is only moved if the loop surely runs and nothing before it
+// in the body stays in the loop, so that it fails as soon as it would have.
+// A moved variable gets a name of its own, as it may shadow another once
+// out of the loop.
+func hoist(prog *ast.Program, info *checker.Info) {
+	names := make(map[string]bool)
+	ast.Inspect(prog, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			names[id.Name] = true
+		}
+		return true
+	})
+
+	w := newWalker(info)
+	w.stmts = func(stmts []ast.Stmt) []ast.Stmt {
+		var out []ast.Stmt
+		for _, stmt := range stmts {
+			if loop, ok := stmt.(*ast.For); ok {
+				out = append(out, hoistLoop(w, loop, names)...)
+			}
+			out = append(out, stmt)
+		}
+		return out
+	}
+	w.program(prog)
+}
+
+// hoistLoop returns the declarations it moves out of a loop.
+func hoistLoop(w *walker, loop *ast.For, names map[string]bool) []ast.Stmt {
+	info := w.info
+
+	// The nodes of the loop, among them the declarations of the names its
+	// values may not depend on
+	inside := make(map[ast.Node]bool)
+	ast.Inspect(loop, func(n ast.Node) bool {
+		inside[n] = true
+		return true
+	})
+	invariant := func(e ast.Expr) bool {
+		ok := true
+		ast.Inspect(e, func(n ast.Node) bool {
+			if id, isIdent := n.(*ast.Ident); isIdent {
+				if decl := w.uses[id]; decl == nil || inside[decl] {
+					ok = false
+				}
+			}
+			return ok
+		})
+		return ok
+	}
+	n, isConst := length(info, loop.X)
+	runs := isConst && n > 0
+
+	var hoisted, kept []ast.Stmt
+	for _, stmt := range loop.Body.Stmts {
+		decl, ok := stmt.(*ast.VarDecl)
+		if ok && len(decl.Vars) == 1 && scalar(info.Types[decl.Vars[0].Type]) && invariant(decl.Value) {
+			first := len(kept) == 0
+			if pure(info, decl.Value) || first && runs && effectFree(info, decl.Value, true) {
+				v := decl.Vars[0]
+				rename(w, loop, v, fresh(v.Name.Name, names))
+				delete(inside, v)
+				hoisted = append(hoisted, decl)
+				continue
+			}
+		}
+		kept = append(kept, stmt)
+	}
+	loop.Body.Stmts = kept
+	return hoisted
+}
+
+// fresh returns a name based on name that no other name is.
+func fresh(name string, names map[string]bool) string {
+	for i := 1; ; i++ {
+		n := fmt.Sprintf("%s_%d", name, i)
+		if !names[n] {
+			names[n] = true
+			return n
+		}
+	}
+}
+
+// rename gives a variable a new name where it is declared and used in a
+// loop.
+func rename(w *walker, loop *ast.For, v *ast.Var, name string) {
+	ast.Inspect(loop, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && w.uses[id] == v {
+			id.Name = name
+		}
+		return true
+	})
+	v.Name.Name = name
+}
@@ -0,0 +1,199 @@
+package optimize
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"slices"
+)
+
+// maxInline is the size, in nodes, of the largest result a function may
+// compute to be inlined.
+const maxInline = 16
+
+// inline replaces the calls of small functions with the expressions they
+// return. A function is small if its body is a return of an operation on
+// its parameters, all of them ints, floats or bools. A call is inlined if
+// its arguments are variables or constants, which may be evaluated as many
+// times as the parameters are used, or not at all.
+func inline(prog *ast.Program, info *checker.Info) {
+	w := newWalker(info)
+	w.expr = func(e ast.Expr) ast.Expr {
+		call, ok := e.(*ast.Call)
+		if !ok {
+			return e
+		}
+		id, ok := call.Fun.(*ast.Ident)
+		if !ok {
+			return e
+		}
+
+		// A function is declared before it is called, but for a call in
+		// its own body, which is not small
+		decl, ok := w.uses[id].(*ast.FuncDecl)
+		if !ok {
+			return e
+		}
+		result := inlinable(w, decl)
+		if result == nil {
+			return e
+		}
+		if inlined := inlineCall(w, call, decl, result); inlined != nil {
+			return inlined
+		}
+		return e
+	}
+	w.program(prog)
+}
+
+// inlinable returns the expression a small function returns, or nil if
+// the function is not small.
+func inlinable(w *walker, decl *ast.FuncDecl) ast.Expr {
+	info := w.info
+	if decl.Recv != nil || decl.Async || decl.Operator || info.Generators[decl] || decl.Result == nil {
+		return nil
+	}
+	if !scalar(info.Types[decl.Result]) || len(decl.Body.Stmts) != 1 {
+		return nil
+	}
+	ret, ok := decl.Body.Stmts[0].(*ast.Return)
+	if !ok || len(ret.Results) != 1 || info.Types[ret.Results[0]] != info.Types[decl.Result] {
+		return nil
+	}
+	for _, param := range decl.Params {
+		if param.Variadic || !scalar(info.Types[param.Type]) {
+			return nil
+		}
+	}
+
+	size, ok := simple(w, decl, ret.Results[0])
+	if !ok || size > maxInline {
+		return nil
+	}
+	return ret.Results[0]
+}
+
+// simple returns the size of an expression that only operates on the
+// parameters of a function and constants, and whether it does.
+func simple(w *walker, decl *ast.FuncDecl, e ast.Expr) (int, bool) {
+	if w.info.Operators[e] != nil {
+		return 0, false
+	}
+
+	var operands []ast.Expr
+	switch e := e.(type) {
+	case *ast.Ident:
+		param, ok := w.uses[e].(*ast.Param)
+		return 1, ok && slices.Contains(decl.Params, param)
+	case *ast.BasicLit:
+		return 1, true
+	case *ast.Paren:
+		operands = []ast.Expr{e.X}
+	case *ast.Unary:
+		operands = []ast.Expr{e.X}
+	case *ast.Binary:
+		if e.Op == "??" {
+			return 0, false
+		}
+		operands = []ast.Expr{e.X, e.Y}
+	case *ast.Conversion:
+		if !scalar(w.info.Types[e.Type]) {
+			return 0, false
+		}
+		operands = []ast.Expr{e.X}
+	default:
+		return 0, false
+	}
+
+	size := 1
+	for _, x := range operands {
+		n, ok := simple(w, decl, x)
+		if !ok {
+			return 0, false
+		}
+		size += n
+	}
+	return size, true
+}
+
+// inlineCall returns the result of a small function with its parameters
+// replaced by the arguments of a call, or nil if the call cannot be
+// inlined.
+func inlineCall(w *walker, call *ast.Call, decl *ast.FuncDecl, result ast.Expr) ast.Expr {
+	info := w.info
+	c := info.Calls[call]
+	if c == nil || c.Variadic || len(c.Args) != len(decl.Params) || info.Types[call] != info.Types[decl.Result] {
+		return nil
+	}
+
+	args := make(map[*ast.Param]ast.Expr)
+	for i, arg := range c.Args {
+		if arg == nil || info.Types[arg] != info.Types[decl.Params[i].Type] {
+			return nil
+		}
+		switch x := ast.Unparen(arg).(type) {
+		case *ast.Ident:
+			if _, ok := w.uses[x].(*ast.FuncDecl); ok || w.uses[x] == nil {
+				return nil
+			}
+		default:
+			if _, ok := constant(info, arg); !ok {
+				return nil
+			}
+		}
+		args[decl.Params[i]] = arg
+	}
+
+	return substitute(w, result, args)
+}
+
+// substitute returns a copy of an expression with the parameters replaced
+// by copies of their arguments, or nil if a constant argument cannot be
+// written as the type its parameter is used as.
+func substitute(w *walker, e ast.Expr, args map[*ast.Param]ast.Expr) ast.Expr {
+	info := w.info
+	span := ast.Span{From: e.Pos(), To: e.End()}
+
+	var copied ast.Expr
+	switch e := e.(type) {
+	case *ast.Ident:
+		arg := args[w.uses[e].(*ast.Param)]
+		if val, ok := constant(info, arg); ok {
+			if val, ok = convert(val, info.Types[e]); !ok {
+				return nil
+			}
+			return literal(info, arg, val, info.Types[e])
+		}
+		x := ast.Unparen(arg).(*ast.Ident)
+		copied = &ast.Ident{Span: ast.Span{From: x.Pos(), To: x.End()}, Name: x.Name}
+	case *ast.BasicLit:
+		lit := *e
+		copied = &lit
+	case *ast.Paren:
+		x := substitute(w, e.X, args)
+		if x == nil {
+			return nil
+		}
+		copied = &ast.Paren{Span: span, X: x}
+	case *ast.Unary:
+		x := substitute(w, e.X, args)
+		if x == nil {
+			return nil
+		}
+		copied = &ast.Unary{Span: span, Op: e.Op, X: x}
+	case *ast.Binary:
+		x, y := substitute(w, e.X, args), substitute(w, e.Y, args)
+		if x == nil || y == nil {
+			return nil
+		}
+		copied = &ast.Binary{Span: span, Op: e.Op, X: x, Y: y}
+	case *ast.Conversion:
+		x := substitute(w, e.X, args)
+		if x == nil {
+			return nil
+		}
+		copied = &ast.Conversion{Span: span, Type: e.Type, X: x}
+	}
+
+	info.Types[copied] = info.Types[e]
+	return copied
+}
@@ -0,0 +1,53 @@
+// Package optimize rewrites a checked program into one that prints the
+// same output with less work, before it is run or compiled. The passes
+// work on the syntax tree and keep the checker's Info up to date, so that
+// every engine and backend runs the result as it is.
+//
+// At level O1, operations on constants are folded and variables declared
+// as constants replaced by their values, the code that can no longer run
+// is left out, and so are the variables and functions nothing uses. At
+// level O2, small functions are inlined and the variables a loop declares
+// with the same value on every iteration are moved out of it. A runtime
+// error still stops the program where it would have: what may fail is not
+// folded, left out or moved ahead of what runs before it.
+package optimize
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"fmt"
+)
+
+// Level is how much a program is optimized.
+type Level int
+
+const (
+	O0 Level = iota // no optimization
+	O1
+	O2
+)
+
+func (l Level) String() string {
+	return fmt.Sprintf("O%d", int(l))
+}
+
+// passes are run in order, each from its level on.
+var passes = []struct {
+	level Level
+	run   func(*ast.Program, *checker.Info)
+}{
+	{O1, fold},
+	{O2, inline},
+	{O2, fold},
+	{O2, hoist},
+	{O1, removeDead},
+}
+
+// Program optimizes a checked program in place at the given level.
+func Program(prog *ast.Program, info *checker.Info, level Level) {
+	for _, pass := range passes {
+		if level >= pass.level {
+			pass.run(prog, info)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package optimize_test
+
+import (
+	"bo/ast"
+	"bo/internal/botest"
+	"bo/optimize"
+	"path/filepath"
+	"testing"
+)
+
+// TestLevels runs the programs of parser/testdata and testdata at every
+// level on both engines, and checks that each prints what it prints
+// unoptimized on the tree engine, and stops with the same runtime error.
+func TestLevels(t *testing.T) {
+	for _, p := range botest.Programs(t, "testdata") {
+		t.Run(p.Name, func(t *testing.T) {
+			t.Parallel()
+			want := botest.Run(t, p.File, "--engine=tree", "-O0")
+			for _, engine := range []string{"tree", "vm"} {
+				for level := optimize.O0; level <= optimize.O2; level++ {
+					t.Run(engine+"-"+level.String(), func(t *testing.T) {
+						botest.Compare(t, botest.Run(t, p.File, "--engine="+engine, "-"+level.String()), want)
+					})
+				}
+			}
+		})
+	}
+}
+
+// TestRewrites checks that the pass each program of testdata is meant for
+// rewrites it at the level the pass runs from, so that TestLevels runs the
+// code the pass writes.
+func TestRewrites(t *testing.T) {
+	for name, level := range map[string]optimize.Level{
+		"fold": optimize.O1, "unfolded": optimize.O1, "dead": optimize.O1,
+		"inline": optimize.O2, "hoist": optimize.O2,
+	} {
+		t.Run(name, func(t *testing.T) {
+			below, at := load(t, name), load(t, name)
+			optimize.Program(below.Prog, below.Info, level-1)
+			optimize.Program(at.Prog, at.Info, level)
+			if ast.String(at.Prog) == ast.String(below.Prog) {
+				t.Errorf("%s rewrites nothing %s does not:\n%s", level, level-1, ast.String(at.Prog))
+			}
+		})
+	}
+}
+
+func load(t *testing.T, name string) *botest.Program {
+	p, err := botest.Load(filepath.Join("testdata", name+".bo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
@@ -0,0 +1,319 @@
+package optimize
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"fmt"
+	"path"
+)
+
+// walker goes through a program in the order it runs, resolving each name
+// to the node that declares it as the checker does, and lets a pass
+// replace expressions and statement lists on the way back up.
+type walker struct {
+	info  *checker.Info
+	scope *scope
+
+	// The node declaring each name used: a *ast.Var, *ast.Param,
+	// *ast.FuncDecl, *ast.BindingPattern or other node that binds a name.
+	// Builtins are left out
+	uses map[*ast.Ident]ast.Node
+
+	// The declaration of each variable
+	decls map[*ast.Var]*ast.VarDecl
+
+	// expr returns what replaces an expression, whose children are done
+	expr func(ast.Expr) ast.Expr
+
+	// stmts returns what replaces a list of statements, which are done
+	stmts func([]ast.Stmt) []ast.Stmt
+}
+
+type scope struct {
+	names map[string]ast.Node
+	outer *scope
+}
+
+func newWalker(info *checker.Info) *walker {
+	return &walker{
+		info:  info,
+		uses:  make(map[*ast.Ident]ast.Node),
+		decls: make(map[*ast.Var]*ast.VarDecl),
+		expr:  func(e ast.Expr) ast.Expr { return e },
+		stmts: func(stmts []ast.Stmt) []ast.Stmt { return stmts },
+	}
+}
+
+func (w *walker) program(prog *ast.Program) {
+	w.scope = &scope{names: make(map[string]ast.Node)}
+	prog.Stmts = w.list(prog.Stmts)
+}
+
+// block walks the statements of a block in a scope of their own.
+func (w *walker) block(block *ast.Block) {
+	w.open(func() { block.Stmts = w.list(block.Stmts) })
+}
+
+func (w *walker) open(f func()) {
+	w.scope = &scope{names: make(map[string]ast.Node), outer: w.scope}
+	defer func() { w.scope = w.scope.outer }()
+	f()
+}
+
+func (w *walker) declare(name *ast.Ident, node ast.Node) {
+	w.scope.names[name.Name] = node
+}
+
+func (w *walker) lookup(name string) ast.Node {
+	for s := w.scope; s != nil; s = s.outer {
+		if node, ok := s.names[name]; ok {
+			return node
+		}
+	}
+	return nil
+}
+
+func (w *walker) list(stmts []ast.Stmt) []ast.Stmt {
+	for _, stmt := range stmts {
+		w.stmt(stmt)
+	}
+	return w.stmts(stmts)
+}
+
+func (w *walker) stmt(stmt ast.Stmt) {
+	switch s := stmt.(type) {
+	case *ast.Require:
+		if s.Std {
+			w.scope.names[path.Base(s.Path)] = s
+		}
+	case *ast.EnumDecl:
+		w.declare(s.Name, s)
+	case *ast.StructDecl:
+		w.declare(s.Name, s)
+	case *ast.FuncDecl:
+		w.funcDecl(s)
+	case *ast.Return:
+		w.exprs(s.Results)
+	case *ast.Defer:
+		w.call(s.Call)
+	case *ast.Yield:
+		s.Value = w.walkExpr(s.Value)
+	case *ast.For:
+		s.X = w.walkExpr(s.X)
+		w.open(func() {
+			w.pattern(s.Pattern)
+			w.block(s.Body)
+		})
+	case *ast.VarDecl:
+		s.Value = w.walkExpr(s.Value)
+		for _, v := range s.Vars {
+			w.declare(v.Name, v)
+			w.decls[v] = s
+		}
+	case *ast.Destructure:
+		s.Value = w.walkExpr(s.Value)
+		w.pattern(s.Pattern)
+	case *ast.Switch:
+		s.X = w.walkExpr(s.X)
+		for _, arm := range s.Arms {
+			w.open(func() {
+				w.pattern(arm.Pattern)
+				if arm.Guard != nil {
+					arm.Guard = w.walkExpr(arm.Guard)
+				}
+				w.block(arm.Body)
+			})
+		}
+	case *ast.Spawn:
+		w.call(s.Call)
+	case *ast.Send:
+		s.Chan = w.walkExpr(s.Chan)
+		s.Value = w.walkExpr(s.Value)
+	case *ast.Select:
+		for _, arm := range s.Arms {
+			switch arm := arm.(type) {
+			case *ast.ReceiveArm:
+				arm.Chan = w.walkExpr(arm.Chan)
+				w.open(func() {
+					if arm.Name != nil {
+						w.declare(arm.Name, arm)
+					}
+					w.block(arm.Body)
+				})
+			case *ast.SendArm:
+				arm.Chan = w.walkExpr(arm.Chan)
+				arm.Value = w.walkExpr(arm.Value)
+				w.block(arm.Body)
+			case *ast.DefaultArm:
+				w.block(arm.Body)
+			}
+		}
+	case *ast.AwaitStmt:
+		s.X = w.walkExpr(s.X)
+	case *ast.CallStmt:
+		w.call(s.Call)
+	default:
+		panic(fmt.Sprintf("stmt -> unhandled statement type: %T", stmt))
+	}
+}
+
+// funcDecl declares a function before its body, so that it may call
+// itself. The parameters are in the same scope as the body, and a default
+// value sees the parameters before it.
+func (w *walker) funcDecl(decl *ast.FuncDecl) {
+	if decl.Recv == nil {
+		w.declare(decl.Name, decl)
+	}
+
+	w.open(func() {
+		if decl.Recv != nil {
+			w.declare(decl.Recv.Name, decl.Recv)
+		}
+		for _, param := range decl.Params {
+			if param.Default != nil {
+				param.Default = w.walkExpr(param.Default)
+			}
+			w.declare(param.Name, param)
+		}
+		decl.Body.Stmts = w.list(decl.Body.Stmts)
+	})
+}
+
+// pattern declares the names a pattern binds.
+func (w *walker) pattern(p ast.Pattern) {
+	switch p := p.(type) {
+	case *ast.BindingPattern:
+		w.declare(p.Name, p)
+	case *ast.RestPattern:
+		if p.Name != nil {
+			w.declare(p.Name, p)
+		}
+	case *ast.CasePattern:
+		for _, field := range p.Fields {
+			w.pattern(field)
+		}
+	case *ast.ListPattern:
+		for _, elem := range p.Elems {
+			w.pattern(elem)
+		}
+	case *ast.MapPattern:
+		for _, entry := range p.Entries {
+			w.pattern(entry.Key)
+			w.pattern(entry.Value)
+		}
+	case *ast.TuplePattern:
+		for _, elem := range p.Elems {
+			w.pattern(elem)
+		}
+	case *ast.StructPattern:
+		for _, field := range p.Fields {
+			if field.Pattern == nil {
+				w.declare(field.Name, field)
+			} else {
+				w.pattern(field.Pattern)
+			}
+		}
+	}
+}
+
+func (w *walker) exprs(exprs []ast.Expr) {
+	for i, e := range exprs {
+		exprs[i] = w.walkExpr(e)
+	}
+}
+
+// call walks the function and arguments of a call, keeping the arguments
+// the checker matched with the parameters in step.
+func (w *walker) call(call *ast.Call) {
+	call.Fun = w.walkExpr(call.Fun)
+	c := w.info.Calls[call]
+	for _, arg := range call.Args {
+		old := arg.Value
+		arg.Value = w.walkExpr(old)
+		if c == nil || arg.Value == old {
+			continue
+		}
+		for i, e := range c.Args {
+			if e == old {
+				c.Args[i] = arg.Value
+			}
+		}
+		for i, e := range c.Rest {
+			if e == old {
+				c.Rest[i] = arg.Value
+			}
+		}
+	}
+}
+
+// walkExpr walks the children of an expression, then returns what replaces
+// it.
+func (w *walker) walkExpr(e ast.Expr) ast.Expr {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if node := w.lookup(e.Name); node != nil {
+			w.uses[e] = node
+		}
+	case *ast.BasicLit:
+	case *ast.StringLit:
+		for _, part := range e.Parts {
+			if part.Expr != nil {
+				part.Expr = w.walkExpr(part.Expr)
+			}
+		}
+	case *ast.Paren:
+		e.X = w.walkExpr(e.X)
+	case *ast.ListLit:
+		w.exprs(e.Elems)
+	case *ast.MapLit:
+		for _, entry := range e.Entries {
+			entry.Key = w.walkExpr(entry.Key)
+			entry.Value = w.walkExpr(entry.Value)
+		}
+	case *ast.TupleLit:
+		w.exprs(e.Elems)
+	case *ast.StructLit:
+		for _, field := range e.Fields {
+			field.Value = w.walkExpr(field.Value)
+		}
+	case *ast.Match:
+		e.X = w.walkExpr(e.X)
+		for _, arm := range e.Arms {
+			w.open(func() {
+				w.pattern(arm.Pattern)
+				if arm.Guard != nil {
+					arm.Guard = w.walkExpr(arm.Guard)
+				}
+				arm.Value = w.walkExpr(arm.Value)
+			})
+		}
+	case *ast.Conversion:
+		e.X = w.walkExpr(e.X)
+	case *ast.Member:
+		e.X = w.walkExpr(e.X)
+	case *ast.Call:
+		w.call(e)
+	case *ast.Index:
+		e.X = w.walkExpr(e.X)
+		e.Index = w.walkExpr(e.Index)
+	case *ast.Unary:
+		e.X = w.walkExpr(e.X)
+	case *ast.Receive:
+		e.Chan = w.walkExpr(e.Chan)
+	case *ast.Await:
+		e.X = w.walkExpr(e.X)
+	case *ast.Binary:
+		e.X = w.walkExpr(e.X)
+		e.Y = w.walkExpr(e.Y)
+	case *ast.Range:
+		e.From = w.walkExpr(e.From)
+		e.To = w.walkExpr(e.To)
+	case *ast.Step:
+		e.X = w.walkExpr(e.X)
+		e.Step = w.walkExpr(e.Step)
+	default:
+		panic(fmt.Sprintf("walkExpr -> unhandled expression type: %T", e))
+	}
+
+	return w.expr(e)
+}
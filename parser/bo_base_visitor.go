@@ -15,7 +15,111 @@ func (v *BaseBoVisitor) VisitStatement(ctx *StatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseBoVisitor) VisitExpression(ctx *ExpressionContext) interface{} {
+func (v *BaseBoVisitor) VisitBlock(ctx *BlockContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitPrimaryExpression(ctx *PrimaryExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitMatchExpression(ctx *MatchExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitConversionExpression(ctx *ConversionExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitUnaryExpression(ctx *UnaryExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitReceiveExpression(ctx *ReceiveExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitAwaitExpression(ctx *AwaitExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitMultiplicativeExpression(ctx *MultiplicativeExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitAdditiveExpression(ctx *AdditiveExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitRangeExpression(ctx *RangeExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitStepExpression(ctx *StepExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitRelationalExpression(ctx *RelationalExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitEqualityExpression(ctx *EqualityExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitAndExpression(ctx *AndExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitOrExpression(ctx *OrExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitCoalesceExpression(ctx *CoalesceExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitMemberExpression(ctx *MemberExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitCallExpression(ctx *CallExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitIndexExpression(ctx *IndexExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitPrimary(ctx *PrimaryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitListLiteral(ctx *ListLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitMapLiteral(ctx *MapLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitMapEntry(ctx *MapEntryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitTupleLiteral(ctx *TupleLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitStructLiteral(ctx *StructLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitFieldValue(ctx *FieldValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitEmbeddedExpression(ctx *EmbeddedExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
@@ -23,18 +127,194 @@ func (v *BaseBoVisitor) VisitFunctionParameters(ctx *FunctionParametersContext)
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseBoVisitor) VisitArgument(ctx *ArgumentContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseBoVisitor) VisitFunctionCall(ctx *FunctionCallContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseBoVisitor) VisitFunctionDeclaration(ctx *FunctionDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitOperator(ctx *OperatorContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitReceiver(ctx *ReceiverContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitParameter(ctx *ParameterContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitReturnStatement(ctx *ReturnStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitYieldStatement(ctx *YieldStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitForStatement(ctx *ForStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitDeferStatement(ctx *DeferStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitEnumDeclaration(ctx *EnumDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitEnumCase(ctx *EnumCaseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitStructDeclaration(ctx *StructDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitStructField(ctx *StructFieldContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitMatchArm(ctx *MatchArmContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitSwitchStatement(ctx *SwitchStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitSwitchArm(ctx *SwitchArmContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitGuard(ctx *GuardContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitSpawnStatement(ctx *SpawnStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitAwaitStatement(ctx *AwaitStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitSendStatement(ctx *SendStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitSelectStatement(ctx *SelectStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitReceiveArm(ctx *ReceiveArmContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitSendArm(ctx *SendArmContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitDefaultArm(ctx *DefaultArmContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitWildcardPattern(ctx *WildcardPatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitLiteralPattern(ctx *LiteralPatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitCasePattern(ctx *CasePatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitListPattern(ctx *ListPatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitRestPattern(ctx *RestPatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitMapPattern(ctx *MapPatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitTuplePattern(ctx *TuplePatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitStructPattern(ctx *StructPatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitBindingPattern(ctx *BindingPatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitEntryPattern(ctx *EntryPatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitFieldPattern(ctx *FieldPatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseBoVisitor) VisitVariableDeclaration(ctx *VariableDeclarationContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseBoVisitor) VisitDestructuringDeclaration(ctx *DestructuringDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseBoVisitor) VisitTypeSpec(ctx *TypeSpecContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseBoVisitor) VisitTypeName(ctx *TypeNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitListType(ctx *ListTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitMapType(ctx *MapTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitChanType(ctx *ChanTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitFutureType(ctx *FutureTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitIteratorType(ctx *IteratorTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitTupleType(ctx *TupleTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseBoVisitor) VisitBasicType(ctx *BasicTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseBoVisitor) VisitRequireStatement(ctx *RequireStatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
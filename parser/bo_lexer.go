@@ -43,122 +43,380 @@ func bolexerLexerInit() {
 		"DEFAULT_MODE",
 	}
 	staticData.LiteralNames = []string{
-		"", "'int'", "'float'", "'string'", "'bool'", "'/'", "'<'", "'>'", "'='",
-		"'('", "')'", "'{'", "'}'", "'.'", "','", "';'", "'require'",
+		"", "'string'", "'int'", "'int8'", "'int16'", "'int32'", "'int64'", "'uint8'",
+		"'uint16'", "'uint32'", "'uint64'", "'float'", "'float32'", "'bigint'",
+		"'decimal'", "'byte'", "'char'", "'rune'", "'bool'", "'<='", "'>='",
+		"'=='", "'!='", "'<'", "'>'", "'='", "'=>'", "'<-'", "'+'", "'-'", "'*'",
+		"'/'", "'%'", "'&&'", "'||'", "'!'", "'+%'", "'-%'", "'*%'", "'('", "')'",
+		"'{'", "'}'", "'['", "']'", "':'", "'...'", "'..<'", "'..'", "'.'", "','",
+		"';'", "'?'", "'?.'", "'??'", "'require'", "'enum'", "'match'", "'switch'",
+		"'case'", "'if'", "'struct'", "'map'", "'func'", "'return'", "'spawn'",
+		"'select'", "'default'", "'chan'", "'async'", "'defer'", "'await'", "'Future'",
+		"'yield'", "'for'", "'in'", "'Iterator'", "'step'", "", "", "", "", "",
+		"'nil'", "", "'_'",
 	}
 	staticData.SymbolicNames = []string{
-		"", "", "", "", "", "", "LT", "GT", "ASSIGN", "LPAREN", "RPAREN", "LBRACE",
-		"RBRACE", "PERIOD", "COMMA", "SEMICOLON", "REQUIRE", "INT", "FLOAT",
-		"BOOL", "STRING", "ID", "WS", "S_COMMENT", "M_COMMENT",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "LE", "GE", "EQ", "NE", "LT", "GT", "ASSIGN", "ARROW", "RECEIVE",
+		"ADD", "SUB", "MUL", "DIV", "MOD", "AND", "OR", "NOT", "ADD_WRAP", "SUB_WRAP",
+		"MUL_WRAP", "LPAREN", "RPAREN", "LBRACE", "RBRACE", "LBRACK", "RBRACK",
+		"COLON", "ELLIPSIS", "RANGE_EXCL", "RANGE", "PERIOD", "COMMA", "SEMICOLON",
+		"QUESTION", "SAFE_PERIOD", "COALESCE", "REQUIRE", "ENUM", "MATCH", "SWITCH",
+		"CASE", "IF", "STRUCT", "MAP", "FUNC", "RETURN", "SPAWN", "SELECT", "DEFAULT",
+		"CHAN", "ASYNC", "DEFER", "AWAIT", "FUTURE", "YIELD", "FOR", "IN", "ITERATOR",
+		"STEP", "INT", "FLOAT", "BIGINT", "DECIMAL", "BOOL", "NIL", "STRING",
+		"UNDERSCORE", "ID", "WS", "S_COMMENT", "M_COMMENT",
 	}
 	staticData.RuleNames = []string{
-		"T__0", "T__1", "T__2", "T__3", "T__4", "LT", "GT", "ASSIGN", "LPAREN",
-		"RPAREN", "LBRACE", "RBRACE", "PERIOD", "COMMA", "SEMICOLON", "REQUIRE",
-		"INT", "FLOAT", "BOOL", "STRING", "ID", "WS", "S_COMMENT", "M_COMMENT",
-		"ESC", "UNICODE", "HEX", "DIGIT",
+		"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8",
+		"T__9", "T__10", "T__11", "T__12", "T__13", "T__14", "T__15", "T__16",
+		"T__17", "LE", "GE", "EQ", "NE", "LT", "GT", "ASSIGN", "ARROW", "RECEIVE",
+		"ADD", "SUB", "MUL", "DIV", "MOD", "AND", "OR", "NOT", "ADD_WRAP", "SUB_WRAP",
+		"MUL_WRAP", "LPAREN", "RPAREN", "LBRACE", "RBRACE", "LBRACK", "RBRACK",
+		"COLON", "ELLIPSIS", "RANGE_EXCL", "RANGE", "PERIOD", "COMMA", "SEMICOLON",
+		"QUESTION", "SAFE_PERIOD", "COALESCE", "REQUIRE", "ENUM", "MATCH", "SWITCH",
+		"CASE", "IF", "STRUCT", "MAP", "FUNC", "RETURN", "SPAWN", "SELECT", "DEFAULT",
+		"CHAN", "ASYNC", "DEFER", "AWAIT", "FUTURE", "YIELD", "FOR", "IN", "ITERATOR",
+		"STEP", "INT", "FLOAT", "BIGINT", "DECIMAL", "BOOL", "NIL", "STRING",
+		"UNDERSCORE", "ID", "WS", "S_COMMENT", "M_COMMENT", "ESC", "UNICODE",
+		"HEX", "DECIMALS", "HEX_DIGITS", "OCTAL_DIGITS", "BINARY_DIGITS", "EXPONENT",
+		"INTERPOLATION", "INTERPOLATION_BODY", "DIGIT",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 24, 218, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
+		4, 0, 89, 746, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
 		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
 		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
 		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
 		20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25,
-		2, 26, 7, 26, 2, 27, 7, 27, 1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1,
-		1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1,
-		3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1,
-		8, 1, 9, 1, 9, 1, 10, 1, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1, 13, 1, 13,
-		1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1,
-		16, 4, 16, 111, 8, 16, 11, 16, 12, 16, 112, 1, 17, 4, 17, 116, 8, 17, 11,
-		17, 12, 17, 117, 1, 17, 1, 17, 5, 17, 122, 8, 17, 10, 17, 12, 17, 125,
-		9, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 3,
-		18, 136, 8, 18, 1, 19, 1, 19, 1, 19, 5, 19, 141, 8, 19, 10, 19, 12, 19,
-		144, 9, 19, 1, 19, 1, 19, 1, 19, 1, 19, 5, 19, 150, 8, 19, 10, 19, 12,
-		19, 153, 9, 19, 1, 19, 3, 19, 156, 8, 19, 1, 20, 1, 20, 5, 20, 160, 8,
-		20, 10, 20, 12, 20, 163, 9, 20, 1, 21, 4, 21, 166, 8, 21, 11, 21, 12, 21,
-		167, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 5, 22, 176, 8, 22, 10, 22,
-		12, 22, 179, 9, 22, 1, 22, 3, 22, 182, 8, 22, 1, 22, 1, 22, 1, 22, 1, 22,
-		1, 23, 1, 23, 1, 23, 1, 23, 5, 23, 192, 8, 23, 10, 23, 12, 23, 195, 9,
-		23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 3, 24, 205,
-		8, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 27, 1,
-		27, 3, 27, 217, 8, 27, 1, 193, 0, 28, 1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11,
-		6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 29, 15,
-		31, 16, 33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43, 22, 45, 23, 47, 24,
-		49, 0, 51, 0, 53, 0, 55, 0, 1, 0, 9, 1, 0, 48, 57, 2, 0, 34, 34, 92, 92,
-		2, 0, 39, 39, 92, 92, 3, 0, 65, 90, 95, 95, 97, 122, 4, 0, 48, 57, 65,
-		90, 95, 95, 97, 122, 3, 0, 9, 10, 13, 13, 32, 32, 2, 0, 10, 10, 13, 13,
-		8, 0, 34, 34, 47, 47, 92, 92, 98, 98, 102, 102, 110, 110, 114, 114, 116,
-		116, 3, 0, 48, 57, 65, 70, 97, 102, 229, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0,
-		0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0,
-		0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1,
-		0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27,
-		1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0,
-		35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0,
-		0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 1, 57, 1, 0, 0,
-		0, 3, 61, 1, 0, 0, 0, 5, 67, 1, 0, 0, 0, 7, 74, 1, 0, 0, 0, 9, 79, 1, 0,
-		0, 0, 11, 81, 1, 0, 0, 0, 13, 83, 1, 0, 0, 0, 15, 85, 1, 0, 0, 0, 17, 87,
-		1, 0, 0, 0, 19, 89, 1, 0, 0, 0, 21, 91, 1, 0, 0, 0, 23, 93, 1, 0, 0, 0,
-		25, 95, 1, 0, 0, 0, 27, 97, 1, 0, 0, 0, 29, 99, 1, 0, 0, 0, 31, 101, 1,
-		0, 0, 0, 33, 110, 1, 0, 0, 0, 35, 115, 1, 0, 0, 0, 37, 135, 1, 0, 0, 0,
-		39, 155, 1, 0, 0, 0, 41, 157, 1, 0, 0, 0, 43, 165, 1, 0, 0, 0, 45, 171,
-		1, 0, 0, 0, 47, 187, 1, 0, 0, 0, 49, 201, 1, 0, 0, 0, 51, 206, 1, 0, 0,
-		0, 53, 212, 1, 0, 0, 0, 55, 216, 1, 0, 0, 0, 57, 58, 5, 105, 0, 0, 58,
-		59, 5, 110, 0, 0, 59, 60, 5, 116, 0, 0, 60, 2, 1, 0, 0, 0, 61, 62, 5, 102,
-		0, 0, 62, 63, 5, 108, 0, 0, 63, 64, 5, 111, 0, 0, 64, 65, 5, 97, 0, 0,
-		65, 66, 5, 116, 0, 0, 66, 4, 1, 0, 0, 0, 67, 68, 5, 115, 0, 0, 68, 69,
-		5, 116, 0, 0, 69, 70, 5, 114, 0, 0, 70, 71, 5, 105, 0, 0, 71, 72, 5, 110,
-		0, 0, 72, 73, 5, 103, 0, 0, 73, 6, 1, 0, 0, 0, 74, 75, 5, 98, 0, 0, 75,
-		76, 5, 111, 0, 0, 76, 77, 5, 111, 0, 0, 77, 78, 5, 108, 0, 0, 78, 8, 1,
-		0, 0, 0, 79, 80, 5, 47, 0, 0, 80, 10, 1, 0, 0, 0, 81, 82, 5, 60, 0, 0,
-		82, 12, 1, 0, 0, 0, 83, 84, 5, 62, 0, 0, 84, 14, 1, 0, 0, 0, 85, 86, 5,
-		61, 0, 0, 86, 16, 1, 0, 0, 0, 87, 88, 5, 40, 0, 0, 88, 18, 1, 0, 0, 0,
-		89, 90, 5, 41, 0, 0, 90, 20, 1, 0, 0, 0, 91, 92, 5, 123, 0, 0, 92, 22,
-		1, 0, 0, 0, 93, 94, 5, 125, 0, 0, 94, 24, 1, 0, 0, 0, 95, 96, 5, 46, 0,
-		0, 96, 26, 1, 0, 0, 0, 97, 98, 5, 44, 0, 0, 98, 28, 1, 0, 0, 0, 99, 100,
-		5, 59, 0, 0, 100, 30, 1, 0, 0, 0, 101, 102, 5, 114, 0, 0, 102, 103, 5,
-		101, 0, 0, 103, 104, 5, 113, 0, 0, 104, 105, 5, 117, 0, 0, 105, 106, 5,
-		105, 0, 0, 106, 107, 5, 114, 0, 0, 107, 108, 5, 101, 0, 0, 108, 32, 1,
-		0, 0, 0, 109, 111, 7, 0, 0, 0, 110, 109, 1, 0, 0, 0, 111, 112, 1, 0, 0,
-		0, 112, 110, 1, 0, 0, 0, 112, 113, 1, 0, 0, 0, 113, 34, 1, 0, 0, 0, 114,
-		116, 7, 0, 0, 0, 115, 114, 1, 0, 0, 0, 116, 117, 1, 0, 0, 0, 117, 115,
-		1, 0, 0, 0, 117, 118, 1, 0, 0, 0, 118, 119, 1, 0, 0, 0, 119, 123, 5, 46,
-		0, 0, 120, 122, 7, 0, 0, 0, 121, 120, 1, 0, 0, 0, 122, 125, 1, 0, 0, 0,
-		123, 121, 1, 0, 0, 0, 123, 124, 1, 0, 0, 0, 124, 36, 1, 0, 0, 0, 125, 123,
-		1, 0, 0, 0, 126, 127, 5, 116, 0, 0, 127, 128, 5, 114, 0, 0, 128, 129, 5,
-		117, 0, 0, 129, 136, 5, 101, 0, 0, 130, 131, 5, 102, 0, 0, 131, 132, 5,
-		97, 0, 0, 132, 133, 5, 108, 0, 0, 133, 134, 5, 115, 0, 0, 134, 136, 5,
-		101, 0, 0, 135, 126, 1, 0, 0, 0, 135, 130, 1, 0, 0, 0, 136, 38, 1, 0, 0,
-		0, 137, 142, 5, 34, 0, 0, 138, 141, 3, 49, 24, 0, 139, 141, 8, 1, 0, 0,
-		140, 138, 1, 0, 0, 0, 140, 139, 1, 0, 0, 0, 141, 144, 1, 0, 0, 0, 142,
-		140, 1, 0, 0, 0, 142, 143, 1, 0, 0, 0, 143, 145, 1, 0, 0, 0, 144, 142,
-		1, 0, 0, 0, 145, 156, 5, 34, 0, 0, 146, 151, 5, 39, 0, 0, 147, 150, 3,
-		49, 24, 0, 148, 150, 8, 2, 0, 0, 149, 147, 1, 0, 0, 0, 149, 148, 1, 0,
-		0, 0, 150, 153, 1, 0, 0, 0, 151, 149, 1, 0, 0, 0, 151, 152, 1, 0, 0, 0,
-		152, 154, 1, 0, 0, 0, 153, 151, 1, 0, 0, 0, 154, 156, 5, 39, 0, 0, 155,
-		137, 1, 0, 0, 0, 155, 146, 1, 0, 0, 0, 156, 40, 1, 0, 0, 0, 157, 161, 7,
-		3, 0, 0, 158, 160, 7, 4, 0, 0, 159, 158, 1, 0, 0, 0, 160, 163, 1, 0, 0,
-		0, 161, 159, 1, 0, 0, 0, 161, 162, 1, 0, 0, 0, 162, 42, 1, 0, 0, 0, 163,
-		161, 1, 0, 0, 0, 164, 166, 7, 5, 0, 0, 165, 164, 1, 0, 0, 0, 166, 167,
-		1, 0, 0, 0, 167, 165, 1, 0, 0, 0, 167, 168, 1, 0, 0, 0, 168, 169, 1, 0,
-		0, 0, 169, 170, 6, 21, 0, 0, 170, 44, 1, 0, 0, 0, 171, 172, 5, 47, 0, 0,
-		172, 173, 5, 47, 0, 0, 173, 177, 1, 0, 0, 0, 174, 176, 8, 6, 0, 0, 175,
-		174, 1, 0, 0, 0, 176, 179, 1, 0, 0, 0, 177, 175, 1, 0, 0, 0, 177, 178,
-		1, 0, 0, 0, 178, 181, 1, 0, 0, 0, 179, 177, 1, 0, 0, 0, 180, 182, 5, 13,
-		0, 0, 181, 180, 1, 0, 0, 0, 181, 182, 1, 0, 0, 0, 182, 183, 1, 0, 0, 0,
-		183, 184, 5, 10, 0, 0, 184, 185, 1, 0, 0, 0, 185, 186, 6, 22, 1, 0, 186,
-		46, 1, 0, 0, 0, 187, 188, 5, 47, 0, 0, 188, 189, 5, 42, 0, 0, 189, 193,
-		1, 0, 0, 0, 190, 192, 9, 0, 0, 0, 191, 190, 1, 0, 0, 0, 192, 195, 1, 0,
-		0, 0, 193, 194, 1, 0, 0, 0, 193, 191, 1, 0, 0, 0, 194, 196, 1, 0, 0, 0,
-		195, 193, 1, 0, 0, 0, 196, 197, 5, 42, 0, 0, 197, 198, 5, 47, 0, 0, 198,
-		199, 1, 0, 0, 0, 199, 200, 6, 23, 1, 0, 200, 48, 1, 0, 0, 0, 201, 204,
-		5, 92, 0, 0, 202, 205, 7, 7, 0, 0, 203, 205, 3, 51, 25, 0, 204, 202, 1,
-		0, 0, 0, 204, 203, 1, 0, 0, 0, 205, 50, 1, 0, 0, 0, 206, 207, 5, 117, 0,
-		0, 207, 208, 3, 53, 26, 0, 208, 209, 3, 53, 26, 0, 209, 210, 3, 53, 26,
-		0, 210, 211, 3, 53, 26, 0, 211, 52, 1, 0, 0, 0, 212, 213, 7, 8, 0, 0, 213,
-		54, 1, 0, 0, 0, 214, 217, 3, 33, 16, 0, 215, 217, 3, 35, 17, 0, 216, 214,
-		1, 0, 0, 0, 216, 215, 1, 0, 0, 0, 217, 56, 1, 0, 0, 0, 17, 0, 112, 117,
-		123, 135, 140, 142, 149, 151, 155, 161, 167, 177, 181, 193, 204, 216, 2,
-		6, 0, 0, 0, 1, 0,
+		2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2,
+		31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36,
+		7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7,
+		41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46,
+		2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2,
+		52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57,
+		7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7,
+		62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67,
+		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
+		73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78,
+		7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7,
+		83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88,
+		2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2,
+		94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99,
+		7, 99, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 4,
+		1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6,
+		1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7,
+		1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9,
+		1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1,
+		11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12,
+		1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1,
+		14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16,
+		1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1,
+		18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21,
+		1, 22, 1, 22, 1, 23, 1, 23, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 26, 1,
+		26, 1, 26, 1, 27, 1, 27, 1, 28, 1, 28, 1, 29, 1, 29, 1, 30, 1, 30, 1, 31,
+		1, 31, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 35, 1,
+		35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 39,
+		1, 39, 1, 40, 1, 40, 1, 41, 1, 41, 1, 42, 1, 42, 1, 43, 1, 43, 1, 44, 1,
+		44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47,
+		1, 47, 1, 48, 1, 48, 1, 49, 1, 49, 1, 50, 1, 50, 1, 51, 1, 51, 1, 52, 1,
+		52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54,
+		1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 1,
+		56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 58,
+		1, 58, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1,
+		60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62,
+		1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 64, 1,
+		64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65,
+		1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 67, 1,
+		67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 69,
+		1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1,
+		70, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72,
+		1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 74, 1, 74, 1, 74, 1,
+		75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 76, 1, 76,
+		1, 76, 1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 1, 77, 3, 77, 541, 8, 77, 1,
+		77, 1, 77, 1, 77, 1, 77, 3, 77, 547, 8, 77, 1, 77, 1, 77, 1, 77, 1, 77,
+		3, 77, 553, 8, 77, 1, 77, 3, 77, 556, 8, 77, 1, 78, 1, 78, 1, 78, 1, 78,
+		3, 78, 562, 8, 78, 1, 78, 1, 78, 1, 78, 3, 78, 567, 8, 78, 1, 79, 1, 79,
+		1, 79, 1, 80, 1, 80, 1, 80, 3, 80, 575, 8, 80, 1, 80, 1, 80, 1, 81, 1,
+		81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 3, 81, 588, 8, 81,
+		1, 82, 1, 82, 1, 82, 1, 82, 1, 83, 1, 83, 1, 83, 1, 83, 5, 83, 598, 8,
+		83, 10, 83, 12, 83, 601, 9, 83, 1, 83, 1, 83, 1, 83, 1, 83, 5, 83, 607,
+		8, 83, 10, 83, 12, 83, 610, 9, 83, 1, 83, 3, 83, 613, 8, 83, 1, 84, 1,
+		84, 1, 85, 1, 85, 5, 85, 619, 8, 85, 10, 85, 12, 85, 622, 9, 85, 1, 86,
+		4, 86, 625, 8, 86, 11, 86, 12, 86, 626, 1, 86, 1, 86, 1, 87, 1, 87, 1,
+		87, 1, 87, 5, 87, 635, 8, 87, 10, 87, 12, 87, 638, 9, 87, 1, 87, 3, 87,
+		641, 8, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 88, 1, 88, 1, 88, 1, 88, 5,
+		88, 651, 8, 88, 10, 88, 12, 88, 654, 9, 88, 1, 88, 1, 88, 1, 88, 1, 88,
+		1, 88, 1, 89, 1, 89, 1, 89, 3, 89, 664, 8, 89, 1, 90, 1, 90, 1, 90, 1,
+		90, 1, 90, 1, 90, 1, 91, 1, 91, 1, 92, 1, 92, 3, 92, 676, 8, 92, 1, 92,
+		5, 92, 679, 8, 92, 10, 92, 12, 92, 682, 9, 92, 1, 93, 1, 93, 3, 93, 686,
+		8, 93, 1, 93, 5, 93, 689, 8, 93, 10, 93, 12, 93, 692, 9, 93, 1, 94, 1,
+		94, 3, 94, 696, 8, 94, 1, 94, 5, 94, 699, 8, 94, 10, 94, 12, 94, 702, 9,
+		94, 1, 95, 1, 95, 3, 95, 706, 8, 95, 1, 95, 5, 95, 709, 8, 95, 10, 95,
+		12, 95, 712, 9, 95, 1, 96, 1, 96, 3, 96, 716, 8, 96, 1, 96, 1, 96, 1, 97,
+		1, 97, 1, 97, 1, 97, 5, 97, 724, 8, 97, 10, 97, 12, 97, 727, 9, 97, 1,
+		97, 1, 97, 1, 98, 1, 98, 1, 98, 5, 98, 734, 8, 98, 10, 98, 12, 98, 737,
+		9, 98, 1, 98, 1, 98, 3, 98, 741, 8, 98, 1, 99, 1, 99, 3, 99, 745, 8, 99,
+		1, 652, 0, 100, 1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17,
+		9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 29, 15, 31, 16, 33, 17, 35,
+		18, 37, 19, 39, 20, 41, 21, 43, 22, 45, 23, 47, 24, 49, 25, 51, 26, 53,
+		27, 55, 28, 57, 29, 59, 30, 61, 31, 63, 32, 65, 33, 67, 34, 69, 35, 71,
+		36, 73, 37, 75, 38, 77, 39, 79, 40, 81, 41, 83, 42, 85, 43, 87, 44, 89,
+		45, 91, 46, 93, 47, 95, 48, 97, 49, 99, 50, 101, 51, 103, 52, 105, 53,
+		107, 54, 109, 55, 111, 56, 113, 57, 115, 58, 117, 59, 119, 60, 121, 61,
+		123, 62, 125, 63, 127, 64, 129, 65, 131, 66, 133, 67, 135, 68, 137, 69,
+		139, 70, 141, 71, 143, 72, 145, 73, 147, 74, 149, 75, 151, 76, 153, 77,
+		155, 78, 157, 79, 159, 80, 161, 81, 163, 82, 165, 83, 167, 84, 169, 85,
+		171, 86, 173, 87, 175, 88, 177, 89, 179, 0, 181, 0, 183, 0, 185, 0, 187,
+		0, 189, 0, 191, 0, 193, 0, 195, 0, 197, 0, 199, 0, 1, 0, 17, 2, 0, 88,
+		88, 120, 120, 2, 0, 79, 79, 111, 111, 2, 0, 66, 66, 98, 98, 2, 0, 34, 34,
+		92, 92, 2, 0, 39, 39, 92, 92, 3, 0, 65, 90, 95, 95, 97, 122, 4, 0, 48,
+		57, 65, 90, 95, 95, 97, 122, 3, 0, 9, 10, 13, 13, 32, 32, 2, 0, 10, 10,
+		13, 13, 9, 0, 34, 34, 36, 36, 47, 47, 92, 92, 98, 98, 102, 102, 110, 110,
+		114, 114, 116, 116, 3, 0, 48, 57, 65, 70, 97, 102, 1, 0, 48, 57, 1, 0,
+		48, 55, 1, 0, 48, 49, 2, 0, 69, 69, 101, 101, 2, 0, 43, 43, 45, 45, 4,
+		0, 34, 34, 39, 39, 123, 123, 125, 125, 770, 0, 1, 1, 0, 0, 0, 0, 3, 1,
+		0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1,
+		0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19,
+		1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0,
+		27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0,
+		0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0,
+		0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0,
+		0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1,
+		0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65,
+		1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0,
+		73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0,
+		0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0,
+		0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0,
+		0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103,
+		1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0,
+		0, 111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1,
+		0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0,
+		125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0,
+		0, 0, 0, 133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139,
+		1, 0, 0, 0, 0, 141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0,
+		0, 147, 1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1,
+		0, 0, 0, 0, 155, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0,
+		161, 1, 0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0,
+		0, 0, 0, 169, 1, 0, 0, 0, 0, 171, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 175,
+		1, 0, 0, 0, 0, 177, 1, 0, 0, 0, 1, 201, 1, 0, 0, 0, 3, 208, 1, 0, 0, 0,
+		5, 212, 1, 0, 0, 0, 7, 217, 1, 0, 0, 0, 9, 223, 1, 0, 0, 0, 11, 229, 1,
+		0, 0, 0, 13, 235, 1, 0, 0, 0, 15, 241, 1, 0, 0, 0, 17, 248, 1, 0, 0, 0,
+		19, 255, 1, 0, 0, 0, 21, 262, 1, 0, 0, 0, 23, 268, 1, 0, 0, 0, 25, 276,
+		1, 0, 0, 0, 27, 283, 1, 0, 0, 0, 29, 291, 1, 0, 0, 0, 31, 296, 1, 0, 0,
+		0, 33, 301, 1, 0, 0, 0, 35, 306, 1, 0, 0, 0, 37, 311, 1, 0, 0, 0, 39, 314,
+		1, 0, 0, 0, 41, 317, 1, 0, 0, 0, 43, 320, 1, 0, 0, 0, 45, 323, 1, 0, 0,
+		0, 47, 325, 1, 0, 0, 0, 49, 327, 1, 0, 0, 0, 51, 329, 1, 0, 0, 0, 53, 332,
+		1, 0, 0, 0, 55, 335, 1, 0, 0, 0, 57, 337, 1, 0, 0, 0, 59, 339, 1, 0, 0,
+		0, 61, 341, 1, 0, 0, 0, 63, 343, 1, 0, 0, 0, 65, 345, 1, 0, 0, 0, 67, 348,
+		1, 0, 0, 0, 69, 351, 1, 0, 0, 0, 71, 353, 1, 0, 0, 0, 73, 356, 1, 0, 0,
+		0, 75, 359, 1, 0, 0, 0, 77, 362, 1, 0, 0, 0, 79, 364, 1, 0, 0, 0, 81, 366,
+		1, 0, 0, 0, 83, 368, 1, 0, 0, 0, 85, 370, 1, 0, 0, 0, 87, 372, 1, 0, 0,
+		0, 89, 374, 1, 0, 0, 0, 91, 376, 1, 0, 0, 0, 93, 380, 1, 0, 0, 0, 95, 384,
+		1, 0, 0, 0, 97, 387, 1, 0, 0, 0, 99, 389, 1, 0, 0, 0, 101, 391, 1, 0, 0,
+		0, 103, 393, 1, 0, 0, 0, 105, 395, 1, 0, 0, 0, 107, 398, 1, 0, 0, 0, 109,
+		401, 1, 0, 0, 0, 111, 409, 1, 0, 0, 0, 113, 414, 1, 0, 0, 0, 115, 420,
+		1, 0, 0, 0, 117, 427, 1, 0, 0, 0, 119, 432, 1, 0, 0, 0, 121, 435, 1, 0,
+		0, 0, 123, 442, 1, 0, 0, 0, 125, 446, 1, 0, 0, 0, 127, 451, 1, 0, 0, 0,
+		129, 458, 1, 0, 0, 0, 131, 464, 1, 0, 0, 0, 133, 471, 1, 0, 0, 0, 135,
+		479, 1, 0, 0, 0, 137, 484, 1, 0, 0, 0, 139, 490, 1, 0, 0, 0, 141, 496,
+		1, 0, 0, 0, 143, 502, 1, 0, 0, 0, 145, 509, 1, 0, 0, 0, 147, 515, 1, 0,
+		0, 0, 149, 519, 1, 0, 0, 0, 151, 522, 1, 0, 0, 0, 153, 531, 1, 0, 0, 0,
+		155, 555, 1, 0, 0, 0, 157, 566, 1, 0, 0, 0, 159, 568, 1, 0, 0, 0, 161,
+		571, 1, 0, 0, 0, 163, 587, 1, 0, 0, 0, 165, 589, 1, 0, 0, 0, 167, 612,
+		1, 0, 0, 0, 169, 614, 1, 0, 0, 0, 171, 616, 1, 0, 0, 0, 173, 624, 1, 0,
+		0, 0, 175, 630, 1, 0, 0, 0, 177, 646, 1, 0, 0, 0, 179, 660, 1, 0, 0, 0,
+		181, 665, 1, 0, 0, 0, 183, 671, 1, 0, 0, 0, 185, 673, 1, 0, 0, 0, 187,
+		683, 1, 0, 0, 0, 189, 693, 1, 0, 0, 0, 191, 703, 1, 0, 0, 0, 193, 713,
+		1, 0, 0, 0, 195, 719, 1, 0, 0, 0, 197, 740, 1, 0, 0, 0, 199, 744, 1, 0,
+		0, 0, 201, 202, 5, 115, 0, 0, 202, 203, 5, 116, 0, 0, 203, 204, 5, 114,
+		0, 0, 204, 205, 5, 105, 0, 0, 205, 206, 5, 110, 0, 0, 206, 207, 5, 103,
+		0, 0, 207, 2, 1, 0, 0, 0, 208, 209, 5, 105, 0, 0, 209, 210, 5, 110, 0,
+		0, 210, 211, 5, 116, 0, 0, 211, 4, 1, 0, 0, 0, 212, 213, 5, 105, 0, 0,
+		213, 214, 5, 110, 0, 0, 214, 215, 5, 116, 0, 0, 215, 216, 5, 56, 0, 0,
+		216, 6, 1, 0, 0, 0, 217, 218, 5, 105, 0, 0, 218, 219, 5, 110, 0, 0, 219,
+		220, 5, 116, 0, 0, 220, 221, 5, 49, 0, 0, 221, 222, 5, 54, 0, 0, 222, 8,
+		1, 0, 0, 0, 223, 224, 5, 105, 0, 0, 224, 225, 5, 110, 0, 0, 225, 226, 5,
+		116, 0, 0, 226, 227, 5, 51, 0, 0, 227, 228, 5, 50, 0, 0, 228, 10, 1, 0,
+		0, 0, 229, 230, 5, 105, 0, 0, 230, 231, 5, 110, 0, 0, 231, 232, 5, 116,
+		0, 0, 232, 233, 5, 54, 0, 0, 233, 234, 5, 52, 0, 0, 234, 12, 1, 0, 0, 0,
+		235, 236, 5, 117, 0, 0, 236, 237, 5, 105, 0, 0, 237, 238, 5, 110, 0, 0,
+		238, 239, 5, 116, 0, 0, 239, 240, 5, 56, 0, 0, 240, 14, 1, 0, 0, 0, 241,
+		242, 5, 117, 0, 0, 242, 243, 5, 105, 0, 0, 243, 244, 5, 110, 0, 0, 244,
+		245, 5, 116, 0, 0, 245, 246, 5, 49, 0, 0, 246, 247, 5, 54, 0, 0, 247, 16,
+		1, 0, 0, 0, 248, 249, 5, 117, 0, 0, 249, 250, 5, 105, 0, 0, 250, 251, 5,
+		110, 0, 0, 251, 252, 5, 116, 0, 0, 252, 253, 5, 51, 0, 0, 253, 254, 5,
+		50, 0, 0, 254, 18, 1, 0, 0, 0, 255, 256, 5, 117, 0, 0, 256, 257, 5, 105,
+		0, 0, 257, 258, 5, 110, 0, 0, 258, 259, 5, 116, 0, 0, 259, 260, 5, 54,
+		0, 0, 260, 261, 5, 52, 0, 0, 261, 20, 1, 0, 0, 0, 262, 263, 5, 102, 0,
+		0, 263, 264, 5, 108, 0, 0, 264, 265, 5, 111, 0, 0, 265, 266, 5, 97, 0,
+		0, 266, 267, 5, 116, 0, 0, 267, 22, 1, 0, 0, 0, 268, 269, 5, 102, 0, 0,
+		269, 270, 5, 108, 0, 0, 270, 271, 5, 111, 0, 0, 271, 272, 5, 97, 0, 0,
+		272, 273, 5, 116, 0, 0, 273, 274, 5, 51, 0, 0, 274, 275, 5, 50, 0, 0, 275,
+		24, 1, 0, 0, 0, 276, 277, 5, 98, 0, 0, 277, 278, 5, 105, 0, 0, 278, 279,
+		5, 103, 0, 0, 279, 280, 5, 105, 0, 0, 280, 281, 5, 110, 0, 0, 281, 282,
+		5, 116, 0, 0, 282, 26, 1, 0, 0, 0, 283, 284, 5, 100, 0, 0, 284, 285, 5,
+		101, 0, 0, 285, 286, 5, 99, 0, 0, 286, 287, 5, 105, 0, 0, 287, 288, 5,
+		109, 0, 0, 288, 289, 5, 97, 0, 0, 289, 290, 5, 108, 0, 0, 290, 28, 1, 0,
+		0, 0, 291, 292, 5, 98, 0, 0, 292, 293, 5, 121, 0, 0, 293, 294, 5, 116,
+		0, 0, 294, 295, 5, 101, 0, 0, 295, 30, 1, 0, 0, 0, 296, 297, 5, 99, 0,
+		0, 297, 298, 5, 104, 0, 0, 298, 299, 5, 97, 0, 0, 299, 300, 5, 114, 0,
+		0, 300, 32, 1, 0, 0, 0, 301, 302, 5, 114, 0, 0, 302, 303, 5, 117, 0, 0,
+		303, 304, 5, 110, 0, 0, 304, 305, 5, 101, 0, 0, 305, 34, 1, 0, 0, 0, 306,
+		307, 5, 98, 0, 0, 307, 308, 5, 111, 0, 0, 308, 309, 5, 111, 0, 0, 309,
+		310, 5, 108, 0, 0, 310, 36, 1, 0, 0, 0, 311, 312, 5, 60, 0, 0, 312, 313,
+		5, 61, 0, 0, 313, 38, 1, 0, 0, 0, 314, 315, 5, 62, 0, 0, 315, 316, 5, 61,
+		0, 0, 316, 40, 1, 0, 0, 0, 317, 318, 5, 61, 0, 0, 318, 319, 5, 61, 0, 0,
+		319, 42, 1, 0, 0, 0, 320, 321, 5, 33, 0, 0, 321, 322, 5, 61, 0, 0, 322,
+		44, 1, 0, 0, 0, 323, 324, 5, 60, 0, 0, 324, 46, 1, 0, 0, 0, 325, 326, 5,
+		62, 0, 0, 326, 48, 1, 0, 0, 0, 327, 328, 5, 61, 0, 0, 328, 50, 1, 0, 0,
+		0, 329, 330, 5, 61, 0, 0, 330, 331, 5, 62, 0, 0, 331, 52, 1, 0, 0, 0, 332,
+		333, 5, 60, 0, 0, 333, 334, 5, 45, 0, 0, 334, 54, 1, 0, 0, 0, 335, 336,
+		5, 43, 0, 0, 336, 56, 1, 0, 0, 0, 337, 338, 5, 45, 0, 0, 338, 58, 1, 0,
+		0, 0, 339, 340, 5, 42, 0, 0, 340, 60, 1, 0, 0, 0, 341, 342, 5, 47, 0, 0,
+		342, 62, 1, 0, 0, 0, 343, 344, 5, 37, 0, 0, 344, 64, 1, 0, 0, 0, 345, 346,
+		5, 38, 0, 0, 346, 347, 5, 38, 0, 0, 347, 66, 1, 0, 0, 0, 348, 349, 5, 124,
+		0, 0, 349, 350, 5, 124, 0, 0, 350, 68, 1, 0, 0, 0, 351, 352, 5, 33, 0,
+		0, 352, 70, 1, 0, 0, 0, 353, 354, 5, 43, 0, 0, 354, 355, 5, 37, 0, 0, 355,
+		72, 1, 0, 0, 0, 356, 357, 5, 45, 0, 0, 357, 358, 5, 37, 0, 0, 358, 74,
+		1, 0, 0, 0, 359, 360, 5, 42, 0, 0, 360, 361, 5, 37, 0, 0, 361, 76, 1, 0,
+		0, 0, 362, 363, 5, 40, 0, 0, 363, 78, 1, 0, 0, 0, 364, 365, 5, 41, 0, 0,
+		365, 80, 1, 0, 0, 0, 366, 367, 5, 123, 0, 0, 367, 82, 1, 0, 0, 0, 368,
+		369, 5, 125, 0, 0, 369, 84, 1, 0, 0, 0, 370, 371, 5, 91, 0, 0, 371, 86,
+		1, 0, 0, 0, 372, 373, 5, 93, 0, 0, 373, 88, 1, 0, 0, 0, 374, 375, 5, 58,
+		0, 0, 375, 90, 1, 0, 0, 0, 376, 377, 5, 46, 0, 0, 377, 378, 5, 46, 0, 0,
+		378, 379, 5, 46, 0, 0, 379, 92, 1, 0, 0, 0, 380, 381, 5, 46, 0, 0, 381,
+		382, 5, 46, 0, 0, 382, 383, 5, 60, 0, 0, 383, 94, 1, 0, 0, 0, 384, 385,
+		5, 46, 0, 0, 385, 386, 5, 46, 0, 0, 386, 96, 1, 0, 0, 0, 387, 388, 5, 46,
+		0, 0, 388, 98, 1, 0, 0, 0, 389, 390, 5, 44, 0, 0, 390, 100, 1, 0, 0, 0,
+		391, 392, 5, 59, 0, 0, 392, 102, 1, 0, 0, 0, 393, 394, 5, 63, 0, 0, 394,
+		104, 1, 0, 0, 0, 395, 396, 5, 63, 0, 0, 396, 397, 5, 46, 0, 0, 397, 106,
+		1, 0, 0, 0, 398, 399, 5, 63, 0, 0, 399, 400, 5, 63, 0, 0, 400, 108, 1,
+		0, 0, 0, 401, 402, 5, 114, 0, 0, 402, 403, 5, 101, 0, 0, 403, 404, 5, 113,
+		0, 0, 404, 405, 5, 117, 0, 0, 405, 406, 5, 105, 0, 0, 406, 407, 5, 114,
+		0, 0, 407, 408, 5, 101, 0, 0, 408, 110, 1, 0, 0, 0, 409, 410, 5, 101, 0,
+		0, 410, 411, 5, 110, 0, 0, 411, 412, 5, 117, 0, 0, 412, 413, 5, 109, 0,
+		0, 413, 112, 1, 0, 0, 0, 414, 415, 5, 109, 0, 0, 415, 416, 5, 97, 0, 0,
+		416, 417, 5, 116, 0, 0, 417, 418, 5, 99, 0, 0, 418, 419, 5, 104, 0, 0,
+		419, 114, 1, 0, 0, 0, 420, 421, 5, 115, 0, 0, 421, 422, 5, 119, 0, 0, 422,
+		423, 5, 105, 0, 0, 423, 424, 5, 116, 0, 0, 424, 425, 5, 99, 0, 0, 425,
+		426, 5, 104, 0, 0, 426, 116, 1, 0, 0, 0, 427, 428, 5, 99, 0, 0, 428, 429,
+		5, 97, 0, 0, 429, 430, 5, 115, 0, 0, 430, 431, 5, 101, 0, 0, 431, 118,
+		1, 0, 0, 0, 432, 433, 5, 105, 0, 0, 433, 434, 5, 102, 0, 0, 434, 120, 1,
+		0, 0, 0, 435, 436, 5, 115, 0, 0, 436, 437, 5, 116, 0, 0, 437, 438, 5, 114,
+		0, 0, 438, 439, 5, 117, 0, 0, 439, 440, 5, 99, 0, 0, 440, 441, 5, 116,
+		0, 0, 441, 122, 1, 0, 0, 0, 442, 443, 5, 109, 0, 0, 443, 444, 5, 97, 0,
+		0, 444, 445, 5, 112, 0, 0, 445, 124, 1, 0, 0, 0, 446, 447, 5, 102, 0, 0,
+		447, 448, 5, 117, 0, 0, 448, 449, 5, 110, 0, 0, 449, 450, 5, 99, 0, 0,
+		450, 126, 1, 0, 0, 0, 451, 452, 5, 114, 0, 0, 452, 453, 5, 101, 0, 0, 453,
+		454, 5, 116, 0, 0, 454, 455, 5, 117, 0, 0, 455, 456, 5, 114, 0, 0, 456,
+		457, 5, 110, 0, 0, 457, 128, 1, 0, 0, 0, 458, 459, 5, 115, 0, 0, 459, 460,
+		5, 112, 0, 0, 460, 461, 5, 97, 0, 0, 461, 462, 5, 119, 0, 0, 462, 463,
+		5, 110, 0, 0, 463, 130, 1, 0, 0, 0, 464, 465, 5, 115, 0, 0, 465, 466, 5,
+		101, 0, 0, 466, 467, 5, 108, 0, 0, 467, 468, 5, 101, 0, 0, 468, 469, 5,
+		99, 0, 0, 469, 470, 5, 116, 0, 0, 470, 132, 1, 0, 0, 0, 471, 472, 5, 100,
+		0, 0, 472, 473, 5, 101, 0, 0, 473, 474, 5, 102, 0, 0, 474, 475, 5, 97,
+		0, 0, 475, 476, 5, 117, 0, 0, 476, 477, 5, 108, 0, 0, 477, 478, 5, 116,
+		0, 0, 478, 134, 1, 0, 0, 0, 479, 480, 5, 99, 0, 0, 480, 481, 5, 104, 0,
+		0, 481, 482, 5, 97, 0, 0, 482, 483, 5, 110, 0, 0, 483, 136, 1, 0, 0, 0,
+		484, 485, 5, 97, 0, 0, 485, 486, 5, 115, 0, 0, 486, 487, 5, 121, 0, 0,
+		487, 488, 5, 110, 0, 0, 488, 489, 5, 99, 0, 0, 489, 138, 1, 0, 0, 0, 490,
+		491, 5, 100, 0, 0, 491, 492, 5, 101, 0, 0, 492, 493, 5, 102, 0, 0, 493,
+		494, 5, 101, 0, 0, 494, 495, 5, 114, 0, 0, 495, 140, 1, 0, 0, 0, 496, 497,
+		5, 97, 0, 0, 497, 498, 5, 119, 0, 0, 498, 499, 5, 97, 0, 0, 499, 500, 5,
+		105, 0, 0, 500, 501, 5, 116, 0, 0, 501, 142, 1, 0, 0, 0, 502, 503, 5, 70,
+		0, 0, 503, 504, 5, 117, 0, 0, 504, 505, 5, 116, 0, 0, 505, 506, 5, 117,
+		0, 0, 506, 507, 5, 114, 0, 0, 507, 508, 5, 101, 0, 0, 508, 144, 1, 0, 0,
+		0, 509, 510, 5, 121, 0, 0, 510, 511, 5, 105, 0, 0, 511, 512, 5, 101, 0,
+		0, 512, 513, 5, 108, 0, 0, 513, 514, 5, 100, 0, 0, 514, 146, 1, 0, 0, 0,
+		515, 516, 5, 102, 0, 0, 516, 517, 5, 111, 0, 0, 517, 518, 5, 114, 0, 0,
+		518, 148, 1, 0, 0, 0, 519, 520, 5, 105, 0, 0, 520, 521, 5, 110, 0, 0, 521,
+		150, 1, 0, 0, 0, 522, 523, 5, 73, 0, 0, 523, 524, 5, 116, 0, 0, 524, 525,
+		5, 101, 0, 0, 525, 526, 5, 114, 0, 0, 526, 527, 5, 97, 0, 0, 527, 528,
+		5, 116, 0, 0, 528, 529, 5, 111, 0, 0, 529, 530, 5, 114, 0, 0, 530, 152,
+		1, 0, 0, 0, 531, 532, 5, 115, 0, 0, 532, 533, 5, 116, 0, 0, 533, 534, 5,
+		101, 0, 0, 534, 535, 5, 112, 0, 0, 535, 154, 1, 0, 0, 0, 536, 556, 3, 185,
+		92, 0, 537, 538, 5, 48, 0, 0, 538, 540, 7, 0, 0, 0, 539, 541, 5, 95, 0,
+		0, 540, 539, 1, 0, 0, 0, 540, 541, 1, 0, 0, 0, 541, 542, 1, 0, 0, 0, 542,
+		556, 3, 187, 93, 0, 543, 544, 5, 48, 0, 0, 544, 546, 7, 1, 0, 0, 545, 547,
+		5, 95, 0, 0, 546, 545, 1, 0, 0, 0, 546, 547, 1, 0, 0, 0, 547, 548, 1, 0,
+		0, 0, 548, 556, 3, 189, 94, 0, 549, 550, 5, 48, 0, 0, 550, 552, 7, 2, 0,
+		0, 551, 553, 5, 95, 0, 0, 552, 551, 1, 0, 0, 0, 552, 553, 1, 0, 0, 0, 553,
+		554, 1, 0, 0, 0, 554, 556, 3, 191, 95, 0, 555, 536, 1, 0, 0, 0, 555, 537,
+		1, 0, 0, 0, 555, 543, 1, 0, 0, 0, 555, 549, 1, 0, 0, 0, 556, 156, 1, 0,
+		0, 0, 557, 558, 3, 185, 92, 0, 558, 559, 5, 46, 0, 0, 559, 561, 3, 185,
+		92, 0, 560, 562, 3, 193, 96, 0, 561, 560, 1, 0, 0, 0, 561, 562, 1, 0, 0,
+		0, 562, 567, 1, 0, 0, 0, 563, 564, 3, 185, 92, 0, 564, 565, 3, 193, 96,
+		0, 565, 567, 1, 0, 0, 0, 566, 557, 1, 0, 0, 0, 566, 563, 1, 0, 0, 0, 567,
+		158, 1, 0, 0, 0, 568, 569, 3, 155, 77, 0, 569, 570, 5, 110, 0, 0, 570,
+		160, 1, 0, 0, 0, 571, 574, 3, 185, 92, 0, 572, 573, 5, 46, 0, 0, 573, 575,
+		3, 185, 92, 0, 574, 572, 1, 0, 0, 0, 574, 575, 1, 0, 0, 0, 575, 576, 1,
+		0, 0, 0, 576, 577, 5, 109, 0, 0, 577, 162, 1, 0, 0, 0, 578, 579, 5, 116,
+		0, 0, 579, 580, 5, 114, 0, 0, 580, 581, 5, 117, 0, 0, 581, 588, 5, 101,
+		0, 0, 582, 583, 5, 102, 0, 0, 583, 584, 5, 97, 0, 0, 584, 585, 5, 108,
+		0, 0, 585, 586, 5, 115, 0, 0, 586, 588, 5, 101, 0, 0, 587, 578, 1, 0, 0,
+		0, 587, 582, 1, 0, 0, 0, 588, 164, 1, 0, 0, 0, 589, 590, 5, 110, 0, 0,
+		590, 591, 5, 105, 0, 0, 591, 592, 5, 108, 0, 0, 592, 166, 1, 0, 0, 0, 593,
+		599, 5, 34, 0, 0, 594, 598, 3, 179, 89, 0, 595, 598, 3, 195, 97, 0, 596,
+		598, 8, 3, 0, 0, 597, 594, 1, 0, 0, 0, 597, 595, 1, 0, 0, 0, 597, 596,
+		1, 0, 0, 0, 598, 601, 1, 0, 0, 0, 599, 597, 1, 0, 0, 0, 599, 600, 1, 0,
+		0, 0, 600, 602, 1, 0, 0, 0, 601, 599, 1, 0, 0, 0, 602, 613, 5, 34, 0, 0,
+		603, 608, 5, 39, 0, 0, 604, 607, 3, 179, 89, 0, 605, 607, 8, 4, 0, 0, 606,
+		604, 1, 0, 0, 0, 606, 605, 1, 0, 0, 0, 607, 610, 1, 0, 0, 0, 608, 606,
+		1, 0, 0, 0, 608, 609, 1, 0, 0, 0, 609, 611, 1, 0, 0, 0, 610, 608, 1, 0,
+		0, 0, 611, 613, 5, 39, 0, 0, 612, 593, 1, 0, 0, 0, 612, 603, 1, 0, 0, 0,
+		613, 168, 1, 0, 0, 0, 614, 615, 5, 95, 0, 0, 615, 170, 1, 0, 0, 0, 616,
+		620, 7, 5, 0, 0, 617, 619, 7, 6, 0, 0, 618, 617, 1, 0, 0, 0, 619, 622,
+		1, 0, 0, 0, 620, 618, 1, 0, 0, 0, 620, 621, 1, 0, 0, 0, 621, 172, 1, 0,
+		0, 0, 622, 620, 1, 0, 0, 0, 623, 625, 7, 7, 0, 0, 624, 623, 1, 0, 0, 0,
+		625, 626, 1, 0, 0, 0, 626, 624, 1, 0, 0, 0, 626, 627, 1, 0, 0, 0, 627,
+		628, 1, 0, 0, 0, 628, 629, 6, 86, 0, 0, 629, 174, 1, 0, 0, 0, 630, 631,
+		5, 47, 0, 0, 631, 632, 5, 47, 0, 0, 632, 636, 1, 0, 0, 0, 633, 635, 8,
+		8, 0, 0, 634, 633, 1, 0, 0, 0, 635, 638, 1, 0, 0, 0, 636, 634, 1, 0, 0,
+		0, 636, 637, 1, 0, 0, 0, 637, 640, 1, 0, 0, 0, 638, 636, 1, 0, 0, 0, 639,
+		641, 5, 13, 0, 0, 640, 639, 1, 0, 0, 0, 640, 641, 1, 0, 0, 0, 641, 642,
+		1, 0, 0, 0, 642, 643, 5, 10, 0, 0, 643, 644, 1, 0, 0, 0, 644, 645, 6, 87,
+		1, 0, 645, 176, 1, 0, 0, 0, 646, 647, 5, 47, 0, 0, 647, 648, 5, 42, 0,
+		0, 648, 652, 1, 0, 0, 0, 649, 651, 9, 0, 0, 0, 650, 649, 1, 0, 0, 0, 651,
+		654, 1, 0, 0, 0, 652, 653, 1, 0, 0, 0, 652, 650, 1, 0, 0, 0, 653, 655,
+		1, 0, 0, 0, 654, 652, 1, 0, 0, 0, 655, 656, 5, 42, 0, 0, 656, 657, 5, 47,
+		0, 0, 657, 658, 1, 0, 0, 0, 658, 659, 6, 88, 1, 0, 659, 178, 1, 0, 0, 0,
+		660, 663, 5, 92, 0, 0, 661, 664, 7, 9, 0, 0, 662, 664, 3, 181, 90, 0, 663,
+		661, 1, 0, 0, 0, 663, 662, 1, 0, 0, 0, 664, 180, 1, 0, 0, 0, 665, 666,
+		5, 117, 0, 0, 666, 667, 3, 183, 91, 0, 667, 668, 3, 183, 91, 0, 668, 669,
+		3, 183, 91, 0, 669, 670, 3, 183, 91, 0, 670, 182, 1, 0, 0, 0, 671, 672,
+		7, 10, 0, 0, 672, 184, 1, 0, 0, 0, 673, 680, 7, 11, 0, 0, 674, 676, 5,
+		95, 0, 0, 675, 674, 1, 0, 0, 0, 675, 676, 1, 0, 0, 0, 676, 677, 1, 0, 0,
+		0, 677, 679, 7, 11, 0, 0, 678, 675, 1, 0, 0, 0, 679, 682, 1, 0, 0, 0, 680,
+		678, 1, 0, 0, 0, 680, 681, 1, 0, 0, 0, 681, 186, 1, 0, 0, 0, 682, 680,
+		1, 0, 0, 0, 683, 690, 3, 183, 91, 0, 684, 686, 5, 95, 0, 0, 685, 684, 1,
+		0, 0, 0, 685, 686, 1, 0, 0, 0, 686, 687, 1, 0, 0, 0, 687, 689, 3, 183,
+		91, 0, 688, 685, 1, 0, 0, 0, 689, 692, 1, 0, 0, 0, 690, 688, 1, 0, 0, 0,
+		690, 691, 1, 0, 0, 0, 691, 188, 1, 0, 0, 0, 692, 690, 1, 0, 0, 0, 693,
+		700, 7, 12, 0, 0, 694, 696, 5, 95, 0, 0, 695, 694, 1, 0, 0, 0, 695, 696,
+		1, 0, 0, 0, 696, 697, 1, 0, 0, 0, 697, 699, 7, 12, 0, 0, 698, 695, 1, 0,
+		0, 0, 699, 702, 1, 0, 0, 0, 700, 698, 1, 0, 0, 0, 700, 701, 1, 0, 0, 0,
+		701, 190, 1, 0, 0, 0, 702, 700, 1, 0, 0, 0, 703, 710, 7, 13, 0, 0, 704,
+		706, 5, 95, 0, 0, 705, 704, 1, 0, 0, 0, 705, 706, 1, 0, 0, 0, 706, 707,
+		1, 0, 0, 0, 707, 709, 7, 13, 0, 0, 708, 705, 1, 0, 0, 0, 709, 712, 1, 0,
+		0, 0, 710, 708, 1, 0, 0, 0, 710, 711, 1, 0, 0, 0, 711, 192, 1, 0, 0, 0,
+		712, 710, 1, 0, 0, 0, 713, 715, 7, 14, 0, 0, 714, 716, 7, 15, 0, 0, 715,
+		714, 1, 0, 0, 0, 715, 716, 1, 0, 0, 0, 716, 717, 1, 0, 0, 0, 717, 718,
+		3, 185, 92, 0, 718, 194, 1, 0, 0, 0, 719, 720, 5, 36, 0, 0, 720, 721, 5,
+		123, 0, 0, 721, 725, 1, 0, 0, 0, 722, 724, 3, 197, 98, 0, 723, 722, 1,
+		0, 0, 0, 724, 727, 1, 0, 0, 0, 725, 723, 1, 0, 0, 0, 725, 726, 1, 0, 0,
+		0, 726, 728, 1, 0, 0, 0, 727, 725, 1, 0, 0, 0, 728, 729, 5, 125, 0, 0,
+		729, 196, 1, 0, 0, 0, 730, 741, 3, 167, 83, 0, 731, 735, 5, 123, 0, 0,
+		732, 734, 3, 197, 98, 0, 733, 732, 1, 0, 0, 0, 734, 737, 1, 0, 0, 0, 735,
+		733, 1, 0, 0, 0, 735, 736, 1, 0, 0, 0, 736, 738, 1, 0, 0, 0, 737, 735,
+		1, 0, 0, 0, 738, 741, 5, 125, 0, 0, 739, 741, 8, 16, 0, 0, 740, 730, 1,
+		0, 0, 0, 740, 731, 1, 0, 0, 0, 740, 739, 1, 0, 0, 0, 741, 198, 1, 0, 0,
+		0, 742, 745, 3, 155, 77, 0, 743, 745, 3, 157, 78, 0, 744, 742, 1, 0, 0,
+		0, 744, 743, 1, 0, 0, 0, 745, 200, 1, 0, 0, 0, 33, 0, 540, 546, 552, 555,
+		561, 566, 574, 587, 597, 599, 606, 608, 612, 620, 626, 636, 640, 652, 663,
+		675, 680, 685, 690, 695, 700, 705, 710, 715, 725, 735, 740, 744, 2, 6,
+		0, 0, 0, 1, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -199,28 +457,93 @@ func NewBoLexer(input antlr.CharStream) *BoLexer {
 
 // BoLexer tokens.
 const (
-	BoLexerT__0      = 1
-	BoLexerT__1      = 2
-	BoLexerT__2      = 3
-	BoLexerT__3      = 4
-	BoLexerT__4      = 5
-	BoLexerLT        = 6
-	BoLexerGT        = 7
-	BoLexerASSIGN    = 8
-	BoLexerLPAREN    = 9
-	BoLexerRPAREN    = 10
-	BoLexerLBRACE    = 11
-	BoLexerRBRACE    = 12
-	BoLexerPERIOD    = 13
-	BoLexerCOMMA     = 14
-	BoLexerSEMICOLON = 15
-	BoLexerREQUIRE   = 16
-	BoLexerINT       = 17
-	BoLexerFLOAT     = 18
-	BoLexerBOOL      = 19
-	BoLexerSTRING    = 20
-	BoLexerID        = 21
-	BoLexerWS        = 22
-	BoLexerS_COMMENT = 23
-	BoLexerM_COMMENT = 24
+	BoLexerT__0        = 1
+	BoLexerT__1        = 2
+	BoLexerT__2        = 3
+	BoLexerT__3        = 4
+	BoLexerT__4        = 5
+	BoLexerT__5        = 6
+	BoLexerT__6        = 7
+	BoLexerT__7        = 8
+	BoLexerT__8        = 9
+	BoLexerT__9        = 10
+	BoLexerT__10       = 11
+	BoLexerT__11       = 12
+	BoLexerT__12       = 13
+	BoLexerT__13       = 14
+	BoLexerT__14       = 15
+	BoLexerT__15       = 16
+	BoLexerT__16       = 17
+	BoLexerT__17       = 18
+	BoLexerLE          = 19
+	BoLexerGE          = 20
+	BoLexerEQ          = 21
+	BoLexerNE          = 22
+	BoLexerLT          = 23
+	BoLexerGT          = 24
+	BoLexerASSIGN      = 25
+	BoLexerARROW       = 26
+	BoLexerRECEIVE     = 27
+	BoLexerADD         = 28
+	BoLexerSUB         = 29
+	BoLexerMUL         = 30
+	BoLexerDIV         = 31
+	BoLexerMOD         = 32
+	BoLexerAND         = 33
+	BoLexerOR          = 34
+	BoLexerNOT         = 35
+	BoLexerADD_WRAP    = 36
+	BoLexerSUB_WRAP    = 37
+	BoLexerMUL_WRAP    = 38
+	BoLexerLPAREN      = 39
+	BoLexerRPAREN      = 40
+	BoLexerLBRACE      = 41
+	BoLexerRBRACE      = 42
+	BoLexerLBRACK      = 43
+	BoLexerRBRACK      = 44
+	BoLexerCOLON       = 45
+	BoLexerELLIPSIS    = 46
+	BoLexerRANGE_EXCL  = 47
+	BoLexerRANGE       = 48
+	BoLexerPERIOD      = 49
+	BoLexerCOMMA       = 50
+	BoLexerSEMICOLON   = 51
+	BoLexerQUESTION    = 52
+	BoLexerSAFE_PERIOD = 53
+	BoLexerCOALESCE    = 54
+	BoLexerREQUIRE     = 55
+	BoLexerENUM        = 56
+	BoLexerMATCH       = 57
+	BoLexerSWITCH      = 58
+	BoLexerCASE        = 59
+	BoLexerIF          = 60
+	BoLexerSTRUCT      = 61
+	BoLexerMAP         = 62
+	BoLexerFUNC        = 63
+	BoLexerRETURN      = 64
+	BoLexerSPAWN       = 65
+	BoLexerSELECT      = 66
+	BoLexerDEFAULT     = 67
+	BoLexerCHAN        = 68
+	BoLexerASYNC       = 69
+	BoLexerDEFER       = 70
+	BoLexerAWAIT       = 71
+	BoLexerFUTURE      = 72
+	BoLexerYIELD       = 73
+	BoLexerFOR         = 74
+	BoLexerIN          = 75
+	BoLexerITERATOR    = 76
+	BoLexerSTEP        = 77
+	BoLexerINT         = 78
+	BoLexerFLOAT       = 79
+	BoLexerBIGINT      = 80
+	BoLexerDECIMAL     = 81
+	BoLexerBOOL        = 82
+	BoLexerNIL         = 83
+	BoLexerSTRING      = 84
+	BoLexerUNDERSCORE  = 85
+	BoLexerID          = 86
+	BoLexerWS          = 87
+	BoLexerS_COMMENT   = 88
+	BoLexerM_COMMENT   = 89
 )
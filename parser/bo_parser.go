@@ -32,51 +32,371 @@ var BoParserStaticData struct {
 func boParserInit() {
 	staticData := &BoParserStaticData
 	staticData.LiteralNames = []string{
-		"", "'int'", "'float'", "'string'", "'bool'", "'/'", "'<'", "'>'", "'='",
-		"'('", "')'", "'{'", "'}'", "'.'", "','", "';'", "'require'",
+		"", "'string'", "'int'", "'int8'", "'int16'", "'int32'", "'int64'", "'uint8'",
+		"'uint16'", "'uint32'", "'uint64'", "'float'", "'float32'", "'bigint'",
+		"'decimal'", "'byte'", "'char'", "'rune'", "'bool'", "'<='", "'>='",
+		"'=='", "'!='", "'<'", "'>'", "'='", "'=>'", "'<-'", "'+'", "'-'", "'*'",
+		"'/'", "'%'", "'&&'", "'||'", "'!'", "'+%'", "'-%'", "'*%'", "'('", "')'",
+		"'{'", "'}'", "'['", "']'", "':'", "'...'", "'..<'", "'..'", "'.'", "','",
+		"';'", "'?'", "'?.'", "'??'", "'require'", "'enum'", "'match'", "'switch'",
+		"'case'", "'if'", "'struct'", "'map'", "'func'", "'return'", "'spawn'",
+		"'select'", "'default'", "'chan'", "'async'", "'defer'", "'await'", "'Future'",
+		"'yield'", "'for'", "'in'", "'Iterator'", "'step'", "", "", "", "", "",
+		"'nil'", "", "'_'",
 	}
 	staticData.SymbolicNames = []string{
-		"", "", "", "", "", "", "LT", "GT", "ASSIGN", "LPAREN", "RPAREN", "LBRACE",
-		"RBRACE", "PERIOD", "COMMA", "SEMICOLON", "REQUIRE", "INT", "FLOAT",
-		"BOOL", "STRING", "ID", "WS", "S_COMMENT", "M_COMMENT",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "LE", "GE", "EQ", "NE", "LT", "GT", "ASSIGN", "ARROW", "RECEIVE",
+		"ADD", "SUB", "MUL", "DIV", "MOD", "AND", "OR", "NOT", "ADD_WRAP", "SUB_WRAP",
+		"MUL_WRAP", "LPAREN", "RPAREN", "LBRACE", "RBRACE", "LBRACK", "RBRACK",
+		"COLON", "ELLIPSIS", "RANGE_EXCL", "RANGE", "PERIOD", "COMMA", "SEMICOLON",
+		"QUESTION", "SAFE_PERIOD", "COALESCE", "REQUIRE", "ENUM", "MATCH", "SWITCH",
+		"CASE", "IF", "STRUCT", "MAP", "FUNC", "RETURN", "SPAWN", "SELECT", "DEFAULT",
+		"CHAN", "ASYNC", "DEFER", "AWAIT", "FUTURE", "YIELD", "FOR", "IN", "ITERATOR",
+		"STEP", "INT", "FLOAT", "BIGINT", "DECIMAL", "BOOL", "NIL", "STRING",
+		"UNDERSCORE", "ID", "WS", "S_COMMENT", "M_COMMENT",
 	}
 	staticData.RuleNames = []string{
-		"program", "statement", "expression", "functionParameters", "functionCall",
-		"variableDeclaration", "typeSpec", "requireStatement", "importPath",
+		"program", "statement", "block", "expression", "primary", "listLiteral",
+		"mapLiteral", "mapEntry", "tupleLiteral", "structLiteral", "fieldValue",
+		"embeddedExpression", "functionParameters", "argument", "functionCall",
+		"functionDeclaration", "operator", "receiver", "parameter", "returnStatement",
+		"yieldStatement", "forStatement", "deferStatement", "enumDeclaration",
+		"enumCase", "structDeclaration", "structField", "matchArm", "switchStatement",
+		"switchArm", "guard", "spawnStatement", "awaitStatement", "sendStatement",
+		"selectStatement", "selectArm", "pattern", "entryPattern", "fieldPattern",
+		"variableDeclaration", "destructuringDeclaration", "typeSpec", "typeName",
+		"listType", "mapType", "chanType", "futureType", "iteratorType", "tupleType",
+		"basicType", "requireStatement", "importPath",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 24, 79, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
-		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 1, 0, 5, 0, 20, 8, 0,
-		10, 0, 12, 0, 23, 9, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 3, 1, 30, 8, 1, 1,
-		2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 5, 3, 38, 8, 3, 10, 3, 12, 3, 41, 9, 3,
-		3, 3, 43, 8, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 3,
-		4, 54, 8, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7,
-		1, 8, 1, 8, 1, 8, 1, 8, 5, 8, 70, 8, 8, 10, 8, 12, 8, 73, 9, 8, 1, 8, 1,
-		8, 3, 8, 77, 8, 8, 1, 8, 0, 0, 9, 0, 2, 4, 6, 8, 10, 12, 14, 16, 0, 2,
-		1, 0, 17, 21, 1, 0, 1, 4, 77, 0, 21, 1, 0, 0, 0, 2, 29, 1, 0, 0, 0, 4,
-		31, 1, 0, 0, 0, 6, 33, 1, 0, 0, 0, 8, 53, 1, 0, 0, 0, 10, 55, 1, 0, 0,
-		0, 12, 60, 1, 0, 0, 0, 14, 62, 1, 0, 0, 0, 16, 76, 1, 0, 0, 0, 18, 20,
-		3, 2, 1, 0, 19, 18, 1, 0, 0, 0, 20, 23, 1, 0, 0, 0, 21, 19, 1, 0, 0, 0,
-		21, 22, 1, 0, 0, 0, 22, 24, 1, 0, 0, 0, 23, 21, 1, 0, 0, 0, 24, 25, 5,
-		0, 0, 1, 25, 1, 1, 0, 0, 0, 26, 30, 3, 14, 7, 0, 27, 30, 3, 10, 5, 0, 28,
-		30, 3, 8, 4, 0, 29, 26, 1, 0, 0, 0, 29, 27, 1, 0, 0, 0, 29, 28, 1, 0, 0,
-		0, 30, 3, 1, 0, 0, 0, 31, 32, 7, 0, 0, 0, 32, 5, 1, 0, 0, 0, 33, 42, 5,
-		9, 0, 0, 34, 39, 3, 4, 2, 0, 35, 36, 5, 14, 0, 0, 36, 38, 3, 4, 2, 0, 37,
-		35, 1, 0, 0, 0, 38, 41, 1, 0, 0, 0, 39, 37, 1, 0, 0, 0, 39, 40, 1, 0, 0,
-		0, 40, 43, 1, 0, 0, 0, 41, 39, 1, 0, 0, 0, 42, 34, 1, 0, 0, 0, 42, 43,
-		1, 0, 0, 0, 43, 44, 1, 0, 0, 0, 44, 45, 5, 10, 0, 0, 45, 7, 1, 0, 0, 0,
-		46, 47, 5, 21, 0, 0, 47, 54, 3, 6, 3, 0, 48, 49, 3, 4, 2, 0, 49, 50, 5,
-		13, 0, 0, 50, 51, 5, 21, 0, 0, 51, 52, 3, 6, 3, 0, 52, 54, 1, 0, 0, 0,
-		53, 46, 1, 0, 0, 0, 53, 48, 1, 0, 0, 0, 54, 9, 1, 0, 0, 0, 55, 56, 3, 12,
-		6, 0, 56, 57, 5, 21, 0, 0, 57, 58, 5, 8, 0, 0, 58, 59, 3, 4, 2, 0, 59,
-		11, 1, 0, 0, 0, 60, 61, 7, 1, 0, 0, 61, 13, 1, 0, 0, 0, 62, 63, 5, 16,
-		0, 0, 63, 64, 3, 16, 8, 0, 64, 15, 1, 0, 0, 0, 65, 66, 5, 6, 0, 0, 66,
-		71, 5, 21, 0, 0, 67, 68, 5, 5, 0, 0, 68, 70, 5, 21, 0, 0, 69, 67, 1, 0,
-		0, 0, 70, 73, 1, 0, 0, 0, 71, 69, 1, 0, 0, 0, 71, 72, 1, 0, 0, 0, 72, 74,
-		1, 0, 0, 0, 73, 71, 1, 0, 0, 0, 74, 77, 5, 7, 0, 0, 75, 77, 5, 20, 0, 0,
-		76, 65, 1, 0, 0, 0, 76, 75, 1, 0, 0, 0, 77, 17, 1, 0, 0, 0, 7, 21, 29,
-		39, 42, 53, 71, 76,
+		4, 1, 89, 706, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
+		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
+		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
+		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
+		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
+		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
+		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2,
+		42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47,
+		7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 1, 0, 5,
+		0, 106, 8, 0, 10, 0, 12, 0, 109, 9, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 3, 1, 129, 8, 1, 1, 2, 1, 2, 5, 2, 133, 8, 2, 10, 2, 12, 2, 136, 9,
+		2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 5, 3, 148,
+		8, 3, 10, 3, 12, 3, 151, 9, 3, 1, 3, 3, 3, 154, 8, 3, 1, 3, 1, 3, 1, 3,
+		1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 169,
+		8, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
+		1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
+		1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
+		1, 3, 1, 3, 5, 3, 208, 8, 3, 10, 3, 12, 3, 211, 9, 3, 1, 4, 1, 4, 1, 4,
+		1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4,
+		1, 4, 1, 4, 3, 4, 230, 8, 4, 1, 5, 1, 5, 1, 5, 1, 5, 5, 5, 236, 8, 5, 10,
+		5, 12, 5, 239, 9, 5, 1, 5, 3, 5, 242, 8, 5, 3, 5, 244, 8, 5, 1, 5, 1, 5,
+		1, 6, 1, 6, 1, 6, 1, 6, 5, 6, 252, 8, 6, 10, 6, 12, 6, 255, 9, 6, 1, 6,
+		3, 6, 258, 8, 6, 3, 6, 260, 8, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1,
+		8, 1, 8, 1, 8, 1, 8, 4, 8, 272, 8, 8, 11, 8, 12, 8, 273, 1, 8, 1, 8, 1,
+		9, 1, 9, 1, 9, 1, 9, 1, 9, 5, 9, 283, 8, 9, 10, 9, 12, 9, 286, 9, 9, 1,
+		9, 3, 9, 289, 8, 9, 3, 9, 291, 8, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1,
+		10, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 5, 12, 306, 8, 12,
+		10, 12, 12, 12, 309, 9, 12, 3, 12, 311, 8, 12, 1, 12, 1, 12, 1, 13, 1,
+		13, 3, 13, 317, 8, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14,
+		1, 14, 1, 14, 3, 14, 328, 8, 14, 1, 15, 3, 15, 331, 8, 15, 1, 15, 1, 15,
+		1, 15, 1, 15, 1, 15, 3, 15, 338, 8, 15, 1, 15, 1, 15, 3, 15, 342, 8, 15,
+		1, 15, 1, 15, 1, 15, 1, 15, 5, 15, 348, 8, 15, 10, 15, 12, 15, 351, 9,
+		15, 3, 15, 353, 8, 15, 1, 15, 1, 15, 3, 15, 357, 8, 15, 1, 15, 1, 15, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16,
+		371, 8, 16, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 3, 18, 380,
+		8, 18, 1, 18, 1, 18, 1, 18, 1, 18, 3, 18, 386, 8, 18, 1, 19, 1, 19, 1,
+		19, 1, 19, 5, 19, 392, 8, 19, 10, 19, 12, 19, 395, 9, 19, 3, 19, 397, 8,
+		19, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22,
+		1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 5, 23, 417, 8,
+		23, 10, 23, 12, 23, 420, 9, 23, 1, 23, 3, 23, 423, 8, 23, 1, 23, 1, 23,
+		1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 5, 24, 432, 8, 24, 10, 24, 12, 24, 435,
+		9, 24, 1, 24, 1, 24, 3, 24, 439, 8, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1,
+		25, 3, 25, 446, 8, 25, 5, 25, 448, 8, 25, 10, 25, 12, 25, 451, 9, 25, 1,
+		25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 3, 27, 460, 8, 27, 1, 27,
+		1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 5, 28, 469, 8, 28, 10, 28, 12,
+		28, 472, 9, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 3, 29, 479, 8, 29, 1,
+		29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32,
+		1, 33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 5, 34, 499, 8, 34, 10,
+		34, 12, 34, 502, 9, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 3, 35, 509,
+		8, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1,
+		35, 1, 35, 1, 35, 3, 35, 523, 8, 35, 1, 36, 1, 36, 3, 36, 527, 8, 36, 1,
+		36, 1, 36, 1, 36, 1, 36, 3, 36, 533, 8, 36, 1, 36, 3, 36, 536, 8, 36, 1,
+		36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 5, 36, 544, 8, 36, 10, 36, 12, 36,
+		547, 9, 36, 3, 36, 549, 8, 36, 1, 36, 3, 36, 552, 8, 36, 1, 36, 1, 36,
+		1, 36, 1, 36, 5, 36, 558, 8, 36, 10, 36, 12, 36, 561, 9, 36, 3, 36, 563,
+		8, 36, 1, 36, 1, 36, 1, 36, 3, 36, 568, 8, 36, 1, 36, 1, 36, 1, 36, 1,
+		36, 5, 36, 574, 8, 36, 10, 36, 12, 36, 577, 9, 36, 3, 36, 579, 8, 36, 1,
+		36, 1, 36, 1, 36, 1, 36, 1, 36, 4, 36, 586, 8, 36, 11, 36, 12, 36, 587,
+		1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 5, 36, 597, 8, 36, 10,
+		36, 12, 36, 600, 9, 36, 3, 36, 602, 8, 36, 1, 36, 1, 36, 3, 36, 606, 8,
+		36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 3, 38, 615, 8, 38,
+		1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 5, 39, 623, 8, 39, 10, 39, 12,
+		39, 626, 9, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41,
+		1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 3, 41, 643, 8, 41, 1,
+		41, 3, 41, 646, 8, 41, 1, 42, 1, 42, 1, 42, 3, 42, 651, 8, 42, 1, 43, 1,
+		43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45,
+		1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1,
+		47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 4, 48, 682, 8, 48, 11, 48,
+		12, 48, 683, 1, 48, 1, 48, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 51, 1,
+		51, 1, 51, 1, 51, 5, 51, 697, 8, 51, 10, 51, 12, 51, 700, 9, 51, 1, 51,
+		1, 51, 3, 51, 704, 8, 51, 1, 51, 0, 1, 6, 52, 0, 2, 4, 6, 8, 10, 12, 14,
+		16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50,
+		52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86,
+		88, 90, 92, 94, 96, 98, 100, 102, 0, 9, 2, 0, 29, 29, 35, 35, 2, 0, 30,
+		32, 38, 38, 2, 0, 28, 29, 36, 37, 1, 0, 47, 48, 2, 0, 19, 20, 23, 24, 1,
+		0, 21, 22, 2, 0, 49, 49, 53, 53, 1, 0, 78, 81, 1, 0, 1, 18, 785, 0, 107,
+		1, 0, 0, 0, 2, 128, 1, 0, 0, 0, 4, 130, 1, 0, 0, 0, 6, 168, 1, 0, 0, 0,
+		8, 229, 1, 0, 0, 0, 10, 231, 1, 0, 0, 0, 12, 247, 1, 0, 0, 0, 14, 263,
+		1, 0, 0, 0, 16, 267, 1, 0, 0, 0, 18, 277, 1, 0, 0, 0, 20, 294, 1, 0, 0,
+		0, 22, 298, 1, 0, 0, 0, 24, 301, 1, 0, 0, 0, 26, 316, 1, 0, 0, 0, 28, 327,
+		1, 0, 0, 0, 30, 330, 1, 0, 0, 0, 32, 370, 1, 0, 0, 0, 34, 372, 1, 0, 0,
+		0, 36, 385, 1, 0, 0, 0, 38, 387, 1, 0, 0, 0, 40, 398, 1, 0, 0, 0, 42, 401,
+		1, 0, 0, 0, 44, 407, 1, 0, 0, 0, 46, 410, 1, 0, 0, 0, 48, 426, 1, 0, 0,
+		0, 50, 440, 1, 0, 0, 0, 52, 454, 1, 0, 0, 0, 54, 457, 1, 0, 0, 0, 56, 464,
+		1, 0, 0, 0, 58, 475, 1, 0, 0, 0, 60, 482, 1, 0, 0, 0, 62, 485, 1, 0, 0,
+		0, 64, 488, 1, 0, 0, 0, 66, 491, 1, 0, 0, 0, 68, 495, 1, 0, 0, 0, 70, 522,
+		1, 0, 0, 0, 72, 605, 1, 0, 0, 0, 74, 607, 1, 0, 0, 0, 76, 611, 1, 0, 0,
+		0, 78, 616, 1, 0, 0, 0, 80, 630, 1, 0, 0, 0, 82, 642, 1, 0, 0, 0, 84, 647,
+		1, 0, 0, 0, 86, 652, 1, 0, 0, 0, 88, 656, 1, 0, 0, 0, 90, 662, 1, 0, 0,
+		0, 92, 667, 1, 0, 0, 0, 94, 672, 1, 0, 0, 0, 96, 677, 1, 0, 0, 0, 98, 687,
+		1, 0, 0, 0, 100, 689, 1, 0, 0, 0, 102, 703, 1, 0, 0, 0, 104, 106, 3, 2,
+		1, 0, 105, 104, 1, 0, 0, 0, 106, 109, 1, 0, 0, 0, 107, 105, 1, 0, 0, 0,
+		107, 108, 1, 0, 0, 0, 108, 110, 1, 0, 0, 0, 109, 107, 1, 0, 0, 0, 110,
+		111, 5, 0, 0, 1, 111, 1, 1, 0, 0, 0, 112, 129, 3, 100, 50, 0, 113, 129,
+		3, 46, 23, 0, 114, 129, 3, 50, 25, 0, 115, 129, 3, 30, 15, 0, 116, 129,
+		3, 38, 19, 0, 117, 129, 3, 44, 22, 0, 118, 129, 3, 40, 20, 0, 119, 129,
+		3, 42, 21, 0, 120, 129, 3, 78, 39, 0, 121, 129, 3, 80, 40, 0, 122, 129,
+		3, 56, 28, 0, 123, 129, 3, 62, 31, 0, 124, 129, 3, 66, 33, 0, 125, 129,
+		3, 68, 34, 0, 126, 129, 3, 64, 32, 0, 127, 129, 3, 28, 14, 0, 128, 112,
+		1, 0, 0, 0, 128, 113, 1, 0, 0, 0, 128, 114, 1, 0, 0, 0, 128, 115, 1, 0,
+		0, 0, 128, 116, 1, 0, 0, 0, 128, 117, 1, 0, 0, 0, 128, 118, 1, 0, 0, 0,
+		128, 119, 1, 0, 0, 0, 128, 120, 1, 0, 0, 0, 128, 121, 1, 0, 0, 0, 128,
+		122, 1, 0, 0, 0, 128, 123, 1, 0, 0, 0, 128, 124, 1, 0, 0, 0, 128, 125,
+		1, 0, 0, 0, 128, 126, 1, 0, 0, 0, 128, 127, 1, 0, 0, 0, 129, 3, 1, 0, 0,
+		0, 130, 134, 5, 41, 0, 0, 131, 133, 3, 2, 1, 0, 132, 131, 1, 0, 0, 0, 133,
+		136, 1, 0, 0, 0, 134, 132, 1, 0, 0, 0, 134, 135, 1, 0, 0, 0, 135, 137,
+		1, 0, 0, 0, 136, 134, 1, 0, 0, 0, 137, 138, 5, 42, 0, 0, 138, 5, 1, 0,
+		0, 0, 139, 140, 6, 3, -1, 0, 140, 169, 3, 8, 4, 0, 141, 142, 5, 57, 0,
+		0, 142, 143, 3, 6, 3, 0, 143, 144, 5, 41, 0, 0, 144, 149, 3, 54, 27, 0,
+		145, 146, 5, 50, 0, 0, 146, 148, 3, 54, 27, 0, 147, 145, 1, 0, 0, 0, 148,
+		151, 1, 0, 0, 0, 149, 147, 1, 0, 0, 0, 149, 150, 1, 0, 0, 0, 150, 153,
+		1, 0, 0, 0, 151, 149, 1, 0, 0, 0, 152, 154, 5, 50, 0, 0, 153, 152, 1, 0,
+		0, 0, 153, 154, 1, 0, 0, 0, 154, 155, 1, 0, 0, 0, 155, 156, 5, 42, 0, 0,
+		156, 169, 1, 0, 0, 0, 157, 158, 3, 82, 41, 0, 158, 159, 5, 39, 0, 0, 159,
+		160, 3, 6, 3, 0, 160, 161, 5, 40, 0, 0, 161, 169, 1, 0, 0, 0, 162, 163,
+		7, 0, 0, 0, 163, 169, 3, 6, 3, 12, 164, 165, 5, 27, 0, 0, 165, 169, 3,
+		6, 3, 11, 166, 167, 5, 71, 0, 0, 167, 169, 3, 6, 3, 10, 168, 139, 1, 0,
+		0, 0, 168, 141, 1, 0, 0, 0, 168, 157, 1, 0, 0, 0, 168, 162, 1, 0, 0, 0,
+		168, 164, 1, 0, 0, 0, 168, 166, 1, 0, 0, 0, 169, 209, 1, 0, 0, 0, 170,
+		171, 10, 9, 0, 0, 171, 172, 7, 1, 0, 0, 172, 208, 3, 6, 3, 10, 173, 174,
+		10, 8, 0, 0, 174, 175, 7, 2, 0, 0, 175, 208, 3, 6, 3, 9, 176, 177, 10,
+		7, 0, 0, 177, 178, 7, 3, 0, 0, 178, 208, 3, 6, 3, 8, 179, 180, 10, 6, 0,
+		0, 180, 181, 5, 77, 0, 0, 181, 208, 3, 6, 3, 7, 182, 183, 10, 5, 0, 0,
+		183, 184, 7, 4, 0, 0, 184, 208, 3, 6, 3, 6, 185, 186, 10, 4, 0, 0, 186,
+		187, 7, 5, 0, 0, 187, 208, 3, 6, 3, 5, 188, 189, 10, 3, 0, 0, 189, 190,
+		5, 33, 0, 0, 190, 208, 3, 6, 3, 4, 191, 192, 10, 2, 0, 0, 192, 193, 5,
+		34, 0, 0, 193, 208, 3, 6, 3, 3, 194, 195, 10, 1, 0, 0, 195, 196, 5, 54,
+		0, 0, 196, 208, 3, 6, 3, 2, 197, 198, 10, 15, 0, 0, 198, 199, 7, 6, 0,
+		0, 199, 208, 5, 86, 0, 0, 200, 201, 10, 14, 0, 0, 201, 208, 3, 24, 12,
+		0, 202, 203, 10, 13, 0, 0, 203, 204, 5, 43, 0, 0, 204, 205, 3, 6, 3, 0,
+		205, 206, 5, 44, 0, 0, 206, 208, 1, 0, 0, 0, 207, 170, 1, 0, 0, 0, 207,
+		173, 1, 0, 0, 0, 207, 176, 1, 0, 0, 0, 207, 179, 1, 0, 0, 0, 207, 182,
+		1, 0, 0, 0, 207, 185, 1, 0, 0, 0, 207, 188, 1, 0, 0, 0, 207, 191, 1, 0,
+		0, 0, 207, 194, 1, 0, 0, 0, 207, 197, 1, 0, 0, 0, 207, 200, 1, 0, 0, 0,
+		207, 202, 1, 0, 0, 0, 208, 211, 1, 0, 0, 0, 209, 207, 1, 0, 0, 0, 209,
+		210, 1, 0, 0, 0, 210, 7, 1, 0, 0, 0, 211, 209, 1, 0, 0, 0, 212, 230, 5,
+		78, 0, 0, 213, 230, 5, 79, 0, 0, 214, 230, 5, 80, 0, 0, 215, 230, 5, 81,
+		0, 0, 216, 230, 5, 84, 0, 0, 217, 230, 5, 82, 0, 0, 218, 230, 5, 83, 0,
+		0, 219, 230, 5, 86, 0, 0, 220, 221, 5, 39, 0, 0, 221, 222, 3, 6, 3, 0,
+		222, 223, 5, 40, 0, 0, 223, 230, 1, 0, 0, 0, 224, 230, 5, 72, 0, 0, 225,
+		230, 3, 10, 5, 0, 226, 230, 3, 12, 6, 0, 227, 230, 3, 16, 8, 0, 228, 230,
+		3, 18, 9, 0, 229, 212, 1, 0, 0, 0, 229, 213, 1, 0, 0, 0, 229, 214, 1, 0,
+		0, 0, 229, 215, 1, 0, 0, 0, 229, 216, 1, 0, 0, 0, 229, 217, 1, 0, 0, 0,
+		229, 218, 1, 0, 0, 0, 229, 219, 1, 0, 0, 0, 229, 220, 1, 0, 0, 0, 229,
+		224, 1, 0, 0, 0, 229, 225, 1, 0, 0, 0, 229, 226, 1, 0, 0, 0, 229, 227,
+		1, 0, 0, 0, 229, 228, 1, 0, 0, 0, 230, 9, 1, 0, 0, 0, 231, 243, 5, 43,
+		0, 0, 232, 237, 3, 6, 3, 0, 233, 234, 5, 50, 0, 0, 234, 236, 3, 6, 3, 0,
+		235, 233, 1, 0, 0, 0, 236, 239, 1, 0, 0, 0, 237, 235, 1, 0, 0, 0, 237,
+		238, 1, 0, 0, 0, 238, 241, 1, 0, 0, 0, 239, 237, 1, 0, 0, 0, 240, 242,
+		5, 50, 0, 0, 241, 240, 1, 0, 0, 0, 241, 242, 1, 0, 0, 0, 242, 244, 1, 0,
+		0, 0, 243, 232, 1, 0, 0, 0, 243, 244, 1, 0, 0, 0, 244, 245, 1, 0, 0, 0,
+		245, 246, 5, 44, 0, 0, 246, 11, 1, 0, 0, 0, 247, 259, 5, 41, 0, 0, 248,
+		253, 3, 14, 7, 0, 249, 250, 5, 50, 0, 0, 250, 252, 3, 14, 7, 0, 251, 249,
+		1, 0, 0, 0, 252, 255, 1, 0, 0, 0, 253, 251, 1, 0, 0, 0, 253, 254, 1, 0,
+		0, 0, 254, 257, 1, 0, 0, 0, 255, 253, 1, 0, 0, 0, 256, 258, 5, 50, 0, 0,
+		257, 256, 1, 0, 0, 0, 257, 258, 1, 0, 0, 0, 258, 260, 1, 0, 0, 0, 259,
+		248, 1, 0, 0, 0, 259, 260, 1, 0, 0, 0, 260, 261, 1, 0, 0, 0, 261, 262,
+		5, 42, 0, 0, 262, 13, 1, 0, 0, 0, 263, 264, 3, 6, 3, 0, 264, 265, 5, 45,
+		0, 0, 265, 266, 3, 6, 3, 0, 266, 15, 1, 0, 0, 0, 267, 268, 5, 39, 0, 0,
+		268, 271, 3, 6, 3, 0, 269, 270, 5, 50, 0, 0, 270, 272, 3, 6, 3, 0, 271,
+		269, 1, 0, 0, 0, 272, 273, 1, 0, 0, 0, 273, 271, 1, 0, 0, 0, 273, 274,
+		1, 0, 0, 0, 274, 275, 1, 0, 0, 0, 275, 276, 5, 40, 0, 0, 276, 17, 1, 0,
+		0, 0, 277, 278, 5, 86, 0, 0, 278, 290, 5, 41, 0, 0, 279, 284, 3, 20, 10,
+		0, 280, 281, 5, 50, 0, 0, 281, 283, 3, 20, 10, 0, 282, 280, 1, 0, 0, 0,
+		283, 286, 1, 0, 0, 0, 284, 282, 1, 0, 0, 0, 284, 285, 1, 0, 0, 0, 285,
+		288, 1, 0, 0, 0, 286, 284, 1, 0, 0, 0, 287, 289, 5, 50, 0, 0, 288, 287,
+		1, 0, 0, 0, 288, 289, 1, 0, 0, 0, 289, 291, 1, 0, 0, 0, 290, 279, 1, 0,
+		0, 0, 290, 291, 1, 0, 0, 0, 291, 292, 1, 0, 0, 0, 292, 293, 5, 42, 0, 0,
+		293, 19, 1, 0, 0, 0, 294, 295, 5, 86, 0, 0, 295, 296, 5, 45, 0, 0, 296,
+		297, 3, 6, 3, 0, 297, 21, 1, 0, 0, 0, 298, 299, 3, 6, 3, 0, 299, 300, 5,
+		0, 0, 1, 300, 23, 1, 0, 0, 0, 301, 310, 5, 39, 0, 0, 302, 307, 3, 26, 13,
+		0, 303, 304, 5, 50, 0, 0, 304, 306, 3, 26, 13, 0, 305, 303, 1, 0, 0, 0,
+		306, 309, 1, 0, 0, 0, 307, 305, 1, 0, 0, 0, 307, 308, 1, 0, 0, 0, 308,
+		311, 1, 0, 0, 0, 309, 307, 1, 0, 0, 0, 310, 302, 1, 0, 0, 0, 310, 311,
+		1, 0, 0, 0, 311, 312, 1, 0, 0, 0, 312, 313, 5, 40, 0, 0, 313, 25, 1, 0,
+		0, 0, 314, 315, 5, 86, 0, 0, 315, 317, 5, 45, 0, 0, 316, 314, 1, 0, 0,
+		0, 316, 317, 1, 0, 0, 0, 317, 318, 1, 0, 0, 0, 318, 319, 3, 6, 3, 0, 319,
+		27, 1, 0, 0, 0, 320, 321, 5, 86, 0, 0, 321, 328, 3, 24, 12, 0, 322, 323,
+		3, 6, 3, 0, 323, 324, 7, 6, 0, 0, 324, 325, 5, 86, 0, 0, 325, 326, 3, 24,
+		12, 0, 326, 328, 1, 0, 0, 0, 327, 320, 1, 0, 0, 0, 327, 322, 1, 0, 0, 0,
+		328, 29, 1, 0, 0, 0, 329, 331, 5, 69, 0, 0, 330, 329, 1, 0, 0, 0, 330,
+		331, 1, 0, 0, 0, 331, 332, 1, 0, 0, 0, 332, 337, 5, 63, 0, 0, 333, 334,
+		5, 39, 0, 0, 334, 335, 3, 34, 17, 0, 335, 336, 5, 40, 0, 0, 336, 338, 1,
+		0, 0, 0, 337, 333, 1, 0, 0, 0, 337, 338, 1, 0, 0, 0, 338, 341, 1, 0, 0,
+		0, 339, 342, 5, 86, 0, 0, 340, 342, 3, 32, 16, 0, 341, 339, 1, 0, 0, 0,
+		341, 340, 1, 0, 0, 0, 342, 343, 1, 0, 0, 0, 343, 352, 5, 39, 0, 0, 344,
+		349, 3, 36, 18, 0, 345, 346, 5, 50, 0, 0, 346, 348, 3, 36, 18, 0, 347,
+		345, 1, 0, 0, 0, 348, 351, 1, 0, 0, 0, 349, 347, 1, 0, 0, 0, 349, 350,
+		1, 0, 0, 0, 350, 353, 1, 0, 0, 0, 351, 349, 1, 0, 0, 0, 352, 344, 1, 0,
+		0, 0, 352, 353, 1, 0, 0, 0, 353, 354, 1, 0, 0, 0, 354, 356, 5, 40, 0, 0,
+		355, 357, 3, 82, 41, 0, 356, 355, 1, 0, 0, 0, 356, 357, 1, 0, 0, 0, 357,
+		358, 1, 0, 0, 0, 358, 359, 3, 4, 2, 0, 359, 31, 1, 0, 0, 0, 360, 371, 5,
+		28, 0, 0, 361, 371, 5, 29, 0, 0, 362, 371, 5, 30, 0, 0, 363, 371, 5, 31,
+		0, 0, 364, 371, 5, 32, 0, 0, 365, 371, 5, 21, 0, 0, 366, 371, 5, 23, 0,
+		0, 367, 368, 5, 43, 0, 0, 368, 371, 5, 44, 0, 0, 369, 371, 5, 1, 0, 0,
+		370, 360, 1, 0, 0, 0, 370, 361, 1, 0, 0, 0, 370, 362, 1, 0, 0, 0, 370,
+		363, 1, 0, 0, 0, 370, 364, 1, 0, 0, 0, 370, 365, 1, 0, 0, 0, 370, 366,
+		1, 0, 0, 0, 370, 367, 1, 0, 0, 0, 370, 369, 1, 0, 0, 0, 371, 33, 1, 0,
+		0, 0, 372, 373, 3, 84, 42, 0, 373, 374, 5, 86, 0, 0, 374, 35, 1, 0, 0,
+		0, 375, 376, 3, 82, 41, 0, 376, 379, 5, 86, 0, 0, 377, 378, 5, 25, 0, 0,
+		378, 380, 3, 6, 3, 0, 379, 377, 1, 0, 0, 0, 379, 380, 1, 0, 0, 0, 380,
+		386, 1, 0, 0, 0, 381, 382, 5, 46, 0, 0, 382, 383, 3, 82, 41, 0, 383, 384,
+		5, 86, 0, 0, 384, 386, 1, 0, 0, 0, 385, 375, 1, 0, 0, 0, 385, 381, 1, 0,
+		0, 0, 386, 37, 1, 0, 0, 0, 387, 396, 5, 64, 0, 0, 388, 393, 3, 6, 3, 0,
+		389, 390, 5, 50, 0, 0, 390, 392, 3, 6, 3, 0, 391, 389, 1, 0, 0, 0, 392,
+		395, 1, 0, 0, 0, 393, 391, 1, 0, 0, 0, 393, 394, 1, 0, 0, 0, 394, 397,
+		1, 0, 0, 0, 395, 393, 1, 0, 0, 0, 396, 388, 1, 0, 0, 0, 396, 397, 1, 0,
+		0, 0, 397, 39, 1, 0, 0, 0, 398, 399, 5, 73, 0, 0, 399, 400, 3, 6, 3, 0,
+		400, 41, 1, 0, 0, 0, 401, 402, 5, 74, 0, 0, 402, 403, 3, 72, 36, 0, 403,
+		404, 5, 75, 0, 0, 404, 405, 3, 6, 3, 0, 405, 406, 3, 4, 2, 0, 406, 43,
+		1, 0, 0, 0, 407, 408, 5, 70, 0, 0, 408, 409, 3, 28, 14, 0, 409, 45, 1,
+		0, 0, 0, 410, 411, 5, 56, 0, 0, 411, 412, 5, 86, 0, 0, 412, 413, 5, 41,
+		0, 0, 413, 418, 3, 48, 24, 0, 414, 415, 5, 50, 0, 0, 415, 417, 3, 48, 24,
+		0, 416, 414, 1, 0, 0, 0, 417, 420, 1, 0, 0, 0, 418, 416, 1, 0, 0, 0, 418,
+		419, 1, 0, 0, 0, 419, 422, 1, 0, 0, 0, 420, 418, 1, 0, 0, 0, 421, 423,
+		5, 50, 0, 0, 422, 421, 1, 0, 0, 0, 422, 423, 1, 0, 0, 0, 423, 424, 1, 0,
+		0, 0, 424, 425, 5, 42, 0, 0, 425, 47, 1, 0, 0, 0, 426, 438, 5, 86, 0, 0,
+		427, 428, 5, 39, 0, 0, 428, 433, 3, 82, 41, 0, 429, 430, 5, 50, 0, 0, 430,
+		432, 3, 82, 41, 0, 431, 429, 1, 0, 0, 0, 432, 435, 1, 0, 0, 0, 433, 431,
+		1, 0, 0, 0, 433, 434, 1, 0, 0, 0, 434, 436, 1, 0, 0, 0, 435, 433, 1, 0,
+		0, 0, 436, 437, 5, 40, 0, 0, 437, 439, 1, 0, 0, 0, 438, 427, 1, 0, 0, 0,
+		438, 439, 1, 0, 0, 0, 439, 49, 1, 0, 0, 0, 440, 441, 5, 61, 0, 0, 441,
+		442, 5, 86, 0, 0, 442, 449, 5, 41, 0, 0, 443, 445, 3, 52, 26, 0, 444, 446,
+		5, 50, 0, 0, 445, 444, 1, 0, 0, 0, 445, 446, 1, 0, 0, 0, 446, 448, 1, 0,
+		0, 0, 447, 443, 1, 0, 0, 0, 448, 451, 1, 0, 0, 0, 449, 447, 1, 0, 0, 0,
+		449, 450, 1, 0, 0, 0, 450, 452, 1, 0, 0, 0, 451, 449, 1, 0, 0, 0, 452,
+		453, 5, 42, 0, 0, 453, 51, 1, 0, 0, 0, 454, 455, 3, 82, 41, 0, 455, 456,
+		5, 86, 0, 0, 456, 53, 1, 0, 0, 0, 457, 459, 3, 72, 36, 0, 458, 460, 3,
+		60, 30, 0, 459, 458, 1, 0, 0, 0, 459, 460, 1, 0, 0, 0, 460, 461, 1, 0,
+		0, 0, 461, 462, 5, 26, 0, 0, 462, 463, 3, 6, 3, 0, 463, 55, 1, 0, 0, 0,
+		464, 465, 5, 58, 0, 0, 465, 466, 3, 6, 3, 0, 466, 470, 5, 41, 0, 0, 467,
+		469, 3, 58, 29, 0, 468, 467, 1, 0, 0, 0, 469, 472, 1, 0, 0, 0, 470, 468,
+		1, 0, 0, 0, 470, 471, 1, 0, 0, 0, 471, 473, 1, 0, 0, 0, 472, 470, 1, 0,
+		0, 0, 473, 474, 5, 42, 0, 0, 474, 57, 1, 0, 0, 0, 475, 476, 5, 59, 0, 0,
+		476, 478, 3, 72, 36, 0, 477, 479, 3, 60, 30, 0, 478, 477, 1, 0, 0, 0, 478,
+		479, 1, 0, 0, 0, 479, 480, 1, 0, 0, 0, 480, 481, 3, 4, 2, 0, 481, 59, 1,
+		0, 0, 0, 482, 483, 5, 60, 0, 0, 483, 484, 3, 6, 3, 0, 484, 61, 1, 0, 0,
+		0, 485, 486, 5, 65, 0, 0, 486, 487, 3, 28, 14, 0, 487, 63, 1, 0, 0, 0,
+		488, 489, 5, 71, 0, 0, 489, 490, 3, 6, 3, 0, 490, 65, 1, 0, 0, 0, 491,
+		492, 3, 6, 3, 0, 492, 493, 5, 27, 0, 0, 493, 494, 3, 6, 3, 0, 494, 67,
+		1, 0, 0, 0, 495, 496, 5, 66, 0, 0, 496, 500, 5, 41, 0, 0, 497, 499, 3,
+		70, 35, 0, 498, 497, 1, 0, 0, 0, 499, 502, 1, 0, 0, 0, 500, 498, 1, 0,
+		0, 0, 500, 501, 1, 0, 0, 0, 501, 503, 1, 0, 0, 0, 502, 500, 1, 0, 0, 0,
+		503, 504, 5, 42, 0, 0, 504, 69, 1, 0, 0, 0, 505, 508, 5, 59, 0, 0, 506,
+		507, 5, 86, 0, 0, 507, 509, 5, 25, 0, 0, 508, 506, 1, 0, 0, 0, 508, 509,
+		1, 0, 0, 0, 509, 510, 1, 0, 0, 0, 510, 511, 5, 27, 0, 0, 511, 512, 3, 6,
+		3, 0, 512, 513, 3, 4, 2, 0, 513, 523, 1, 0, 0, 0, 514, 515, 5, 59, 0, 0,
+		515, 516, 3, 6, 3, 0, 516, 517, 5, 27, 0, 0, 517, 518, 3, 6, 3, 0, 518,
+		519, 3, 4, 2, 0, 519, 523, 1, 0, 0, 0, 520, 521, 5, 67, 0, 0, 521, 523,
+		3, 4, 2, 0, 522, 505, 1, 0, 0, 0, 522, 514, 1, 0, 0, 0, 522, 520, 1, 0,
+		0, 0, 523, 71, 1, 0, 0, 0, 524, 606, 5, 85, 0, 0, 525, 527, 5, 29, 0, 0,
+		526, 525, 1, 0, 0, 0, 526, 527, 1, 0, 0, 0, 527, 528, 1, 0, 0, 0, 528,
+		533, 7, 7, 0, 0, 529, 533, 5, 84, 0, 0, 530, 533, 5, 82, 0, 0, 531, 533,
+		5, 83, 0, 0, 532, 526, 1, 0, 0, 0, 532, 529, 1, 0, 0, 0, 532, 530, 1, 0,
+		0, 0, 532, 531, 1, 0, 0, 0, 533, 606, 1, 0, 0, 0, 534, 536, 5, 86, 0, 0,
+		535, 534, 1, 0, 0, 0, 535, 536, 1, 0, 0, 0, 536, 537, 1, 0, 0, 0, 537,
+		538, 5, 49, 0, 0, 538, 551, 5, 86, 0, 0, 539, 548, 5, 39, 0, 0, 540, 545,
+		3, 72, 36, 0, 541, 542, 5, 50, 0, 0, 542, 544, 3, 72, 36, 0, 543, 541,
+		1, 0, 0, 0, 544, 547, 1, 0, 0, 0, 545, 543, 1, 0, 0, 0, 545, 546, 1, 0,
+		0, 0, 546, 549, 1, 0, 0, 0, 547, 545, 1, 0, 0, 0, 548, 540, 1, 0, 0, 0,
+		548, 549, 1, 0, 0, 0, 549, 550, 1, 0, 0, 0, 550, 552, 5, 40, 0, 0, 551,
+		539, 1, 0, 0, 0, 551, 552, 1, 0, 0, 0, 552, 606, 1, 0, 0, 0, 553, 562,
+		5, 43, 0, 0, 554, 559, 3, 72, 36, 0, 555, 556, 5, 50, 0, 0, 556, 558, 3,
+		72, 36, 0, 557, 555, 1, 0, 0, 0, 558, 561, 1, 0, 0, 0, 559, 557, 1, 0,
+		0, 0, 559, 560, 1, 0, 0, 0, 560, 563, 1, 0, 0, 0, 561, 559, 1, 0, 0, 0,
+		562, 554, 1, 0, 0, 0, 562, 563, 1, 0, 0, 0, 563, 564, 1, 0, 0, 0, 564,
+		606, 5, 44, 0, 0, 565, 567, 5, 46, 0, 0, 566, 568, 5, 86, 0, 0, 567, 566,
+		1, 0, 0, 0, 567, 568, 1, 0, 0, 0, 568, 606, 1, 0, 0, 0, 569, 578, 5, 41,
+		0, 0, 570, 575, 3, 74, 37, 0, 571, 572, 5, 50, 0, 0, 572, 574, 3, 74, 37,
+		0, 573, 571, 1, 0, 0, 0, 574, 577, 1, 0, 0, 0, 575, 573, 1, 0, 0, 0, 575,
+		576, 1, 0, 0, 0, 576, 579, 1, 0, 0, 0, 577, 575, 1, 0, 0, 0, 578, 570,
+		1, 0, 0, 0, 578, 579, 1, 0, 0, 0, 579, 580, 1, 0, 0, 0, 580, 606, 5, 42,
+		0, 0, 581, 582, 5, 39, 0, 0, 582, 585, 3, 72, 36, 0, 583, 584, 5, 50, 0,
+		0, 584, 586, 3, 72, 36, 0, 585, 583, 1, 0, 0, 0, 586, 587, 1, 0, 0, 0,
+		587, 585, 1, 0, 0, 0, 587, 588, 1, 0, 0, 0, 588, 589, 1, 0, 0, 0, 589,
+		590, 5, 40, 0, 0, 590, 606, 1, 0, 0, 0, 591, 592, 5, 86, 0, 0, 592, 601,
+		5, 41, 0, 0, 593, 598, 3, 76, 38, 0, 594, 595, 5, 50, 0, 0, 595, 597, 3,
+		76, 38, 0, 596, 594, 1, 0, 0, 0, 597, 600, 1, 0, 0, 0, 598, 596, 1, 0,
+		0, 0, 598, 599, 1, 0, 0, 0, 599, 602, 1, 0, 0, 0, 600, 598, 1, 0, 0, 0,
+		601, 593, 1, 0, 0, 0, 601, 602, 1, 0, 0, 0, 602, 603, 1, 0, 0, 0, 603,
+		606, 5, 42, 0, 0, 604, 606, 5, 86, 0, 0, 605, 524, 1, 0, 0, 0, 605, 532,
+		1, 0, 0, 0, 605, 535, 1, 0, 0, 0, 605, 553, 1, 0, 0, 0, 605, 565, 1, 0,
+		0, 0, 605, 569, 1, 0, 0, 0, 605, 581, 1, 0, 0, 0, 605, 591, 1, 0, 0, 0,
+		605, 604, 1, 0, 0, 0, 606, 73, 1, 0, 0, 0, 607, 608, 3, 72, 36, 0, 608,
+		609, 5, 45, 0, 0, 609, 610, 3, 72, 36, 0, 610, 75, 1, 0, 0, 0, 611, 614,
+		5, 86, 0, 0, 612, 613, 5, 45, 0, 0, 613, 615, 3, 72, 36, 0, 614, 612, 1,
+		0, 0, 0, 614, 615, 1, 0, 0, 0, 615, 77, 1, 0, 0, 0, 616, 617, 3, 82, 41,
+		0, 617, 624, 5, 86, 0, 0, 618, 619, 5, 50, 0, 0, 619, 620, 3, 82, 41, 0,
+		620, 621, 5, 86, 0, 0, 621, 623, 1, 0, 0, 0, 622, 618, 1, 0, 0, 0, 623,
+		626, 1, 0, 0, 0, 624, 622, 1, 0, 0, 0, 624, 625, 1, 0, 0, 0, 625, 627,
+		1, 0, 0, 0, 626, 624, 1, 0, 0, 0, 627, 628, 5, 25, 0, 0, 628, 629, 3, 6,
+		3, 0, 629, 79, 1, 0, 0, 0, 630, 631, 3, 72, 36, 0, 631, 632, 5, 25, 0,
+		0, 632, 633, 3, 6, 3, 0, 633, 81, 1, 0, 0, 0, 634, 643, 3, 98, 49, 0, 635,
+		643, 3, 84, 42, 0, 636, 643, 3, 86, 43, 0, 637, 643, 3, 88, 44, 0, 638,
+		643, 3, 96, 48, 0, 639, 643, 3, 90, 45, 0, 640, 643, 3, 92, 46, 0, 641,
+		643, 3, 94, 47, 0, 642, 634, 1, 0, 0, 0, 642, 635, 1, 0, 0, 0, 642, 636,
+		1, 0, 0, 0, 642, 637, 1, 0, 0, 0, 642, 638, 1, 0, 0, 0, 642, 639, 1, 0,
+		0, 0, 642, 640, 1, 0, 0, 0, 642, 641, 1, 0, 0, 0, 643, 645, 1, 0, 0, 0,
+		644, 646, 5, 52, 0, 0, 645, 644, 1, 0, 0, 0, 645, 646, 1, 0, 0, 0, 646,
+		83, 1, 0, 0, 0, 647, 650, 5, 86, 0, 0, 648, 649, 5, 49, 0, 0, 649, 651,
+		5, 86, 0, 0, 650, 648, 1, 0, 0, 0, 650, 651, 1, 0, 0, 0, 651, 85, 1, 0,
+		0, 0, 652, 653, 5, 43, 0, 0, 653, 654, 5, 44, 0, 0, 654, 655, 3, 82, 41,
+		0, 655, 87, 1, 0, 0, 0, 656, 657, 5, 62, 0, 0, 657, 658, 5, 43, 0, 0, 658,
+		659, 3, 82, 41, 0, 659, 660, 5, 44, 0, 0, 660, 661, 3, 82, 41, 0, 661,
+		89, 1, 0, 0, 0, 662, 663, 5, 68, 0, 0, 663, 664, 5, 43, 0, 0, 664, 665,
+		3, 82, 41, 0, 665, 666, 5, 44, 0, 0, 666, 91, 1, 0, 0, 0, 667, 668, 5,
+		72, 0, 0, 668, 669, 5, 43, 0, 0, 669, 670, 3, 82, 41, 0, 670, 671, 5, 44,
+		0, 0, 671, 93, 1, 0, 0, 0, 672, 673, 5, 76, 0, 0, 673, 674, 5, 43, 0, 0,
+		674, 675, 3, 82, 41, 0, 675, 676, 5, 44, 0, 0, 676, 95, 1, 0, 0, 0, 677,
+		678, 5, 39, 0, 0, 678, 681, 3, 82, 41, 0, 679, 680, 5, 50, 0, 0, 680, 682,
+		3, 82, 41, 0, 681, 679, 1, 0, 0, 0, 682, 683, 1, 0, 0, 0, 683, 681, 1,
+		0, 0, 0, 683, 684, 1, 0, 0, 0, 684, 685, 1, 0, 0, 0, 685, 686, 5, 40, 0,
+		0, 686, 97, 1, 0, 0, 0, 687, 688, 7, 8, 0, 0, 688, 99, 1, 0, 0, 0, 689,
+		690, 5, 55, 0, 0, 690, 691, 3, 102, 51, 0, 691, 101, 1, 0, 0, 0, 692, 693,
+		5, 23, 0, 0, 693, 698, 5, 86, 0, 0, 694, 695, 5, 31, 0, 0, 695, 697, 5,
+		86, 0, 0, 696, 694, 1, 0, 0, 0, 697, 700, 1, 0, 0, 0, 698, 696, 1, 0, 0,
+		0, 698, 699, 1, 0, 0, 0, 699, 701, 1, 0, 0, 0, 700, 698, 1, 0, 0, 0, 701,
+		704, 5, 24, 0, 0, 702, 704, 5, 84, 0, 0, 703, 692, 1, 0, 0, 0, 703, 702,
+		1, 0, 0, 0, 704, 103, 1, 0, 0, 0, 69, 107, 128, 134, 149, 153, 168, 207,
+		209, 229, 237, 241, 243, 253, 257, 259, 273, 284, 288, 290, 307, 310, 316,
+		327, 330, 337, 341, 349, 352, 356, 370, 379, 385, 393, 396, 418, 422, 433,
+		438, 445, 449, 459, 470, 478, 500, 508, 522, 526, 532, 535, 545, 548, 551,
+		559, 562, 567, 575, 578, 587, 598, 601, 605, 614, 624, 642, 645, 650, 683,
+		698, 703,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -114,44 +434,152 @@ func NewBoParser(input antlr.TokenStream) *BoParser {
 
 // BoParser tokens.
 const (
-	BoParserEOF       = antlr.TokenEOF
-	BoParserT__0      = 1
-	BoParserT__1      = 2
-	BoParserT__2      = 3
-	BoParserT__3      = 4
-	BoParserT__4      = 5
-	BoParserLT        = 6
-	BoParserGT        = 7
-	BoParserASSIGN    = 8
-	BoParserLPAREN    = 9
-	BoParserRPAREN    = 10
-	BoParserLBRACE    = 11
-	BoParserRBRACE    = 12
-	BoParserPERIOD    = 13
-	BoParserCOMMA     = 14
-	BoParserSEMICOLON = 15
-	BoParserREQUIRE   = 16
-	BoParserINT       = 17
-	BoParserFLOAT     = 18
-	BoParserBOOL      = 19
-	BoParserSTRING    = 20
-	BoParserID        = 21
-	BoParserWS        = 22
-	BoParserS_COMMENT = 23
-	BoParserM_COMMENT = 24
+	BoParserEOF         = antlr.TokenEOF
+	BoParserT__0        = 1
+	BoParserT__1        = 2
+	BoParserT__2        = 3
+	BoParserT__3        = 4
+	BoParserT__4        = 5
+	BoParserT__5        = 6
+	BoParserT__6        = 7
+	BoParserT__7        = 8
+	BoParserT__8        = 9
+	BoParserT__9        = 10
+	BoParserT__10       = 11
+	BoParserT__11       = 12
+	BoParserT__12       = 13
+	BoParserT__13       = 14
+	BoParserT__14       = 15
+	BoParserT__15       = 16
+	BoParserT__16       = 17
+	BoParserT__17       = 18
+	BoParserLE          = 19
+	BoParserGE          = 20
+	BoParserEQ          = 21
+	BoParserNE          = 22
+	BoParserLT          = 23
+	BoParserGT          = 24
+	BoParserASSIGN      = 25
+	BoParserARROW       = 26
+	BoParserRECEIVE     = 27
+	BoParserADD         = 28
+	BoParserSUB         = 29
+	BoParserMUL         = 30
+	BoParserDIV         = 31
+	BoParserMOD         = 32
+	BoParserAND         = 33
+	BoParserOR          = 34
+	BoParserNOT         = 35
+	BoParserADD_WRAP    = 36
+	BoParserSUB_WRAP    = 37
+	BoParserMUL_WRAP    = 38
+	BoParserLPAREN      = 39
+	BoParserRPAREN      = 40
+	BoParserLBRACE      = 41
+	BoParserRBRACE      = 42
+	BoParserLBRACK      = 43
+	BoParserRBRACK      = 44
+	BoParserCOLON       = 45
+	BoParserELLIPSIS    = 46
+	BoParserRANGE_EXCL  = 47
+	BoParserRANGE       = 48
+	BoParserPERIOD      = 49
+	BoParserCOMMA       = 50
+	BoParserSEMICOLON   = 51
+	BoParserQUESTION    = 52
+	BoParserSAFE_PERIOD = 53
+	BoParserCOALESCE    = 54
+	BoParserREQUIRE     = 55
+	BoParserENUM        = 56
+	BoParserMATCH       = 57
+	BoParserSWITCH      = 58
+	BoParserCASE        = 59
+	BoParserIF          = 60
+	BoParserSTRUCT      = 61
+	BoParserMAP         = 62
+	BoParserFUNC        = 63
+	BoParserRETURN      = 64
+	BoParserSPAWN       = 65
+	BoParserSELECT      = 66
+	BoParserDEFAULT     = 67
+	BoParserCHAN        = 68
+	BoParserASYNC       = 69
+	BoParserDEFER       = 70
+	BoParserAWAIT       = 71
+	BoParserFUTURE      = 72
+	BoParserYIELD       = 73
+	BoParserFOR         = 74
+	BoParserIN          = 75
+	BoParserITERATOR    = 76
+	BoParserSTEP        = 77
+	BoParserINT         = 78
+	BoParserFLOAT       = 79
+	BoParserBIGINT      = 80
+	BoParserDECIMAL     = 81
+	BoParserBOOL        = 82
+	BoParserNIL         = 83
+	BoParserSTRING      = 84
+	BoParserUNDERSCORE  = 85
+	BoParserID          = 86
+	BoParserWS          = 87
+	BoParserS_COMMENT   = 88
+	BoParserM_COMMENT   = 89
 )
 
 // BoParser rules.
 const (
-	BoParserRULE_program             = 0
-	BoParserRULE_statement           = 1
-	BoParserRULE_expression          = 2
-	BoParserRULE_functionParameters  = 3
-	BoParserRULE_functionCall        = 4
-	BoParserRULE_variableDeclaration = 5
-	BoParserRULE_typeSpec            = 6
-	BoParserRULE_requireStatement    = 7
-	BoParserRULE_importPath          = 8
+	BoParserRULE_program                  = 0
+	BoParserRULE_statement                = 1
+	BoParserRULE_block                    = 2
+	BoParserRULE_expression               = 3
+	BoParserRULE_primary                  = 4
+	BoParserRULE_listLiteral              = 5
+	BoParserRULE_mapLiteral               = 6
+	BoParserRULE_mapEntry                 = 7
+	BoParserRULE_tupleLiteral             = 8
+	BoParserRULE_structLiteral            = 9
+	BoParserRULE_fieldValue               = 10
+	BoParserRULE_embeddedExpression       = 11
+	BoParserRULE_functionParameters       = 12
+	BoParserRULE_argument                 = 13
+	BoParserRULE_functionCall             = 14
+	BoParserRULE_functionDeclaration      = 15
+	BoParserRULE_operator                 = 16
+	BoParserRULE_receiver                 = 17
+	BoParserRULE_parameter                = 18
+	BoParserRULE_returnStatement          = 19
+	BoParserRULE_yieldStatement           = 20
+	BoParserRULE_forStatement             = 21
+	BoParserRULE_deferStatement           = 22
+	BoParserRULE_enumDeclaration          = 23
+	BoParserRULE_enumCase                 = 24
+	BoParserRULE_structDeclaration        = 25
+	BoParserRULE_structField              = 26
+	BoParserRULE_matchArm                 = 27
+	BoParserRULE_switchStatement          = 28
+	BoParserRULE_switchArm                = 29
+	BoParserRULE_guard                    = 30
+	BoParserRULE_spawnStatement           = 31
+	BoParserRULE_awaitStatement           = 32
+	BoParserRULE_sendStatement            = 33
+	BoParserRULE_selectStatement          = 34
+	BoParserRULE_selectArm                = 35
+	BoParserRULE_pattern                  = 36
+	BoParserRULE_entryPattern             = 37
+	BoParserRULE_fieldPattern             = 38
+	BoParserRULE_variableDeclaration      = 39
+	BoParserRULE_destructuringDeclaration = 40
+	BoParserRULE_typeSpec                 = 41
+	BoParserRULE_typeName                 = 42
+	BoParserRULE_listType                 = 43
+	BoParserRULE_mapType                  = 44
+	BoParserRULE_chanType                 = 45
+	BoParserRULE_futureType               = 46
+	BoParserRULE_iteratorType             = 47
+	BoParserRULE_tupleType                = 48
+	BoParserRULE_basicType                = 49
+	BoParserRULE_requireStatement         = 50
+	BoParserRULE_importPath               = 51
 )
 
 // IProgramContext is an interface to support dynamic dispatch.
@@ -271,20 +699,20 @@ func (p *BoParser) Program() (localctx IProgramContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(21)
+	p.SetState(107)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4128798) != 0 {
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-1764766155328192514) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&8378359) != 0) {
 		{
-			p.SetState(18)
+			p.SetState(104)
 			p.Statement()
 		}
 
-		p.SetState(23)
+		p.SetState(109)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -292,7 +720,7 @@ func (p *BoParser) Program() (localctx IProgramContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(24)
+		p.SetState(110)
 		p.Match(BoParserEOF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -322,7 +750,20 @@ type IStatementContext interface {
 
 	// Getter signatures
 	RequireStatement() IRequireStatementContext
+	EnumDeclaration() IEnumDeclarationContext
+	StructDeclaration() IStructDeclarationContext
+	FunctionDeclaration() IFunctionDeclarationContext
+	ReturnStatement() IReturnStatementContext
+	DeferStatement() IDeferStatementContext
+	YieldStatement() IYieldStatementContext
+	ForStatement() IForStatementContext
 	VariableDeclaration() IVariableDeclarationContext
+	DestructuringDeclaration() IDestructuringDeclarationContext
+	SwitchStatement() ISwitchStatementContext
+	SpawnStatement() ISpawnStatementContext
+	SendStatement() ISendStatementContext
+	SelectStatement() ISelectStatementContext
+	AwaitStatement() IAwaitStatementContext
 	FunctionCall() IFunctionCallContext
 
 	// IsStatementContext differentiates from other interfaces.
@@ -377,6 +818,118 @@ func (s *StatementContext) RequireStatement() IRequireStatementContext {
 	return t.(IRequireStatementContext)
 }
 
+func (s *StatementContext) EnumDeclaration() IEnumDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEnumDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumDeclarationContext)
+}
+
+func (s *StatementContext) StructDeclaration() IStructDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructDeclarationContext)
+}
+
+func (s *StatementContext) FunctionDeclaration() IFunctionDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionDeclarationContext)
+}
+
+func (s *StatementContext) ReturnStatement() IReturnStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IReturnStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IReturnStatementContext)
+}
+
+func (s *StatementContext) DeferStatement() IDeferStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDeferStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDeferStatementContext)
+}
+
+func (s *StatementContext) YieldStatement() IYieldStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IYieldStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IYieldStatementContext)
+}
+
+func (s *StatementContext) ForStatement() IForStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IForStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IForStatementContext)
+}
+
 func (s *StatementContext) VariableDeclaration() IVariableDeclarationContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -386,19 +939,10944 @@ func (s *StatementContext) VariableDeclaration() IVariableDeclarationContext {
 		}
 	}
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IVariableDeclarationContext)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVariableDeclarationContext)
+}
+
+func (s *StatementContext) DestructuringDeclaration() IDestructuringDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDestructuringDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDestructuringDeclarationContext)
+}
+
+func (s *StatementContext) SwitchStatement() ISwitchStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISwitchStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitchStatementContext)
+}
+
+func (s *StatementContext) SpawnStatement() ISpawnStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISpawnStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISpawnStatementContext)
+}
+
+func (s *StatementContext) SendStatement() ISendStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISendStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISendStatementContext)
+}
+
+func (s *StatementContext) SelectStatement() ISelectStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectStatementContext)
+}
+
+func (s *StatementContext) AwaitStatement() IAwaitStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAwaitStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAwaitStatementContext)
+}
+
+func (s *StatementContext) FunctionCall() IFunctionCallContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionCallContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionCallContext)
+}
+
+func (s *StatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) Statement() (localctx IStatementContext) {
+	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, BoParserRULE_statement)
+	p.SetState(128)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 1, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(112)
+			p.RequireStatement()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(113)
+			p.EnumDeclaration()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(114)
+			p.StructDeclaration()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(115)
+			p.FunctionDeclaration()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(116)
+			p.ReturnStatement()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(117)
+			p.DeferStatement()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(118)
+			p.YieldStatement()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(119)
+			p.ForStatement()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(120)
+			p.VariableDeclaration()
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(121)
+			p.DestructuringDeclaration()
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(122)
+			p.SwitchStatement()
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(123)
+			p.SpawnStatement()
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(124)
+			p.SendStatement()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(125)
+			p.SelectStatement()
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(126)
+			p.AwaitStatement()
+		}
+
+	case 16:
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(127)
+			p.FunctionCall()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IBlockContext is an interface to support dynamic dispatch.
+type IBlockContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	AllStatement() []IStatementContext
+	Statement(i int) IStatementContext
+
+	// IsBlockContext differentiates from other interfaces.
+	IsBlockContext()
+}
+
+type BlockContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBlockContext() *BlockContext {
+	var p = new(BlockContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_block
+	return p
+}
+
+func InitEmptyBlockContext(p *BlockContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_block
+}
+
+func (*BlockContext) IsBlockContext() {}
+
+func NewBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BlockContext {
+	var p = new(BlockContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_block
+
+	return p
+}
+
+func (s *BlockContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BlockContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACE, 0)
+}
+
+func (s *BlockContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACE, 0)
+}
+
+func (s *BlockContext) AllStatement() []IStatementContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatementContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatementContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatementContext); ok {
+			tst[i] = t.(IStatementContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *BlockContext) Statement(i int) IStatementContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatementContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *BlockContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitBlock(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) Block() (localctx IBlockContext) {
+	localctx = NewBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, BoParserRULE_block)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(130)
+		p.Match(BoParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(134)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-1764766155328192514) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&8378359) != 0) {
+		{
+			p.SetState(131)
+			p.Statement()
+		}
+
+		p.SetState(136)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(137)
+		p.Match(BoParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
+}
+
+type ExpressionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_expression
+	return p
+}
+
+func InitEmptyExpressionContext(p *ExpressionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_expression
+}
+
+func (*ExpressionContext) IsExpressionContext() {}
+
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_expression
+
+	return p
+}
+
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionContext) CopyAll(ctx *ExpressionContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type PrimaryExpressionContext struct {
+	ExpressionContext
+}
+
+func NewPrimaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PrimaryExpressionContext {
+	var p = new(PrimaryExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *PrimaryExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrimaryExpressionContext) Primary() IPrimaryContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrimaryContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrimaryContext)
+}
+
+func (s *PrimaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitPrimaryExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type MatchExpressionContext struct {
+	ExpressionContext
+}
+
+func NewMatchExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MatchExpressionContext {
+	var p = new(MatchExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *MatchExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchExpressionContext) MATCH() antlr.TerminalNode {
+	return s.GetToken(BoParserMATCH, 0)
+}
+
+func (s *MatchExpressionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *MatchExpressionContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACE, 0)
+}
+
+func (s *MatchExpressionContext) AllMatchArm() []IMatchArmContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMatchArmContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMatchArmContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMatchArmContext); ok {
+			tst[i] = t.(IMatchArmContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MatchExpressionContext) MatchArm(i int) IMatchArmContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMatchArmContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchArmContext)
+}
+
+func (s *MatchExpressionContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACE, 0)
+}
+
+func (s *MatchExpressionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *MatchExpressionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *MatchExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitMatchExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type CoalesceExpressionContext struct {
+	ExpressionContext
+}
+
+func NewCoalesceExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CoalesceExpressionContext {
+	var p = new(CoalesceExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *CoalesceExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CoalesceExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CoalesceExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *CoalesceExpressionContext) COALESCE() antlr.TerminalNode {
+	return s.GetToken(BoParserCOALESCE, 0)
+}
+
+func (s *CoalesceExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitCoalesceExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AwaitExpressionContext struct {
+	ExpressionContext
+}
+
+func NewAwaitExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AwaitExpressionContext {
+	var p = new(AwaitExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *AwaitExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AwaitExpressionContext) AWAIT() antlr.TerminalNode {
+	return s.GetToken(BoParserAWAIT, 0)
+}
+
+func (s *AwaitExpressionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AwaitExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitAwaitExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ConversionExpressionContext struct {
+	ExpressionContext
+}
+
+func NewConversionExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ConversionExpressionContext {
+	var p = new(ConversionExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *ConversionExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ConversionExpressionContext) TypeSpec() ITypeSpecContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *ConversionExpressionContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserLPAREN, 0)
+}
+
+func (s *ConversionExpressionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ConversionExpressionContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserRPAREN, 0)
+}
+
+func (s *ConversionExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitConversionExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AdditiveExpressionContext struct {
+	ExpressionContext
+}
+
+func NewAdditiveExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AdditiveExpressionContext {
+	var p = new(AdditiveExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *AdditiveExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AdditiveExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AdditiveExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AdditiveExpressionContext) ADD() antlr.TerminalNode {
+	return s.GetToken(BoParserADD, 0)
+}
+
+func (s *AdditiveExpressionContext) SUB() antlr.TerminalNode {
+	return s.GetToken(BoParserSUB, 0)
+}
+
+func (s *AdditiveExpressionContext) ADD_WRAP() antlr.TerminalNode {
+	return s.GetToken(BoParserADD_WRAP, 0)
+}
+
+func (s *AdditiveExpressionContext) SUB_WRAP() antlr.TerminalNode {
+	return s.GetToken(BoParserSUB_WRAP, 0)
+}
+
+func (s *AdditiveExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitAdditiveExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type RelationalExpressionContext struct {
+	ExpressionContext
+}
+
+func NewRelationalExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RelationalExpressionContext {
+	var p = new(RelationalExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *RelationalExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RelationalExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *RelationalExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *RelationalExpressionContext) LT() antlr.TerminalNode {
+	return s.GetToken(BoParserLT, 0)
+}
+
+func (s *RelationalExpressionContext) LE() antlr.TerminalNode {
+	return s.GetToken(BoParserLE, 0)
+}
+
+func (s *RelationalExpressionContext) GT() antlr.TerminalNode {
+	return s.GetToken(BoParserGT, 0)
+}
+
+func (s *RelationalExpressionContext) GE() antlr.TerminalNode {
+	return s.GetToken(BoParserGE, 0)
+}
+
+func (s *RelationalExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitRelationalExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ReceiveExpressionContext struct {
+	ExpressionContext
+}
+
+func NewReceiveExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ReceiveExpressionContext {
+	var p = new(ReceiveExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *ReceiveExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ReceiveExpressionContext) RECEIVE() antlr.TerminalNode {
+	return s.GetToken(BoParserRECEIVE, 0)
+}
+
+func (s *ReceiveExpressionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ReceiveExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitReceiveExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type MultiplicativeExpressionContext struct {
+	ExpressionContext
+}
+
+func NewMultiplicativeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MultiplicativeExpressionContext {
+	var p = new(MultiplicativeExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *MultiplicativeExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MultiplicativeExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MultiplicativeExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *MultiplicativeExpressionContext) MUL() antlr.TerminalNode {
+	return s.GetToken(BoParserMUL, 0)
+}
+
+func (s *MultiplicativeExpressionContext) DIV() antlr.TerminalNode {
+	return s.GetToken(BoParserDIV, 0)
+}
+
+func (s *MultiplicativeExpressionContext) MOD() antlr.TerminalNode {
+	return s.GetToken(BoParserMOD, 0)
+}
+
+func (s *MultiplicativeExpressionContext) MUL_WRAP() antlr.TerminalNode {
+	return s.GetToken(BoParserMUL_WRAP, 0)
+}
+
+func (s *MultiplicativeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitMultiplicativeExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type MemberExpressionContext struct {
+	ExpressionContext
+}
+
+func NewMemberExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MemberExpressionContext {
+	var p = new(MemberExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *MemberExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MemberExpressionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *MemberExpressionContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *MemberExpressionContext) PERIOD() antlr.TerminalNode {
+	return s.GetToken(BoParserPERIOD, 0)
+}
+
+func (s *MemberExpressionContext) SAFE_PERIOD() antlr.TerminalNode {
+	return s.GetToken(BoParserSAFE_PERIOD, 0)
+}
+
+func (s *MemberExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitMemberExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type OrExpressionContext struct {
+	ExpressionContext
+}
+
+func NewOrExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *OrExpressionContext {
+	var p = new(OrExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *OrExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OrExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *OrExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *OrExpressionContext) OR() antlr.TerminalNode {
+	return s.GetToken(BoParserOR, 0)
+}
+
+func (s *OrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitOrExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AndExpressionContext struct {
+	ExpressionContext
+}
+
+func NewAndExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndExpressionContext {
+	var p = new(AndExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *AndExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AndExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AndExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AndExpressionContext) AND() antlr.TerminalNode {
+	return s.GetToken(BoParserAND, 0)
+}
+
+func (s *AndExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitAndExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type CallExpressionContext struct {
+	ExpressionContext
+}
+
+func NewCallExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallExpressionContext {
+	var p = new(CallExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *CallExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CallExpressionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *CallExpressionContext) FunctionParameters() IFunctionParametersContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionParametersContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionParametersContext)
+}
+
+func (s *CallExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitCallExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type StepExpressionContext struct {
+	ExpressionContext
+}
+
+func NewStepExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StepExpressionContext {
+	var p = new(StepExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *StepExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StepExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *StepExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *StepExpressionContext) STEP() antlr.TerminalNode {
+	return s.GetToken(BoParserSTEP, 0)
+}
+
+func (s *StepExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitStepExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type EqualityExpressionContext struct {
+	ExpressionContext
+}
+
+func NewEqualityExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqualityExpressionContext {
+	var p = new(EqualityExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *EqualityExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EqualityExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EqualityExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *EqualityExpressionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(BoParserEQ, 0)
+}
+
+func (s *EqualityExpressionContext) NE() antlr.TerminalNode {
+	return s.GetToken(BoParserNE, 0)
+}
+
+func (s *EqualityExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitEqualityExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type RangeExpressionContext struct {
+	ExpressionContext
+}
+
+func NewRangeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RangeExpressionContext {
+	var p = new(RangeExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *RangeExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RangeExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *RangeExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *RangeExpressionContext) RANGE() antlr.TerminalNode {
+	return s.GetToken(BoParserRANGE, 0)
+}
+
+func (s *RangeExpressionContext) RANGE_EXCL() antlr.TerminalNode {
+	return s.GetToken(BoParserRANGE_EXCL, 0)
+}
+
+func (s *RangeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitRangeExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type IndexExpressionContext struct {
+	ExpressionContext
+}
+
+func NewIndexExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IndexExpressionContext {
+	var p = new(IndexExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *IndexExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IndexExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *IndexExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *IndexExpressionContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACK, 0)
+}
+
+func (s *IndexExpressionContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACK, 0)
+}
+
+func (s *IndexExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitIndexExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type UnaryExpressionContext struct {
+	ExpressionContext
+}
+
+func NewUnaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnaryExpressionContext {
+	var p = new(UnaryExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *UnaryExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnaryExpressionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *UnaryExpressionContext) SUB() antlr.TerminalNode {
+	return s.GetToken(BoParserSUB, 0)
+}
+
+func (s *UnaryExpressionContext) NOT() antlr.TerminalNode {
+	return s.GetToken(BoParserNOT, 0)
+}
+
+func (s *UnaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitUnaryExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) Expression() (localctx IExpressionContext) {
+	return p.expression(0)
+}
+
+func (p *BoParser) expression(_p int) (localctx IExpressionContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+
+	_parentState := p.GetState()
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExpressionContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 6
+	p.EnterRecursionRule(localctx, 6, BoParserRULE_expression, _p)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(168)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewPrimaryExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+
+		{
+			p.SetState(140)
+			p.Primary()
+		}
+
+	case 2:
+		localctx = NewMatchExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(141)
+			p.Match(BoParserMATCH)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(142)
+			p.expression(0)
+		}
+		{
+			p.SetState(143)
+			p.Match(BoParserLBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(144)
+			p.MatchArm()
+		}
+		p.SetState(149)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 3, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(145)
+					p.Match(BoParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(146)
+					p.MatchArm()
+				}
+
+			}
+			p.SetState(151)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 3, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(153)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserCOMMA {
+			{
+				p.SetState(152)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(155)
+			p.Match(BoParserRBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		localctx = NewConversionExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(157)
+			p.TypeSpec()
+		}
+		{
+			p.SetState(158)
+			p.Match(BoParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(159)
+			p.expression(0)
+		}
+		{
+			p.SetState(160)
+			p.Match(BoParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 4:
+		localctx = NewUnaryExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(162)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == BoParserSUB || _la == BoParserNOT) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(163)
+			p.expression(12)
+		}
+
+	case 5:
+		localctx = NewReceiveExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(164)
+			p.Match(BoParserRECEIVE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(165)
+			p.expression(11)
+		}
+
+	case 6:
+		localctx = NewAwaitExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(166)
+			p.Match(BoParserAWAIT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(167)
+			p.expression(10)
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(209)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 7, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(207)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 6, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewMultiplicativeExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(170)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(171)
+					_la = p.GetTokenStream().LA(1)
+
+					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&282394099712) != 0) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(172)
+					p.expression(10)
+				}
+
+			case 2:
+				localctx = NewAdditiveExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(173)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(174)
+					_la = p.GetTokenStream().LA(1)
+
+					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&206963736576) != 0) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(175)
+					p.expression(9)
+				}
+
+			case 3:
+				localctx = NewRangeExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(176)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(177)
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == BoParserRANGE_EXCL || _la == BoParserRANGE) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(178)
+					p.expression(8)
+				}
+
+			case 4:
+				localctx = NewStepExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(179)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(180)
+					p.Match(BoParserSTEP)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(181)
+					p.expression(7)
+				}
+
+			case 5:
+				localctx = NewRelationalExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(182)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(183)
+					_la = p.GetTokenStream().LA(1)
+
+					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&26738688) != 0) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(184)
+					p.expression(6)
+				}
+
+			case 6:
+				localctx = NewEqualityExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(185)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(186)
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == BoParserEQ || _la == BoParserNE) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(187)
+					p.expression(5)
+				}
+
+			case 7:
+				localctx = NewAndExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(188)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(189)
+					p.Match(BoParserAND)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(190)
+					p.expression(4)
+				}
+
+			case 8:
+				localctx = NewOrExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(191)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(192)
+					p.Match(BoParserOR)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(193)
+					p.expression(3)
+				}
+
+			case 9:
+				localctx = NewCoalesceExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(194)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(195)
+					p.Match(BoParserCOALESCE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(196)
+					p.expression(2)
+				}
+
+			case 10:
+				localctx = NewMemberExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(197)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(198)
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == BoParserPERIOD || _la == BoParserSAFE_PERIOD) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(199)
+					p.Match(BoParserID)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			case 11:
+				localctx = NewCallExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(200)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(201)
+					p.FunctionParameters()
+				}
+
+			case 12:
+				localctx = NewIndexExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, BoParserRULE_expression)
+				p.SetState(202)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(203)
+					p.Match(BoParserLBRACK)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(204)
+					p.expression(0)
+				}
+				{
+					p.SetState(205)
+					p.Match(BoParserRBRACK)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			case antlr.ATNInvalidAltNumber:
+				goto errorExit
+			}
+
+		}
+		p.SetState(211)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 7, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.UnrollRecursionContexts(_parentctx)
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPrimaryContext is an interface to support dynamic dispatch.
+type IPrimaryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	INT() antlr.TerminalNode
+	FLOAT() antlr.TerminalNode
+	BIGINT() antlr.TerminalNode
+	DECIMAL() antlr.TerminalNode
+	STRING() antlr.TerminalNode
+	BOOL() antlr.TerminalNode
+	NIL() antlr.TerminalNode
+	ID() antlr.TerminalNode
+	LPAREN() antlr.TerminalNode
+	Expression() IExpressionContext
+	RPAREN() antlr.TerminalNode
+	FUTURE() antlr.TerminalNode
+	ListLiteral() IListLiteralContext
+	MapLiteral() IMapLiteralContext
+	TupleLiteral() ITupleLiteralContext
+	StructLiteral() IStructLiteralContext
+
+	// IsPrimaryContext differentiates from other interfaces.
+	IsPrimaryContext()
+}
+
+type PrimaryContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPrimaryContext() *PrimaryContext {
+	var p = new(PrimaryContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_primary
+	return p
+}
+
+func InitEmptyPrimaryContext(p *PrimaryContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_primary
+}
+
+func (*PrimaryContext) IsPrimaryContext() {}
+
+func NewPrimaryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimaryContext {
+	var p = new(PrimaryContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_primary
+
+	return p
+}
+
+func (s *PrimaryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrimaryContext) INT() antlr.TerminalNode {
+	return s.GetToken(BoParserINT, 0)
+}
+
+func (s *PrimaryContext) FLOAT() antlr.TerminalNode {
+	return s.GetToken(BoParserFLOAT, 0)
+}
+
+func (s *PrimaryContext) BIGINT() antlr.TerminalNode {
+	return s.GetToken(BoParserBIGINT, 0)
+}
+
+func (s *PrimaryContext) DECIMAL() antlr.TerminalNode {
+	return s.GetToken(BoParserDECIMAL, 0)
+}
+
+func (s *PrimaryContext) STRING() antlr.TerminalNode {
+	return s.GetToken(BoParserSTRING, 0)
+}
+
+func (s *PrimaryContext) BOOL() antlr.TerminalNode {
+	return s.GetToken(BoParserBOOL, 0)
+}
+
+func (s *PrimaryContext) NIL() antlr.TerminalNode {
+	return s.GetToken(BoParserNIL, 0)
+}
+
+func (s *PrimaryContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *PrimaryContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserLPAREN, 0)
+}
+
+func (s *PrimaryContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *PrimaryContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserRPAREN, 0)
+}
+
+func (s *PrimaryContext) FUTURE() antlr.TerminalNode {
+	return s.GetToken(BoParserFUTURE, 0)
+}
+
+func (s *PrimaryContext) ListLiteral() IListLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IListLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IListLiteralContext)
+}
+
+func (s *PrimaryContext) MapLiteral() IMapLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMapLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMapLiteralContext)
+}
+
+func (s *PrimaryContext) TupleLiteral() ITupleLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITupleLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITupleLiteralContext)
+}
+
+func (s *PrimaryContext) StructLiteral() IStructLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructLiteralContext)
+}
+
+func (s *PrimaryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrimaryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PrimaryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitPrimary(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) Primary() (localctx IPrimaryContext) {
+	localctx = NewPrimaryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, BoParserRULE_primary)
+	p.SetState(229)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 8, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(212)
+			p.Match(BoParserINT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(213)
+			p.Match(BoParserFLOAT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(214)
+			p.Match(BoParserBIGINT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(215)
+			p.Match(BoParserDECIMAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(216)
+			p.Match(BoParserSTRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(217)
+			p.Match(BoParserBOOL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(218)
+			p.Match(BoParserNIL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(219)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(220)
+			p.Match(BoParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(221)
+			p.expression(0)
+		}
+		{
+			p.SetState(222)
+			p.Match(BoParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(224)
+			p.Match(BoParserFUTURE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(225)
+			p.ListLiteral()
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(226)
+			p.MapLiteral()
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(227)
+			p.TupleLiteral()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(228)
+			p.StructLiteral()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IListLiteralContext is an interface to support dynamic dispatch.
+type IListLiteralContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LBRACK() antlr.TerminalNode
+	RBRACK() antlr.TerminalNode
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsListLiteralContext differentiates from other interfaces.
+	IsListLiteralContext()
+}
+
+type ListLiteralContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyListLiteralContext() *ListLiteralContext {
+	var p = new(ListLiteralContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_listLiteral
+	return p
+}
+
+func InitEmptyListLiteralContext(p *ListLiteralContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_listLiteral
+}
+
+func (*ListLiteralContext) IsListLiteralContext() {}
+
+func NewListLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ListLiteralContext {
+	var p = new(ListLiteralContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_listLiteral
+
+	return p
+}
+
+func (s *ListLiteralContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ListLiteralContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACK, 0)
+}
+
+func (s *ListLiteralContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACK, 0)
+}
+
+func (s *ListLiteralContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ListLiteralContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ListLiteralContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *ListLiteralContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *ListLiteralContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ListLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ListLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitListLiteral(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) ListLiteral() (localctx IListLiteralContext) {
+	localctx = NewListLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, BoParserRULE_listLiteral)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(231)
+		p.Match(BoParserLBRACK)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(243)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4755812786406686718) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&392473) != 0) {
+		{
+			p.SetState(232)
+			p.expression(0)
+		}
+		p.SetState(237)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 9, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(233)
+					p.Match(BoParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(234)
+					p.expression(0)
+				}
+
+			}
+			p.SetState(239)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 9, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(241)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserCOMMA {
+			{
+				p.SetState(240)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	}
+	{
+		p.SetState(245)
+		p.Match(BoParserRBRACK)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMapLiteralContext is an interface to support dynamic dispatch.
+type IMapLiteralContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	AllMapEntry() []IMapEntryContext
+	MapEntry(i int) IMapEntryContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsMapLiteralContext differentiates from other interfaces.
+	IsMapLiteralContext()
+}
+
+type MapLiteralContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMapLiteralContext() *MapLiteralContext {
+	var p = new(MapLiteralContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_mapLiteral
+	return p
+}
+
+func InitEmptyMapLiteralContext(p *MapLiteralContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_mapLiteral
+}
+
+func (*MapLiteralContext) IsMapLiteralContext() {}
+
+func NewMapLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapLiteralContext {
+	var p = new(MapLiteralContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_mapLiteral
+
+	return p
+}
+
+func (s *MapLiteralContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MapLiteralContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACE, 0)
+}
+
+func (s *MapLiteralContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACE, 0)
+}
+
+func (s *MapLiteralContext) AllMapEntry() []IMapEntryContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMapEntryContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMapEntryContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMapEntryContext); ok {
+			tst[i] = t.(IMapEntryContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MapLiteralContext) MapEntry(i int) IMapEntryContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMapEntryContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMapEntryContext)
+}
+
+func (s *MapLiteralContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *MapLiteralContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *MapLiteralContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MapLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MapLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitMapLiteral(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) MapLiteral() (localctx IMapLiteralContext) {
+	localctx = NewMapLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, BoParserRULE_mapLiteral)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(247)
+		p.Match(BoParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(259)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4755812786406686718) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&392473) != 0) {
+		{
+			p.SetState(248)
+			p.MapEntry()
+		}
+		p.SetState(253)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(249)
+					p.Match(BoParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(250)
+					p.MapEntry()
+				}
+
+			}
+			p.SetState(255)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(257)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserCOMMA {
+			{
+				p.SetState(256)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	}
+	{
+		p.SetState(261)
+		p.Match(BoParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMapEntryContext is an interface to support dynamic dispatch.
+type IMapEntryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	COLON() antlr.TerminalNode
+
+	// IsMapEntryContext differentiates from other interfaces.
+	IsMapEntryContext()
+}
+
+type MapEntryContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMapEntryContext() *MapEntryContext {
+	var p = new(MapEntryContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_mapEntry
+	return p
+}
+
+func InitEmptyMapEntryContext(p *MapEntryContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_mapEntry
+}
+
+func (*MapEntryContext) IsMapEntryContext() {}
+
+func NewMapEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapEntryContext {
+	var p = new(MapEntryContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_mapEntry
+
+	return p
+}
+
+func (s *MapEntryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MapEntryContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MapEntryContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *MapEntryContext) COLON() antlr.TerminalNode {
+	return s.GetToken(BoParserCOLON, 0)
+}
+
+func (s *MapEntryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MapEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MapEntryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitMapEntry(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) MapEntry() (localctx IMapEntryContext) {
+	localctx = NewMapEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, BoParserRULE_mapEntry)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(263)
+		p.expression(0)
+	}
+	{
+		p.SetState(264)
+		p.Match(BoParserCOLON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(265)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITupleLiteralContext is an interface to support dynamic dispatch.
+type ITupleLiteralContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LPAREN() antlr.TerminalNode
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	RPAREN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsTupleLiteralContext differentiates from other interfaces.
+	IsTupleLiteralContext()
+}
+
+type TupleLiteralContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTupleLiteralContext() *TupleLiteralContext {
+	var p = new(TupleLiteralContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_tupleLiteral
+	return p
+}
+
+func InitEmptyTupleLiteralContext(p *TupleLiteralContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_tupleLiteral
+}
+
+func (*TupleLiteralContext) IsTupleLiteralContext() {}
+
+func NewTupleLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TupleLiteralContext {
+	var p = new(TupleLiteralContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_tupleLiteral
+
+	return p
+}
+
+func (s *TupleLiteralContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TupleLiteralContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserLPAREN, 0)
+}
+
+func (s *TupleLiteralContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TupleLiteralContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *TupleLiteralContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserRPAREN, 0)
+}
+
+func (s *TupleLiteralContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *TupleLiteralContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *TupleLiteralContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TupleLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TupleLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitTupleLiteral(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) TupleLiteral() (localctx ITupleLiteralContext) {
+	localctx = NewTupleLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, BoParserRULE_tupleLiteral)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(267)
+		p.Match(BoParserLPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(268)
+		p.expression(0)
+	}
+	p.SetState(271)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == BoParserCOMMA {
+		{
+			p.SetState(269)
+			p.Match(BoParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(270)
+			p.expression(0)
+		}
+
+		p.SetState(273)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(275)
+		p.Match(BoParserRPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IStructLiteralContext is an interface to support dynamic dispatch.
+type IStructLiteralContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ID() antlr.TerminalNode
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	AllFieldValue() []IFieldValueContext
+	FieldValue(i int) IFieldValueContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsStructLiteralContext differentiates from other interfaces.
+	IsStructLiteralContext()
+}
+
+type StructLiteralContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStructLiteralContext() *StructLiteralContext {
+	var p = new(StructLiteralContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_structLiteral
+	return p
+}
+
+func InitEmptyStructLiteralContext(p *StructLiteralContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_structLiteral
+}
+
+func (*StructLiteralContext) IsStructLiteralContext() {}
+
+func NewStructLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructLiteralContext {
+	var p = new(StructLiteralContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_structLiteral
+
+	return p
+}
+
+func (s *StructLiteralContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StructLiteralContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *StructLiteralContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACE, 0)
+}
+
+func (s *StructLiteralContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACE, 0)
+}
+
+func (s *StructLiteralContext) AllFieldValue() []IFieldValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFieldValueContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFieldValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFieldValueContext); ok {
+			tst[i] = t.(IFieldValueContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *StructLiteralContext) FieldValue(i int) IFieldValueContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldValueContext)
+}
+
+func (s *StructLiteralContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *StructLiteralContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *StructLiteralContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StructLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StructLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitStructLiteral(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) StructLiteral() (localctx IStructLiteralContext) {
+	localctx = NewStructLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, BoParserRULE_structLiteral)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(277)
+		p.Match(BoParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(278)
+		p.Match(BoParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(290)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == BoParserID {
+		{
+			p.SetState(279)
+			p.FieldValue()
+		}
+		p.SetState(284)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(280)
+					p.Match(BoParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(281)
+					p.FieldValue()
+				}
+
+			}
+			p.SetState(286)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(288)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserCOMMA {
+			{
+				p.SetState(287)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	}
+	{
+		p.SetState(292)
+		p.Match(BoParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IFieldValueContext is an interface to support dynamic dispatch.
+type IFieldValueContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ID() antlr.TerminalNode
+	COLON() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsFieldValueContext differentiates from other interfaces.
+	IsFieldValueContext()
+}
+
+type FieldValueContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFieldValueContext() *FieldValueContext {
+	var p = new(FieldValueContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_fieldValue
+	return p
+}
+
+func InitEmptyFieldValueContext(p *FieldValueContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_fieldValue
+}
+
+func (*FieldValueContext) IsFieldValueContext() {}
+
+func NewFieldValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldValueContext {
+	var p = new(FieldValueContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_fieldValue
+
+	return p
+}
+
+func (s *FieldValueContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldValueContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *FieldValueContext) COLON() antlr.TerminalNode {
+	return s.GetToken(BoParserCOLON, 0)
+}
+
+func (s *FieldValueContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *FieldValueContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FieldValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitFieldValue(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) FieldValue() (localctx IFieldValueContext) {
+	localctx = NewFieldValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, BoParserRULE_fieldValue)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(294)
+		p.Match(BoParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(295)
+		p.Match(BoParserCOLON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(296)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IEmbeddedExpressionContext is an interface to support dynamic dispatch.
+type IEmbeddedExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Expression() IExpressionContext
+	EOF() antlr.TerminalNode
+
+	// IsEmbeddedExpressionContext differentiates from other interfaces.
+	IsEmbeddedExpressionContext()
+}
+
+type EmbeddedExpressionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyEmbeddedExpressionContext() *EmbeddedExpressionContext {
+	var p = new(EmbeddedExpressionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_embeddedExpression
+	return p
+}
+
+func InitEmptyEmbeddedExpressionContext(p *EmbeddedExpressionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_embeddedExpression
+}
+
+func (*EmbeddedExpressionContext) IsEmbeddedExpressionContext() {}
+
+func NewEmbeddedExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EmbeddedExpressionContext {
+	var p = new(EmbeddedExpressionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_embeddedExpression
+
+	return p
+}
+
+func (s *EmbeddedExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EmbeddedExpressionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *EmbeddedExpressionContext) EOF() antlr.TerminalNode {
+	return s.GetToken(BoParserEOF, 0)
+}
+
+func (s *EmbeddedExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EmbeddedExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EmbeddedExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitEmbeddedExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) EmbeddedExpression() (localctx IEmbeddedExpressionContext) {
+	localctx = NewEmbeddedExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, BoParserRULE_embeddedExpression)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(298)
+		p.expression(0)
+	}
+	{
+		p.SetState(299)
+		p.Match(BoParserEOF)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IFunctionParametersContext is an interface to support dynamic dispatch.
+type IFunctionParametersContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LPAREN() antlr.TerminalNode
+	RPAREN() antlr.TerminalNode
+	AllArgument() []IArgumentContext
+	Argument(i int) IArgumentContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsFunctionParametersContext differentiates from other interfaces.
+	IsFunctionParametersContext()
+}
+
+type FunctionParametersContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFunctionParametersContext() *FunctionParametersContext {
+	var p = new(FunctionParametersContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_functionParameters
+	return p
+}
+
+func InitEmptyFunctionParametersContext(p *FunctionParametersContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_functionParameters
+}
+
+func (*FunctionParametersContext) IsFunctionParametersContext() {}
+
+func NewFunctionParametersContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionParametersContext {
+	var p = new(FunctionParametersContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_functionParameters
+
+	return p
+}
+
+func (s *FunctionParametersContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FunctionParametersContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserLPAREN, 0)
+}
+
+func (s *FunctionParametersContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserRPAREN, 0)
+}
+
+func (s *FunctionParametersContext) AllArgument() []IArgumentContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IArgumentContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IArgumentContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IArgumentContext); ok {
+			tst[i] = t.(IArgumentContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FunctionParametersContext) Argument(i int) IArgumentContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArgumentContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgumentContext)
+}
+
+func (s *FunctionParametersContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *FunctionParametersContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *FunctionParametersContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionParametersContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FunctionParametersContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitFunctionParameters(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) FunctionParameters() (localctx IFunctionParametersContext) {
+	localctx = NewFunctionParametersContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, BoParserRULE_functionParameters)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(301)
+		p.Match(BoParserLPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(310)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4755812786406686718) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&392473) != 0) {
+		{
+			p.SetState(302)
+			p.Argument()
+		}
+		p.SetState(307)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == BoParserCOMMA {
+			{
+				p.SetState(303)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(304)
+				p.Argument()
+			}
+
+			p.SetState(309)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	{
+		p.SetState(312)
+		p.Match(BoParserRPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IArgumentContext is an interface to support dynamic dispatch.
+type IArgumentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Expression() IExpressionContext
+	ID() antlr.TerminalNode
+	COLON() antlr.TerminalNode
+
+	// IsArgumentContext differentiates from other interfaces.
+	IsArgumentContext()
+}
+
+type ArgumentContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArgumentContext() *ArgumentContext {
+	var p = new(ArgumentContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_argument
+	return p
+}
+
+func InitEmptyArgumentContext(p *ArgumentContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_argument
+}
+
+func (*ArgumentContext) IsArgumentContext() {}
+
+func NewArgumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentContext {
+	var p = new(ArgumentContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_argument
+
+	return p
+}
+
+func (s *ArgumentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArgumentContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ArgumentContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *ArgumentContext) COLON() antlr.TerminalNode {
+	return s.GetToken(BoParserCOLON, 0)
+}
+
+func (s *ArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArgumentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArgumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitArgument(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) Argument() (localctx IArgumentContext) {
+	localctx = NewArgumentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, BoParserRULE_argument)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(316)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(314)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(315)
+			p.Match(BoParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	{
+		p.SetState(318)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IFunctionCallContext is an interface to support dynamic dispatch.
+type IFunctionCallContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ID() antlr.TerminalNode
+	FunctionParameters() IFunctionParametersContext
+	Expression() IExpressionContext
+	PERIOD() antlr.TerminalNode
+	SAFE_PERIOD() antlr.TerminalNode
+
+	// IsFunctionCallContext differentiates from other interfaces.
+	IsFunctionCallContext()
+}
+
+type FunctionCallContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFunctionCallContext() *FunctionCallContext {
+	var p = new(FunctionCallContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_functionCall
+	return p
+}
+
+func InitEmptyFunctionCallContext(p *FunctionCallContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_functionCall
+}
+
+func (*FunctionCallContext) IsFunctionCallContext() {}
+
+func NewFunctionCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallContext {
+	var p = new(FunctionCallContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_functionCall
+
+	return p
+}
+
+func (s *FunctionCallContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FunctionCallContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *FunctionCallContext) FunctionParameters() IFunctionParametersContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionParametersContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionParametersContext)
+}
+
+func (s *FunctionCallContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *FunctionCallContext) PERIOD() antlr.TerminalNode {
+	return s.GetToken(BoParserPERIOD, 0)
+}
+
+func (s *FunctionCallContext) SAFE_PERIOD() antlr.TerminalNode {
+	return s.GetToken(BoParserSAFE_PERIOD, 0)
+}
+
+func (s *FunctionCallContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FunctionCallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitFunctionCall(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) FunctionCall() (localctx IFunctionCallContext) {
+	localctx = NewFunctionCallContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, BoParserRULE_functionCall)
+	var _la int
+
+	p.SetState(327)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 22, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(320)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(321)
+			p.FunctionParameters()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(322)
+			p.expression(0)
+		}
+		{
+			p.SetState(323)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == BoParserPERIOD || _la == BoParserSAFE_PERIOD) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(324)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(325)
+			p.FunctionParameters()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IFunctionDeclarationContext is an interface to support dynamic dispatch.
+type IFunctionDeclarationContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	FUNC() antlr.TerminalNode
+	AllLPAREN() []antlr.TerminalNode
+	LPAREN(i int) antlr.TerminalNode
+	AllRPAREN() []antlr.TerminalNode
+	RPAREN(i int) antlr.TerminalNode
+	Block() IBlockContext
+	ID() antlr.TerminalNode
+	Operator() IOperatorContext
+	ASYNC() antlr.TerminalNode
+	Receiver() IReceiverContext
+	AllParameter() []IParameterContext
+	Parameter(i int) IParameterContext
+	TypeSpec() ITypeSpecContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsFunctionDeclarationContext differentiates from other interfaces.
+	IsFunctionDeclarationContext()
+}
+
+type FunctionDeclarationContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFunctionDeclarationContext() *FunctionDeclarationContext {
+	var p = new(FunctionDeclarationContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_functionDeclaration
+	return p
+}
+
+func InitEmptyFunctionDeclarationContext(p *FunctionDeclarationContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_functionDeclaration
+}
+
+func (*FunctionDeclarationContext) IsFunctionDeclarationContext() {}
+
+func NewFunctionDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionDeclarationContext {
+	var p = new(FunctionDeclarationContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_functionDeclaration
+
+	return p
+}
+
+func (s *FunctionDeclarationContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FunctionDeclarationContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(BoParserFUNC, 0)
+}
+
+func (s *FunctionDeclarationContext) AllLPAREN() []antlr.TerminalNode {
+	return s.GetTokens(BoParserLPAREN)
+}
+
+func (s *FunctionDeclarationContext) LPAREN(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserLPAREN, i)
+}
+
+func (s *FunctionDeclarationContext) AllRPAREN() []antlr.TerminalNode {
+	return s.GetTokens(BoParserRPAREN)
+}
+
+func (s *FunctionDeclarationContext) RPAREN(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserRPAREN, i)
+}
+
+func (s *FunctionDeclarationContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *FunctionDeclarationContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *FunctionDeclarationContext) Operator() IOperatorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOperatorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOperatorContext)
+}
+
+func (s *FunctionDeclarationContext) ASYNC() antlr.TerminalNode {
+	return s.GetToken(BoParserASYNC, 0)
+}
+
+func (s *FunctionDeclarationContext) Receiver() IReceiverContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IReceiverContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IReceiverContext)
+}
+
+func (s *FunctionDeclarationContext) AllParameter() []IParameterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IParameterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IParameterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IParameterContext); ok {
+			tst[i] = t.(IParameterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FunctionDeclarationContext) Parameter(i int) IParameterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParameterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParameterContext)
+}
+
+func (s *FunctionDeclarationContext) TypeSpec() ITypeSpecContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *FunctionDeclarationContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *FunctionDeclarationContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *FunctionDeclarationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FunctionDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitFunctionDeclaration(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) FunctionDeclaration() (localctx IFunctionDeclarationContext) {
+	localctx = NewFunctionDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, BoParserRULE_functionDeclaration)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(330)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == BoParserASYNC {
+		{
+			p.SetState(329)
+			p.Match(BoParserASYNC)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	{
+		p.SetState(332)
+		p.Match(BoParserFUNC)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(337)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == BoParserLPAREN {
+		{
+			p.SetState(333)
+			p.Match(BoParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(334)
+			p.Receiver()
+		}
+		{
+			p.SetState(335)
+			p.Match(BoParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	p.SetState(341)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case BoParserID:
+		{
+			p.SetState(339)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case BoParserT__0, BoParserEQ, BoParserLT, BoParserADD, BoParserSUB, BoParserMUL, BoParserDIV, BoParserMOD, BoParserLBRACK:
+		{
+			p.SetState(340)
+			p.Operator()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+	{
+		p.SetState(343)
+		p.Match(BoParserLPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(352)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4611765733020925950) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&262417) != 0) {
+		{
+			p.SetState(344)
+			p.Parameter()
+		}
+		p.SetState(349)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == BoParserCOMMA {
+			{
+				p.SetState(345)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(346)
+				p.Parameter()
+			}
+
+			p.SetState(351)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	{
+		p.SetState(354)
+		p.Match(BoParserRPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(356)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4611695364276748286) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&262417) != 0) {
+		{
+			p.SetState(355)
+			p.TypeSpec()
+		}
+
+	}
+	{
+		p.SetState(358)
+		p.Block()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IOperatorContext is an interface to support dynamic dispatch.
+type IOperatorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ADD() antlr.TerminalNode
+	SUB() antlr.TerminalNode
+	MUL() antlr.TerminalNode
+	DIV() antlr.TerminalNode
+	MOD() antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	LT() antlr.TerminalNode
+	LBRACK() antlr.TerminalNode
+	RBRACK() antlr.TerminalNode
+
+	// IsOperatorContext differentiates from other interfaces.
+	IsOperatorContext()
+}
+
+type OperatorContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOperatorContext() *OperatorContext {
+	var p = new(OperatorContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_operator
+	return p
+}
+
+func InitEmptyOperatorContext(p *OperatorContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_operator
+}
+
+func (*OperatorContext) IsOperatorContext() {}
+
+func NewOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OperatorContext {
+	var p = new(OperatorContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_operator
+
+	return p
+}
+
+func (s *OperatorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OperatorContext) ADD() antlr.TerminalNode {
+	return s.GetToken(BoParserADD, 0)
+}
+
+func (s *OperatorContext) SUB() antlr.TerminalNode {
+	return s.GetToken(BoParserSUB, 0)
+}
+
+func (s *OperatorContext) MUL() antlr.TerminalNode {
+	return s.GetToken(BoParserMUL, 0)
+}
+
+func (s *OperatorContext) DIV() antlr.TerminalNode {
+	return s.GetToken(BoParserDIV, 0)
+}
+
+func (s *OperatorContext) MOD() antlr.TerminalNode {
+	return s.GetToken(BoParserMOD, 0)
+}
+
+func (s *OperatorContext) EQ() antlr.TerminalNode {
+	return s.GetToken(BoParserEQ, 0)
+}
+
+func (s *OperatorContext) LT() antlr.TerminalNode {
+	return s.GetToken(BoParserLT, 0)
+}
+
+func (s *OperatorContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACK, 0)
+}
+
+func (s *OperatorContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACK, 0)
+}
+
+func (s *OperatorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitOperator(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) Operator() (localctx IOperatorContext) {
+	localctx = NewOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, BoParserRULE_operator)
+	p.SetState(370)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case BoParserADD:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(360)
+			p.Match(BoParserADD)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case BoParserSUB:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(361)
+			p.Match(BoParserSUB)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case BoParserMUL:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(362)
+			p.Match(BoParserMUL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case BoParserDIV:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(363)
+			p.Match(BoParserDIV)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case BoParserMOD:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(364)
+			p.Match(BoParserMOD)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case BoParserEQ:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(365)
+			p.Match(BoParserEQ)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case BoParserLT:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(366)
+			p.Match(BoParserLT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case BoParserLBRACK:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(367)
+			p.Match(BoParserLBRACK)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(368)
+			p.Match(BoParserRBRACK)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case BoParserT__0:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(369)
+			p.Match(BoParserT__0)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IReceiverContext is an interface to support dynamic dispatch.
+type IReceiverContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	TypeName() ITypeNameContext
+	ID() antlr.TerminalNode
+
+	// IsReceiverContext differentiates from other interfaces.
+	IsReceiverContext()
+}
+
+type ReceiverContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyReceiverContext() *ReceiverContext {
+	var p = new(ReceiverContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_receiver
+	return p
+}
+
+func InitEmptyReceiverContext(p *ReceiverContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_receiver
+}
+
+func (*ReceiverContext) IsReceiverContext() {}
+
+func NewReceiverContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReceiverContext {
+	var p = new(ReceiverContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_receiver
+
+	return p
+}
+
+func (s *ReceiverContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ReceiverContext) TypeName() ITypeNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeNameContext)
+}
+
+func (s *ReceiverContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *ReceiverContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ReceiverContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ReceiverContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitReceiver(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) Receiver() (localctx IReceiverContext) {
+	localctx = NewReceiverContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, BoParserRULE_receiver)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(372)
+		p.TypeName()
+	}
+	{
+		p.SetState(373)
+		p.Match(BoParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IParameterContext is an interface to support dynamic dispatch.
+type IParameterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	TypeSpec() ITypeSpecContext
+	ID() antlr.TerminalNode
+	ASSIGN() antlr.TerminalNode
+	Expression() IExpressionContext
+	ELLIPSIS() antlr.TerminalNode
+
+	// IsParameterContext differentiates from other interfaces.
+	IsParameterContext()
+}
+
+type ParameterContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyParameterContext() *ParameterContext {
+	var p = new(ParameterContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_parameter
+	return p
+}
+
+func InitEmptyParameterContext(p *ParameterContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_parameter
+}
+
+func (*ParameterContext) IsParameterContext() {}
+
+func NewParameterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParameterContext {
+	var p = new(ParameterContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_parameter
+
+	return p
+}
+
+func (s *ParameterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ParameterContext) TypeSpec() ITypeSpecContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *ParameterContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *ParameterContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(BoParserASSIGN, 0)
+}
+
+func (s *ParameterContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ParameterContext) ELLIPSIS() antlr.TerminalNode {
+	return s.GetToken(BoParserELLIPSIS, 0)
+}
+
+func (s *ParameterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParameterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ParameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitParameter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) Parameter() (localctx IParameterContext) {
+	localctx = NewParameterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, BoParserRULE_parameter)
+	var _la int
+
+	p.SetState(385)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case BoParserT__0, BoParserT__1, BoParserT__2, BoParserT__3, BoParserT__4, BoParserT__5, BoParserT__6, BoParserT__7, BoParserT__8, BoParserT__9, BoParserT__10, BoParserT__11, BoParserT__12, BoParserT__13, BoParserT__14, BoParserT__15, BoParserT__16, BoParserT__17, BoParserLPAREN, BoParserLBRACK, BoParserMAP, BoParserCHAN, BoParserFUTURE, BoParserITERATOR, BoParserID:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(375)
+			p.TypeSpec()
+		}
+		{
+			p.SetState(376)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(379)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserASSIGN {
+			{
+				p.SetState(377)
+				p.Match(BoParserASSIGN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(378)
+				p.expression(0)
+			}
+
+		}
+
+	case BoParserELLIPSIS:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(381)
+			p.Match(BoParserELLIPSIS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(382)
+			p.TypeSpec()
+		}
+		{
+			p.SetState(383)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IReturnStatementContext is an interface to support dynamic dispatch.
+type IReturnStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	RETURN() antlr.TerminalNode
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsReturnStatementContext differentiates from other interfaces.
+	IsReturnStatementContext()
+}
+
+type ReturnStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyReturnStatementContext() *ReturnStatementContext {
+	var p = new(ReturnStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_returnStatement
+	return p
+}
+
+func InitEmptyReturnStatementContext(p *ReturnStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_returnStatement
+}
+
+func (*ReturnStatementContext) IsReturnStatementContext() {}
+
+func NewReturnStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnStatementContext {
+	var p = new(ReturnStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_returnStatement
+
+	return p
+}
+
+func (s *ReturnStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ReturnStatementContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(BoParserRETURN, 0)
+}
+
+func (s *ReturnStatementContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ReturnStatementContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ReturnStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *ReturnStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *ReturnStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ReturnStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ReturnStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitReturnStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) ReturnStatement() (localctx IReturnStatementContext) {
+	localctx = NewReturnStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, BoParserRULE_returnStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(387)
+		p.Match(BoParserRETURN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(396)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 33, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(388)
+			p.expression(0)
+		}
+		p.SetState(393)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == BoParserCOMMA {
+			{
+				p.SetState(389)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(390)
+				p.expression(0)
+			}
+
+			p.SetState(395)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IYieldStatementContext is an interface to support dynamic dispatch.
+type IYieldStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	YIELD() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsYieldStatementContext differentiates from other interfaces.
+	IsYieldStatementContext()
+}
+
+type YieldStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyYieldStatementContext() *YieldStatementContext {
+	var p = new(YieldStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_yieldStatement
+	return p
+}
+
+func InitEmptyYieldStatementContext(p *YieldStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_yieldStatement
+}
+
+func (*YieldStatementContext) IsYieldStatementContext() {}
+
+func NewYieldStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *YieldStatementContext {
+	var p = new(YieldStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_yieldStatement
+
+	return p
+}
+
+func (s *YieldStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *YieldStatementContext) YIELD() antlr.TerminalNode {
+	return s.GetToken(BoParserYIELD, 0)
+}
+
+func (s *YieldStatementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *YieldStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *YieldStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *YieldStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitYieldStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) YieldStatement() (localctx IYieldStatementContext) {
+	localctx = NewYieldStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, BoParserRULE_yieldStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(398)
+		p.Match(BoParserYIELD)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(399)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IForStatementContext is an interface to support dynamic dispatch.
+type IForStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	FOR() antlr.TerminalNode
+	Pattern() IPatternContext
+	IN() antlr.TerminalNode
+	Expression() IExpressionContext
+	Block() IBlockContext
+
+	// IsForStatementContext differentiates from other interfaces.
+	IsForStatementContext()
+}
+
+type ForStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyForStatementContext() *ForStatementContext {
+	var p = new(ForStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_forStatement
+	return p
+}
+
+func InitEmptyForStatementContext(p *ForStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_forStatement
+}
+
+func (*ForStatementContext) IsForStatementContext() {}
+
+func NewForStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForStatementContext {
+	var p = new(ForStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_forStatement
+
+	return p
+}
+
+func (s *ForStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ForStatementContext) FOR() antlr.TerminalNode {
+	return s.GetToken(BoParserFOR, 0)
+}
+
+func (s *ForStatementContext) Pattern() IPatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPatternContext)
+}
+
+func (s *ForStatementContext) IN() antlr.TerminalNode {
+	return s.GetToken(BoParserIN, 0)
+}
+
+func (s *ForStatementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ForStatementContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ForStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ForStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ForStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitForStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) ForStatement() (localctx IForStatementContext) {
+	localctx = NewForStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, BoParserRULE_forStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(401)
+		p.Match(BoParserFOR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(402)
+		p.Pattern()
+	}
+	{
+		p.SetState(403)
+		p.Match(BoParserIN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(404)
+		p.expression(0)
+	}
+	{
+		p.SetState(405)
+		p.Block()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IDeferStatementContext is an interface to support dynamic dispatch.
+type IDeferStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	DEFER() antlr.TerminalNode
+	FunctionCall() IFunctionCallContext
+
+	// IsDeferStatementContext differentiates from other interfaces.
+	IsDeferStatementContext()
+}
+
+type DeferStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDeferStatementContext() *DeferStatementContext {
+	var p = new(DeferStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_deferStatement
+	return p
+}
+
+func InitEmptyDeferStatementContext(p *DeferStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_deferStatement
+}
+
+func (*DeferStatementContext) IsDeferStatementContext() {}
+
+func NewDeferStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeferStatementContext {
+	var p = new(DeferStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_deferStatement
+
+	return p
+}
+
+func (s *DeferStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DeferStatementContext) DEFER() antlr.TerminalNode {
+	return s.GetToken(BoParserDEFER, 0)
+}
+
+func (s *DeferStatementContext) FunctionCall() IFunctionCallContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionCallContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionCallContext)
+}
+
+func (s *DeferStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DeferStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DeferStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitDeferStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) DeferStatement() (localctx IDeferStatementContext) {
+	localctx = NewDeferStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, BoParserRULE_deferStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(407)
+		p.Match(BoParserDEFER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(408)
+		p.FunctionCall()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IEnumDeclarationContext is an interface to support dynamic dispatch.
+type IEnumDeclarationContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ENUM() antlr.TerminalNode
+	ID() antlr.TerminalNode
+	LBRACE() antlr.TerminalNode
+	AllEnumCase() []IEnumCaseContext
+	EnumCase(i int) IEnumCaseContext
+	RBRACE() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsEnumDeclarationContext differentiates from other interfaces.
+	IsEnumDeclarationContext()
+}
+
+type EnumDeclarationContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyEnumDeclarationContext() *EnumDeclarationContext {
+	var p = new(EnumDeclarationContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_enumDeclaration
+	return p
+}
+
+func InitEmptyEnumDeclarationContext(p *EnumDeclarationContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_enumDeclaration
+}
+
+func (*EnumDeclarationContext) IsEnumDeclarationContext() {}
+
+func NewEnumDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumDeclarationContext {
+	var p = new(EnumDeclarationContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_enumDeclaration
+
+	return p
+}
+
+func (s *EnumDeclarationContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EnumDeclarationContext) ENUM() antlr.TerminalNode {
+	return s.GetToken(BoParserENUM, 0)
+}
+
+func (s *EnumDeclarationContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *EnumDeclarationContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACE, 0)
+}
+
+func (s *EnumDeclarationContext) AllEnumCase() []IEnumCaseContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IEnumCaseContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IEnumCaseContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IEnumCaseContext); ok {
+			tst[i] = t.(IEnumCaseContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EnumDeclarationContext) EnumCase(i int) IEnumCaseContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEnumCaseContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumCaseContext)
+}
+
+func (s *EnumDeclarationContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACE, 0)
+}
+
+func (s *EnumDeclarationContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *EnumDeclarationContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *EnumDeclarationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EnumDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EnumDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitEnumDeclaration(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) EnumDeclaration() (localctx IEnumDeclarationContext) {
+	localctx = NewEnumDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, BoParserRULE_enumDeclaration)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(410)
+		p.Match(BoParserENUM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(411)
+		p.Match(BoParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(412)
+		p.Match(BoParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(413)
+		p.EnumCase()
+	}
+	p.SetState(418)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(414)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(415)
+				p.EnumCase()
+			}
+
+		}
+		p.SetState(420)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(422)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == BoParserCOMMA {
+		{
+			p.SetState(421)
+			p.Match(BoParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	{
+		p.SetState(424)
+		p.Match(BoParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IEnumCaseContext is an interface to support dynamic dispatch.
+type IEnumCaseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ID() antlr.TerminalNode
+	LPAREN() antlr.TerminalNode
+	AllTypeSpec() []ITypeSpecContext
+	TypeSpec(i int) ITypeSpecContext
+	RPAREN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsEnumCaseContext differentiates from other interfaces.
+	IsEnumCaseContext()
+}
+
+type EnumCaseContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyEnumCaseContext() *EnumCaseContext {
+	var p = new(EnumCaseContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_enumCase
+	return p
+}
+
+func InitEmptyEnumCaseContext(p *EnumCaseContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_enumCase
+}
+
+func (*EnumCaseContext) IsEnumCaseContext() {}
+
+func NewEnumCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumCaseContext {
+	var p = new(EnumCaseContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_enumCase
+
+	return p
+}
+
+func (s *EnumCaseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EnumCaseContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *EnumCaseContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserLPAREN, 0)
+}
+
+func (s *EnumCaseContext) AllTypeSpec() []ITypeSpecContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITypeSpecContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeSpecContext); ok {
+			tst[i] = t.(ITypeSpecContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EnumCaseContext) TypeSpec(i int) ITypeSpecContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *EnumCaseContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserRPAREN, 0)
+}
+
+func (s *EnumCaseContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *EnumCaseContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *EnumCaseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EnumCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EnumCaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitEnumCase(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) EnumCase() (localctx IEnumCaseContext) {
+	localctx = NewEnumCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, BoParserRULE_enumCase)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(426)
+		p.Match(BoParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(438)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == BoParserLPAREN {
+		{
+			p.SetState(427)
+			p.Match(BoParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(428)
+			p.TypeSpec()
+		}
+		p.SetState(433)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == BoParserCOMMA {
+			{
+				p.SetState(429)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(430)
+				p.TypeSpec()
+			}
+
+			p.SetState(435)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(436)
+			p.Match(BoParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IStructDeclarationContext is an interface to support dynamic dispatch.
+type IStructDeclarationContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	STRUCT() antlr.TerminalNode
+	ID() antlr.TerminalNode
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	AllStructField() []IStructFieldContext
+	StructField(i int) IStructFieldContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsStructDeclarationContext differentiates from other interfaces.
+	IsStructDeclarationContext()
+}
+
+type StructDeclarationContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStructDeclarationContext() *StructDeclarationContext {
+	var p = new(StructDeclarationContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_structDeclaration
+	return p
+}
+
+func InitEmptyStructDeclarationContext(p *StructDeclarationContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_structDeclaration
+}
+
+func (*StructDeclarationContext) IsStructDeclarationContext() {}
+
+func NewStructDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructDeclarationContext {
+	var p = new(StructDeclarationContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_structDeclaration
+
+	return p
+}
+
+func (s *StructDeclarationContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StructDeclarationContext) STRUCT() antlr.TerminalNode {
+	return s.GetToken(BoParserSTRUCT, 0)
+}
+
+func (s *StructDeclarationContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *StructDeclarationContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACE, 0)
+}
+
+func (s *StructDeclarationContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACE, 0)
+}
+
+func (s *StructDeclarationContext) AllStructField() []IStructFieldContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStructFieldContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStructFieldContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStructFieldContext); ok {
+			tst[i] = t.(IStructFieldContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *StructDeclarationContext) StructField(i int) IStructFieldContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructFieldContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructFieldContext)
+}
+
+func (s *StructDeclarationContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *StructDeclarationContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *StructDeclarationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StructDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StructDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitStructDeclaration(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) StructDeclaration() (localctx IStructDeclarationContext) {
+	localctx = NewStructDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, BoParserRULE_structDeclaration)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(440)
+		p.Match(BoParserSTRUCT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(441)
+		p.Match(BoParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(442)
+		p.Match(BoParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(449)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4611695364276748286) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&262417) != 0) {
+		{
+			p.SetState(443)
+			p.StructField()
+		}
+		p.SetState(445)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserCOMMA {
+			{
+				p.SetState(444)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+		p.SetState(451)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(452)
+		p.Match(BoParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IStructFieldContext is an interface to support dynamic dispatch.
+type IStructFieldContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	TypeSpec() ITypeSpecContext
+	ID() antlr.TerminalNode
+
+	// IsStructFieldContext differentiates from other interfaces.
+	IsStructFieldContext()
+}
+
+type StructFieldContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStructFieldContext() *StructFieldContext {
+	var p = new(StructFieldContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_structField
+	return p
+}
+
+func InitEmptyStructFieldContext(p *StructFieldContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_structField
+}
+
+func (*StructFieldContext) IsStructFieldContext() {}
+
+func NewStructFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructFieldContext {
+	var p = new(StructFieldContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_structField
+
+	return p
+}
+
+func (s *StructFieldContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StructFieldContext) TypeSpec() ITypeSpecContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *StructFieldContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *StructFieldContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StructFieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StructFieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitStructField(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) StructField() (localctx IStructFieldContext) {
+	localctx = NewStructFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, BoParserRULE_structField)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(454)
+		p.TypeSpec()
+	}
+	{
+		p.SetState(455)
+		p.Match(BoParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMatchArmContext is an interface to support dynamic dispatch.
+type IMatchArmContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Pattern() IPatternContext
+	ARROW() antlr.TerminalNode
+	Expression() IExpressionContext
+	Guard() IGuardContext
+
+	// IsMatchArmContext differentiates from other interfaces.
+	IsMatchArmContext()
+}
+
+type MatchArmContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMatchArmContext() *MatchArmContext {
+	var p = new(MatchArmContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_matchArm
+	return p
+}
+
+func InitEmptyMatchArmContext(p *MatchArmContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_matchArm
+}
+
+func (*MatchArmContext) IsMatchArmContext() {}
+
+func NewMatchArmContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchArmContext {
+	var p = new(MatchArmContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_matchArm
+
+	return p
+}
+
+func (s *MatchArmContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MatchArmContext) Pattern() IPatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPatternContext)
+}
+
+func (s *MatchArmContext) ARROW() antlr.TerminalNode {
+	return s.GetToken(BoParserARROW, 0)
+}
+
+func (s *MatchArmContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *MatchArmContext) Guard() IGuardContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGuardContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGuardContext)
+}
+
+func (s *MatchArmContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchArmContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MatchArmContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitMatchArm(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) MatchArm() (localctx IMatchArmContext) {
+	localctx = NewMatchArmContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, BoParserRULE_matchArm)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(457)
+		p.Pattern()
+	}
+	p.SetState(459)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == BoParserIF {
+		{
+			p.SetState(458)
+			p.Guard()
+		}
+
+	}
+	{
+		p.SetState(461)
+		p.Match(BoParserARROW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(462)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISwitchStatementContext is an interface to support dynamic dispatch.
+type ISwitchStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SWITCH() antlr.TerminalNode
+	Expression() IExpressionContext
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	AllSwitchArm() []ISwitchArmContext
+	SwitchArm(i int) ISwitchArmContext
+
+	// IsSwitchStatementContext differentiates from other interfaces.
+	IsSwitchStatementContext()
+}
+
+type SwitchStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySwitchStatementContext() *SwitchStatementContext {
+	var p = new(SwitchStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_switchStatement
+	return p
+}
+
+func InitEmptySwitchStatementContext(p *SwitchStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_switchStatement
+}
+
+func (*SwitchStatementContext) IsSwitchStatementContext() {}
+
+func NewSwitchStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchStatementContext {
+	var p = new(SwitchStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_switchStatement
+
+	return p
+}
+
+func (s *SwitchStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SwitchStatementContext) SWITCH() antlr.TerminalNode {
+	return s.GetToken(BoParserSWITCH, 0)
+}
+
+func (s *SwitchStatementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SwitchStatementContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACE, 0)
+}
+
+func (s *SwitchStatementContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACE, 0)
+}
+
+func (s *SwitchStatementContext) AllSwitchArm() []ISwitchArmContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISwitchArmContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISwitchArmContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISwitchArmContext); ok {
+			tst[i] = t.(ISwitchArmContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SwitchStatementContext) SwitchArm(i int) ISwitchArmContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISwitchArmContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitchArmContext)
+}
+
+func (s *SwitchStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SwitchStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SwitchStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitSwitchStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) SwitchStatement() (localctx ISwitchStatementContext) {
+	localctx = NewSwitchStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, BoParserRULE_switchStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(464)
+		p.Match(BoParserSWITCH)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(465)
+		p.expression(0)
+	}
+	{
+		p.SetState(466)
+		p.Match(BoParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(470)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == BoParserCASE {
+		{
+			p.SetState(467)
+			p.SwitchArm()
+		}
+
+		p.SetState(472)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(473)
+		p.Match(BoParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISwitchArmContext is an interface to support dynamic dispatch.
+type ISwitchArmContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	CASE() antlr.TerminalNode
+	Pattern() IPatternContext
+	Block() IBlockContext
+	Guard() IGuardContext
+
+	// IsSwitchArmContext differentiates from other interfaces.
+	IsSwitchArmContext()
+}
+
+type SwitchArmContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySwitchArmContext() *SwitchArmContext {
+	var p = new(SwitchArmContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_switchArm
+	return p
+}
+
+func InitEmptySwitchArmContext(p *SwitchArmContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_switchArm
+}
+
+func (*SwitchArmContext) IsSwitchArmContext() {}
+
+func NewSwitchArmContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchArmContext {
+	var p = new(SwitchArmContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_switchArm
+
+	return p
+}
+
+func (s *SwitchArmContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SwitchArmContext) CASE() antlr.TerminalNode {
+	return s.GetToken(BoParserCASE, 0)
+}
+
+func (s *SwitchArmContext) Pattern() IPatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPatternContext)
+}
+
+func (s *SwitchArmContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *SwitchArmContext) Guard() IGuardContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGuardContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGuardContext)
+}
+
+func (s *SwitchArmContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SwitchArmContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SwitchArmContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitSwitchArm(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) SwitchArm() (localctx ISwitchArmContext) {
+	localctx = NewSwitchArmContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, BoParserRULE_switchArm)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(475)
+		p.Match(BoParserCASE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(476)
+		p.Pattern()
+	}
+	p.SetState(478)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == BoParserIF {
+		{
+			p.SetState(477)
+			p.Guard()
+		}
+
+	}
+	{
+		p.SetState(480)
+		p.Block()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IGuardContext is an interface to support dynamic dispatch.
+type IGuardContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IF() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsGuardContext differentiates from other interfaces.
+	IsGuardContext()
+}
+
+type GuardContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGuardContext() *GuardContext {
+	var p = new(GuardContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_guard
+	return p
+}
+
+func InitEmptyGuardContext(p *GuardContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_guard
+}
+
+func (*GuardContext) IsGuardContext() {}
+
+func NewGuardContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GuardContext {
+	var p = new(GuardContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_guard
+
+	return p
+}
+
+func (s *GuardContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GuardContext) IF() antlr.TerminalNode {
+	return s.GetToken(BoParserIF, 0)
+}
+
+func (s *GuardContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *GuardContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GuardContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GuardContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitGuard(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) Guard() (localctx IGuardContext) {
+	localctx = NewGuardContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, BoParserRULE_guard)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(482)
+		p.Match(BoParserIF)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(483)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISpawnStatementContext is an interface to support dynamic dispatch.
+type ISpawnStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SPAWN() antlr.TerminalNode
+	FunctionCall() IFunctionCallContext
+
+	// IsSpawnStatementContext differentiates from other interfaces.
+	IsSpawnStatementContext()
+}
+
+type SpawnStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySpawnStatementContext() *SpawnStatementContext {
+	var p = new(SpawnStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_spawnStatement
+	return p
+}
+
+func InitEmptySpawnStatementContext(p *SpawnStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_spawnStatement
+}
+
+func (*SpawnStatementContext) IsSpawnStatementContext() {}
+
+func NewSpawnStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpawnStatementContext {
+	var p = new(SpawnStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_spawnStatement
+
+	return p
+}
+
+func (s *SpawnStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SpawnStatementContext) SPAWN() antlr.TerminalNode {
+	return s.GetToken(BoParserSPAWN, 0)
+}
+
+func (s *SpawnStatementContext) FunctionCall() IFunctionCallContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionCallContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionCallContext)
+}
+
+func (s *SpawnStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SpawnStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SpawnStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitSpawnStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) SpawnStatement() (localctx ISpawnStatementContext) {
+	localctx = NewSpawnStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, BoParserRULE_spawnStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(485)
+		p.Match(BoParserSPAWN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(486)
+		p.FunctionCall()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAwaitStatementContext is an interface to support dynamic dispatch.
+type IAwaitStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AWAIT() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsAwaitStatementContext differentiates from other interfaces.
+	IsAwaitStatementContext()
+}
+
+type AwaitStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAwaitStatementContext() *AwaitStatementContext {
+	var p = new(AwaitStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_awaitStatement
+	return p
+}
+
+func InitEmptyAwaitStatementContext(p *AwaitStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_awaitStatement
+}
+
+func (*AwaitStatementContext) IsAwaitStatementContext() {}
+
+func NewAwaitStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AwaitStatementContext {
+	var p = new(AwaitStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_awaitStatement
+
+	return p
+}
+
+func (s *AwaitStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AwaitStatementContext) AWAIT() antlr.TerminalNode {
+	return s.GetToken(BoParserAWAIT, 0)
+}
+
+func (s *AwaitStatementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AwaitStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AwaitStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AwaitStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitAwaitStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) AwaitStatement() (localctx IAwaitStatementContext) {
+	localctx = NewAwaitStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, BoParserRULE_awaitStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(488)
+		p.Match(BoParserAWAIT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(489)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISendStatementContext is an interface to support dynamic dispatch.
+type ISendStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	RECEIVE() antlr.TerminalNode
+
+	// IsSendStatementContext differentiates from other interfaces.
+	IsSendStatementContext()
+}
+
+type SendStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySendStatementContext() *SendStatementContext {
+	var p = new(SendStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_sendStatement
+	return p
+}
+
+func InitEmptySendStatementContext(p *SendStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_sendStatement
+}
+
+func (*SendStatementContext) IsSendStatementContext() {}
+
+func NewSendStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SendStatementContext {
+	var p = new(SendStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_sendStatement
+
+	return p
+}
+
+func (s *SendStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SendStatementContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SendStatementContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SendStatementContext) RECEIVE() antlr.TerminalNode {
+	return s.GetToken(BoParserRECEIVE, 0)
+}
+
+func (s *SendStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SendStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SendStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitSendStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) SendStatement() (localctx ISendStatementContext) {
+	localctx = NewSendStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, BoParserRULE_sendStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(491)
+		p.expression(0)
+	}
+	{
+		p.SetState(492)
+		p.Match(BoParserRECEIVE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(493)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISelectStatementContext is an interface to support dynamic dispatch.
+type ISelectStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SELECT() antlr.TerminalNode
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	AllSelectArm() []ISelectArmContext
+	SelectArm(i int) ISelectArmContext
+
+	// IsSelectStatementContext differentiates from other interfaces.
+	IsSelectStatementContext()
+}
+
+type SelectStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectStatementContext() *SelectStatementContext {
+	var p = new(SelectStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_selectStatement
+	return p
+}
+
+func InitEmptySelectStatementContext(p *SelectStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_selectStatement
+}
+
+func (*SelectStatementContext) IsSelectStatementContext() {}
+
+func NewSelectStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectStatementContext {
+	var p = new(SelectStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_selectStatement
+
+	return p
+}
+
+func (s *SelectStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectStatementContext) SELECT() antlr.TerminalNode {
+	return s.GetToken(BoParserSELECT, 0)
+}
+
+func (s *SelectStatementContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACE, 0)
+}
+
+func (s *SelectStatementContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACE, 0)
+}
+
+func (s *SelectStatementContext) AllSelectArm() []ISelectArmContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISelectArmContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISelectArmContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISelectArmContext); ok {
+			tst[i] = t.(ISelectArmContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SelectStatementContext) SelectArm(i int) ISelectArmContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectArmContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectArmContext)
+}
+
+func (s *SelectStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SelectStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitSelectStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) SelectStatement() (localctx ISelectStatementContext) {
+	localctx = NewSelectStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, BoParserRULE_selectStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(495)
+		p.Match(BoParserSELECT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(496)
+		p.Match(BoParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(500)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == BoParserCASE || _la == BoParserDEFAULT {
+		{
+			p.SetState(497)
+			p.SelectArm()
+		}
+
+		p.SetState(502)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(503)
+		p.Match(BoParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISelectArmContext is an interface to support dynamic dispatch.
+type ISelectArmContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsSelectArmContext differentiates from other interfaces.
+	IsSelectArmContext()
+}
+
+type SelectArmContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectArmContext() *SelectArmContext {
+	var p = new(SelectArmContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_selectArm
+	return p
+}
+
+func InitEmptySelectArmContext(p *SelectArmContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_selectArm
+}
+
+func (*SelectArmContext) IsSelectArmContext() {}
+
+func NewSelectArmContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectArmContext {
+	var p = new(SelectArmContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_selectArm
+
+	return p
+}
+
+func (s *SelectArmContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectArmContext) CopyAll(ctx *SelectArmContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *SelectArmContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectArmContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type ReceiveArmContext struct {
+	SelectArmContext
+}
+
+func NewReceiveArmContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ReceiveArmContext {
+	var p = new(ReceiveArmContext)
+
+	InitEmptySelectArmContext(&p.SelectArmContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*SelectArmContext))
+
+	return p
+}
+
+func (s *ReceiveArmContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ReceiveArmContext) CASE() antlr.TerminalNode {
+	return s.GetToken(BoParserCASE, 0)
+}
+
+func (s *ReceiveArmContext) RECEIVE() antlr.TerminalNode {
+	return s.GetToken(BoParserRECEIVE, 0)
+}
+
+func (s *ReceiveArmContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ReceiveArmContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ReceiveArmContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *ReceiveArmContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(BoParserASSIGN, 0)
+}
+
+func (s *ReceiveArmContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitReceiveArm(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type SendArmContext struct {
+	SelectArmContext
+}
+
+func NewSendArmContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SendArmContext {
+	var p = new(SendArmContext)
+
+	InitEmptySelectArmContext(&p.SelectArmContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*SelectArmContext))
+
+	return p
+}
+
+func (s *SendArmContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SendArmContext) CASE() antlr.TerminalNode {
+	return s.GetToken(BoParserCASE, 0)
+}
+
+func (s *SendArmContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SendArmContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SendArmContext) RECEIVE() antlr.TerminalNode {
+	return s.GetToken(BoParserRECEIVE, 0)
+}
+
+func (s *SendArmContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *SendArmContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitSendArm(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type DefaultArmContext struct {
+	SelectArmContext
+}
+
+func NewDefaultArmContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DefaultArmContext {
+	var p = new(DefaultArmContext)
+
+	InitEmptySelectArmContext(&p.SelectArmContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*SelectArmContext))
+
+	return p
+}
+
+func (s *DefaultArmContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DefaultArmContext) DEFAULT() antlr.TerminalNode {
+	return s.GetToken(BoParserDEFAULT, 0)
+}
+
+func (s *DefaultArmContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *DefaultArmContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitDefaultArm(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) SelectArm() (localctx ISelectArmContext) {
+	localctx = NewSelectArmContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, BoParserRULE_selectArm)
+	var _la int
+
+	p.SetState(522)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 45, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewReceiveArmContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(505)
+			p.Match(BoParserCASE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(508)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserID {
+			{
+				p.SetState(506)
+				p.Match(BoParserID)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(507)
+				p.Match(BoParserASSIGN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(510)
+			p.Match(BoParserRECEIVE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(511)
+			p.expression(0)
+		}
+		{
+			p.SetState(512)
+			p.Block()
+		}
+
+	case 2:
+		localctx = NewSendArmContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(514)
+			p.Match(BoParserCASE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(515)
+			p.expression(0)
+		}
+		{
+			p.SetState(516)
+			p.Match(BoParserRECEIVE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(517)
+			p.expression(0)
+		}
+		{
+			p.SetState(518)
+			p.Block()
+		}
+
+	case 3:
+		localctx = NewDefaultArmContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(520)
+			p.Match(BoParserDEFAULT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(521)
+			p.Block()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPatternContext is an interface to support dynamic dispatch.
+type IPatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsPatternContext differentiates from other interfaces.
+	IsPatternContext()
+}
+
+type PatternContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPatternContext() *PatternContext {
+	var p = new(PatternContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_pattern
+	return p
+}
+
+func InitEmptyPatternContext(p *PatternContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_pattern
+}
+
+func (*PatternContext) IsPatternContext() {}
+
+func NewPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PatternContext {
+	var p = new(PatternContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_pattern
+
+	return p
+}
+
+func (s *PatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PatternContext) CopyAll(ctx *PatternContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *PatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type WildcardPatternContext struct {
+	PatternContext
+}
+
+func NewWildcardPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *WildcardPatternContext {
+	var p = new(WildcardPatternContext)
+
+	InitEmptyPatternContext(&p.PatternContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PatternContext))
+
+	return p
+}
+
+func (s *WildcardPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WildcardPatternContext) UNDERSCORE() antlr.TerminalNode {
+	return s.GetToken(BoParserUNDERSCORE, 0)
+}
+
+func (s *WildcardPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitWildcardPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ListPatternContext struct {
+	PatternContext
+}
+
+func NewListPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ListPatternContext {
+	var p = new(ListPatternContext)
+
+	InitEmptyPatternContext(&p.PatternContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PatternContext))
+
+	return p
+}
+
+func (s *ListPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ListPatternContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACK, 0)
+}
+
+func (s *ListPatternContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACK, 0)
+}
+
+func (s *ListPatternContext) AllPattern() []IPatternContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IPatternContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IPatternContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IPatternContext); ok {
+			tst[i] = t.(IPatternContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ListPatternContext) Pattern(i int) IPatternContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPatternContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPatternContext)
+}
+
+func (s *ListPatternContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *ListPatternContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *ListPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitListPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type RestPatternContext struct {
+	PatternContext
+}
+
+func NewRestPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RestPatternContext {
+	var p = new(RestPatternContext)
+
+	InitEmptyPatternContext(&p.PatternContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PatternContext))
+
+	return p
+}
+
+func (s *RestPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RestPatternContext) ELLIPSIS() antlr.TerminalNode {
+	return s.GetToken(BoParserELLIPSIS, 0)
+}
+
+func (s *RestPatternContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *RestPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitRestPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type TuplePatternContext struct {
+	PatternContext
+}
+
+func NewTuplePatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TuplePatternContext {
+	var p = new(TuplePatternContext)
+
+	InitEmptyPatternContext(&p.PatternContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PatternContext))
+
+	return p
+}
+
+func (s *TuplePatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TuplePatternContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserLPAREN, 0)
+}
+
+func (s *TuplePatternContext) AllPattern() []IPatternContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IPatternContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IPatternContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IPatternContext); ok {
+			tst[i] = t.(IPatternContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TuplePatternContext) Pattern(i int) IPatternContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPatternContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPatternContext)
+}
+
+func (s *TuplePatternContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserRPAREN, 0)
+}
+
+func (s *TuplePatternContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *TuplePatternContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *TuplePatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitTuplePattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type StructPatternContext struct {
+	PatternContext
+}
+
+func NewStructPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StructPatternContext {
+	var p = new(StructPatternContext)
+
+	InitEmptyPatternContext(&p.PatternContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PatternContext))
+
+	return p
+}
+
+func (s *StructPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StructPatternContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *StructPatternContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACE, 0)
+}
+
+func (s *StructPatternContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACE, 0)
+}
+
+func (s *StructPatternContext) AllFieldPattern() []IFieldPatternContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFieldPatternContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFieldPatternContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFieldPatternContext); ok {
+			tst[i] = t.(IFieldPatternContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *StructPatternContext) FieldPattern(i int) IFieldPatternContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldPatternContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldPatternContext)
+}
+
+func (s *StructPatternContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *StructPatternContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *StructPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitStructPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LiteralPatternContext struct {
+	PatternContext
+}
+
+func NewLiteralPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LiteralPatternContext {
+	var p = new(LiteralPatternContext)
+
+	InitEmptyPatternContext(&p.PatternContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PatternContext))
+
+	return p
+}
+
+func (s *LiteralPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LiteralPatternContext) STRING() antlr.TerminalNode {
+	return s.GetToken(BoParserSTRING, 0)
+}
+
+func (s *LiteralPatternContext) BOOL() antlr.TerminalNode {
+	return s.GetToken(BoParserBOOL, 0)
+}
+
+func (s *LiteralPatternContext) NIL() antlr.TerminalNode {
+	return s.GetToken(BoParserNIL, 0)
+}
+
+func (s *LiteralPatternContext) INT() antlr.TerminalNode {
+	return s.GetToken(BoParserINT, 0)
+}
+
+func (s *LiteralPatternContext) FLOAT() antlr.TerminalNode {
+	return s.GetToken(BoParserFLOAT, 0)
+}
+
+func (s *LiteralPatternContext) BIGINT() antlr.TerminalNode {
+	return s.GetToken(BoParserBIGINT, 0)
+}
+
+func (s *LiteralPatternContext) DECIMAL() antlr.TerminalNode {
+	return s.GetToken(BoParserDECIMAL, 0)
+}
+
+func (s *LiteralPatternContext) SUB() antlr.TerminalNode {
+	return s.GetToken(BoParserSUB, 0)
+}
+
+func (s *LiteralPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitLiteralPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type BindingPatternContext struct {
+	PatternContext
+}
+
+func NewBindingPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BindingPatternContext {
+	var p = new(BindingPatternContext)
+
+	InitEmptyPatternContext(&p.PatternContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PatternContext))
+
+	return p
+}
+
+func (s *BindingPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BindingPatternContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *BindingPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitBindingPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type CasePatternContext struct {
+	PatternContext
+}
+
+func NewCasePatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CasePatternContext {
+	var p = new(CasePatternContext)
+
+	InitEmptyPatternContext(&p.PatternContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PatternContext))
+
+	return p
+}
+
+func (s *CasePatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CasePatternContext) PERIOD() antlr.TerminalNode {
+	return s.GetToken(BoParserPERIOD, 0)
+}
+
+func (s *CasePatternContext) AllID() []antlr.TerminalNode {
+	return s.GetTokens(BoParserID)
+}
+
+func (s *CasePatternContext) ID(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserID, i)
+}
+
+func (s *CasePatternContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserLPAREN, 0)
+}
+
+func (s *CasePatternContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserRPAREN, 0)
+}
+
+func (s *CasePatternContext) AllPattern() []IPatternContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IPatternContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IPatternContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IPatternContext); ok {
+			tst[i] = t.(IPatternContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CasePatternContext) Pattern(i int) IPatternContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPatternContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPatternContext)
+}
+
+func (s *CasePatternContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *CasePatternContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *CasePatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitCasePattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type MapPatternContext struct {
+	PatternContext
+}
+
+func NewMapPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MapPatternContext {
+	var p = new(MapPatternContext)
+
+	InitEmptyPatternContext(&p.PatternContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PatternContext))
+
+	return p
+}
+
+func (s *MapPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MapPatternContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACE, 0)
+}
+
+func (s *MapPatternContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACE, 0)
+}
+
+func (s *MapPatternContext) AllEntryPattern() []IEntryPatternContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IEntryPatternContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IEntryPatternContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IEntryPatternContext); ok {
+			tst[i] = t.(IEntryPatternContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MapPatternContext) EntryPattern(i int) IEntryPatternContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEntryPatternContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEntryPatternContext)
+}
+
+func (s *MapPatternContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *MapPatternContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *MapPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitMapPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) Pattern() (localctx IPatternContext) {
+	localctx = NewPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, BoParserRULE_pattern)
+	var _la int
+
+	p.SetState(605)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 60, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewWildcardPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(524)
+			p.Match(BoParserUNDERSCORE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		localctx = NewLiteralPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(532)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case BoParserSUB, BoParserINT, BoParserFLOAT, BoParserBIGINT, BoParserDECIMAL:
+			p.SetState(526)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == BoParserSUB {
+				{
+					p.SetState(525)
+					p.Match(BoParserSUB)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			}
+			{
+				p.SetState(528)
+				_la = p.GetTokenStream().LA(1)
+
+				if !((int64((_la-78)) & ^0x3f) == 0 && ((int64(1)<<(_la-78))&15) != 0) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+
+		case BoParserSTRING:
+			{
+				p.SetState(529)
+				p.Match(BoParserSTRING)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case BoParserBOOL:
+			{
+				p.SetState(530)
+				p.Match(BoParserBOOL)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case BoParserNIL:
+			{
+				p.SetState(531)
+				p.Match(BoParserNIL)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
+
+	case 3:
+		localctx = NewCasePatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		p.SetState(535)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserID {
+			{
+				p.SetState(534)
+				p.Match(BoParserID)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(537)
+			p.Match(BoParserPERIOD)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(538)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(551)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserLPAREN {
+			{
+				p.SetState(539)
+				p.Match(BoParserLPAREN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			p.SetState(548)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if (int64((_la-29)) & ^0x3f) == 0 && ((int64(1)<<(_la-29))&287667426199491585) != 0 {
+				{
+					p.SetState(540)
+					p.Pattern()
+				}
+				p.SetState(545)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+
+				for _la == BoParserCOMMA {
+					{
+						p.SetState(541)
+						p.Match(BoParserCOMMA)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+					{
+						p.SetState(542)
+						p.Pattern()
+					}
+
+					p.SetState(547)
+					p.GetErrorHandler().Sync(p)
+					if p.HasError() {
+						goto errorExit
+					}
+					_la = p.GetTokenStream().LA(1)
+				}
+
+			}
+			{
+				p.SetState(550)
+				p.Match(BoParserRPAREN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	case 4:
+		localctx = NewListPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(553)
+			p.Match(BoParserLBRACK)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(562)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64((_la-29)) & ^0x3f) == 0 && ((int64(1)<<(_la-29))&287667426199491585) != 0 {
+			{
+				p.SetState(554)
+				p.Pattern()
+			}
+			p.SetState(559)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == BoParserCOMMA {
+				{
+					p.SetState(555)
+					p.Match(BoParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(556)
+					p.Pattern()
+				}
+
+				p.SetState(561)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(564)
+			p.Match(BoParserRBRACK)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 5:
+		localctx = NewRestPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(565)
+			p.Match(BoParserELLIPSIS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(567)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserID {
+			{
+				p.SetState(566)
+				p.Match(BoParserID)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	case 6:
+		localctx = NewMapPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(569)
+			p.Match(BoParserLBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(578)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64((_la-29)) & ^0x3f) == 0 && ((int64(1)<<(_la-29))&287667426199491585) != 0 {
+			{
+				p.SetState(570)
+				p.EntryPattern()
+			}
+			p.SetState(575)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == BoParserCOMMA {
+				{
+					p.SetState(571)
+					p.Match(BoParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(572)
+					p.EntryPattern()
+				}
+
+				p.SetState(577)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(580)
+			p.Match(BoParserRBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 7:
+		localctx = NewTuplePatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(581)
+			p.Match(BoParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(582)
+			p.Pattern()
+		}
+		p.SetState(585)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == BoParserCOMMA {
+			{
+				p.SetState(583)
+				p.Match(BoParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(584)
+				p.Pattern()
+			}
+
+			p.SetState(587)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(589)
+			p.Match(BoParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 8:
+		localctx = NewStructPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(591)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(592)
+			p.Match(BoParserLBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(601)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == BoParserID {
+			{
+				p.SetState(593)
+				p.FieldPattern()
+			}
+			p.SetState(598)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == BoParserCOMMA {
+				{
+					p.SetState(594)
+					p.Match(BoParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(595)
+					p.FieldPattern()
+				}
+
+				p.SetState(600)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(603)
+			p.Match(BoParserRBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 9:
+		localctx = NewBindingPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(604)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IEntryPatternContext is an interface to support dynamic dispatch.
+type IEntryPatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllPattern() []IPatternContext
+	Pattern(i int) IPatternContext
+	COLON() antlr.TerminalNode
+
+	// IsEntryPatternContext differentiates from other interfaces.
+	IsEntryPatternContext()
+}
+
+type EntryPatternContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyEntryPatternContext() *EntryPatternContext {
+	var p = new(EntryPatternContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_entryPattern
+	return p
+}
+
+func InitEmptyEntryPatternContext(p *EntryPatternContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_entryPattern
+}
+
+func (*EntryPatternContext) IsEntryPatternContext() {}
+
+func NewEntryPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EntryPatternContext {
+	var p = new(EntryPatternContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_entryPattern
+
+	return p
+}
+
+func (s *EntryPatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EntryPatternContext) AllPattern() []IPatternContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IPatternContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IPatternContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IPatternContext); ok {
+			tst[i] = t.(IPatternContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EntryPatternContext) Pattern(i int) IPatternContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPatternContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPatternContext)
+}
+
+func (s *EntryPatternContext) COLON() antlr.TerminalNode {
+	return s.GetToken(BoParserCOLON, 0)
+}
+
+func (s *EntryPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EntryPatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EntryPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitEntryPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) EntryPattern() (localctx IEntryPatternContext) {
+	localctx = NewEntryPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, BoParserRULE_entryPattern)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(607)
+		p.Pattern()
+	}
+	{
+		p.SetState(608)
+		p.Match(BoParserCOLON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(609)
+		p.Pattern()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IFieldPatternContext is an interface to support dynamic dispatch.
+type IFieldPatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ID() antlr.TerminalNode
+	COLON() antlr.TerminalNode
+	Pattern() IPatternContext
+
+	// IsFieldPatternContext differentiates from other interfaces.
+	IsFieldPatternContext()
+}
+
+type FieldPatternContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFieldPatternContext() *FieldPatternContext {
+	var p = new(FieldPatternContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_fieldPattern
+	return p
+}
+
+func InitEmptyFieldPatternContext(p *FieldPatternContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_fieldPattern
+}
+
+func (*FieldPatternContext) IsFieldPatternContext() {}
+
+func NewFieldPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldPatternContext {
+	var p = new(FieldPatternContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_fieldPattern
+
+	return p
+}
+
+func (s *FieldPatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldPatternContext) ID() antlr.TerminalNode {
+	return s.GetToken(BoParserID, 0)
+}
+
+func (s *FieldPatternContext) COLON() antlr.TerminalNode {
+	return s.GetToken(BoParserCOLON, 0)
+}
+
+func (s *FieldPatternContext) Pattern() IPatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPatternContext)
+}
+
+func (s *FieldPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldPatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FieldPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitFieldPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) FieldPattern() (localctx IFieldPatternContext) {
+	localctx = NewFieldPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, BoParserRULE_fieldPattern)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(611)
+		p.Match(BoParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(614)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == BoParserCOLON {
+		{
+			p.SetState(612)
+			p.Match(BoParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(613)
+			p.Pattern()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IVariableDeclarationContext is an interface to support dynamic dispatch.
+type IVariableDeclarationContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllTypeSpec() []ITypeSpecContext
+	TypeSpec(i int) ITypeSpecContext
+	AllID() []antlr.TerminalNode
+	ID(i int) antlr.TerminalNode
+	ASSIGN() antlr.TerminalNode
+	Expression() IExpressionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsVariableDeclarationContext differentiates from other interfaces.
+	IsVariableDeclarationContext()
+}
+
+type VariableDeclarationContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyVariableDeclarationContext() *VariableDeclarationContext {
+	var p = new(VariableDeclarationContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_variableDeclaration
+	return p
+}
+
+func InitEmptyVariableDeclarationContext(p *VariableDeclarationContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_variableDeclaration
+}
+
+func (*VariableDeclarationContext) IsVariableDeclarationContext() {}
+
+func NewVariableDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableDeclarationContext {
+	var p = new(VariableDeclarationContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_variableDeclaration
+
+	return p
+}
+
+func (s *VariableDeclarationContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *VariableDeclarationContext) AllTypeSpec() []ITypeSpecContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITypeSpecContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeSpecContext); ok {
+			tst[i] = t.(ITypeSpecContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *VariableDeclarationContext) TypeSpec(i int) ITypeSpecContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *VariableDeclarationContext) AllID() []antlr.TerminalNode {
+	return s.GetTokens(BoParserID)
+}
+
+func (s *VariableDeclarationContext) ID(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserID, i)
+}
+
+func (s *VariableDeclarationContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(BoParserASSIGN, 0)
+}
+
+func (s *VariableDeclarationContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *VariableDeclarationContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *VariableDeclarationContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *VariableDeclarationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VariableDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *VariableDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitVariableDeclaration(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) VariableDeclaration() (localctx IVariableDeclarationContext) {
+	localctx = NewVariableDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, BoParserRULE_variableDeclaration)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(616)
+		p.TypeSpec()
+	}
+	{
+		p.SetState(617)
+		p.Match(BoParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(624)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == BoParserCOMMA {
+		{
+			p.SetState(618)
+			p.Match(BoParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(619)
+			p.TypeSpec()
+		}
+		{
+			p.SetState(620)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(626)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(627)
+		p.Match(BoParserASSIGN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(628)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IDestructuringDeclarationContext is an interface to support dynamic dispatch.
+type IDestructuringDeclarationContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Pattern() IPatternContext
+	ASSIGN() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsDestructuringDeclarationContext differentiates from other interfaces.
+	IsDestructuringDeclarationContext()
+}
+
+type DestructuringDeclarationContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDestructuringDeclarationContext() *DestructuringDeclarationContext {
+	var p = new(DestructuringDeclarationContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_destructuringDeclaration
+	return p
+}
+
+func InitEmptyDestructuringDeclarationContext(p *DestructuringDeclarationContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_destructuringDeclaration
+}
+
+func (*DestructuringDeclarationContext) IsDestructuringDeclarationContext() {}
+
+func NewDestructuringDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DestructuringDeclarationContext {
+	var p = new(DestructuringDeclarationContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_destructuringDeclaration
+
+	return p
+}
+
+func (s *DestructuringDeclarationContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DestructuringDeclarationContext) Pattern() IPatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPatternContext)
+}
+
+func (s *DestructuringDeclarationContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(BoParserASSIGN, 0)
+}
+
+func (s *DestructuringDeclarationContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *DestructuringDeclarationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DestructuringDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DestructuringDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitDestructuringDeclaration(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) DestructuringDeclaration() (localctx IDestructuringDeclarationContext) {
+	localctx = NewDestructuringDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, BoParserRULE_destructuringDeclaration)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(630)
+		p.Pattern()
+	}
+	{
+		p.SetState(631)
+		p.Match(BoParserASSIGN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(632)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITypeSpecContext is an interface to support dynamic dispatch.
+type ITypeSpecContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	BasicType() IBasicTypeContext
+	TypeName() ITypeNameContext
+	ListType() IListTypeContext
+	MapType() IMapTypeContext
+	TupleType() ITupleTypeContext
+	ChanType() IChanTypeContext
+	FutureType() IFutureTypeContext
+	IteratorType() IIteratorTypeContext
+	QUESTION() antlr.TerminalNode
+
+	// IsTypeSpecContext differentiates from other interfaces.
+	IsTypeSpecContext()
+}
+
+type TypeSpecContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeSpecContext() *TypeSpecContext {
+	var p = new(TypeSpecContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_typeSpec
+	return p
+}
+
+func InitEmptyTypeSpecContext(p *TypeSpecContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_typeSpec
+}
+
+func (*TypeSpecContext) IsTypeSpecContext() {}
+
+func NewTypeSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSpecContext {
+	var p = new(TypeSpecContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_typeSpec
+
+	return p
+}
+
+func (s *TypeSpecContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeSpecContext) BasicType() IBasicTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBasicTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBasicTypeContext)
+}
+
+func (s *TypeSpecContext) TypeName() ITypeNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeNameContext)
+}
+
+func (s *TypeSpecContext) ListType() IListTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IListTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IListTypeContext)
+}
+
+func (s *TypeSpecContext) MapType() IMapTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMapTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMapTypeContext)
+}
+
+func (s *TypeSpecContext) TupleType() ITupleTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITupleTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITupleTypeContext)
+}
+
+func (s *TypeSpecContext) ChanType() IChanTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IChanTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IChanTypeContext)
+}
+
+func (s *TypeSpecContext) FutureType() IFutureTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFutureTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFutureTypeContext)
+}
+
+func (s *TypeSpecContext) IteratorType() IIteratorTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIteratorTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIteratorTypeContext)
+}
+
+func (s *TypeSpecContext) QUESTION() antlr.TerminalNode {
+	return s.GetToken(BoParserQUESTION, 0)
+}
+
+func (s *TypeSpecContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeSpecContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitTypeSpec(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) TypeSpec() (localctx ITypeSpecContext) {
+	localctx = NewTypeSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, BoParserRULE_typeSpec)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(642)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case BoParserT__0, BoParserT__1, BoParserT__2, BoParserT__3, BoParserT__4, BoParserT__5, BoParserT__6, BoParserT__7, BoParserT__8, BoParserT__9, BoParserT__10, BoParserT__11, BoParserT__12, BoParserT__13, BoParserT__14, BoParserT__15, BoParserT__16, BoParserT__17:
+		{
+			p.SetState(634)
+			p.BasicType()
+		}
+
+	case BoParserID:
+		{
+			p.SetState(635)
+			p.TypeName()
+		}
+
+	case BoParserLBRACK:
+		{
+			p.SetState(636)
+			p.ListType()
+		}
+
+	case BoParserMAP:
+		{
+			p.SetState(637)
+			p.MapType()
+		}
+
+	case BoParserLPAREN:
+		{
+			p.SetState(638)
+			p.TupleType()
+		}
+
+	case BoParserCHAN:
+		{
+			p.SetState(639)
+			p.ChanType()
+		}
+
+	case BoParserFUTURE:
+		{
+			p.SetState(640)
+			p.FutureType()
+		}
+
+	case BoParserITERATOR:
+		{
+			p.SetState(641)
+			p.IteratorType()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+	p.SetState(645)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 64, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(644)
+			p.Match(BoParserQUESTION)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITypeNameContext is an interface to support dynamic dispatch.
+type ITypeNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllID() []antlr.TerminalNode
+	ID(i int) antlr.TerminalNode
+	PERIOD() antlr.TerminalNode
+
+	// IsTypeNameContext differentiates from other interfaces.
+	IsTypeNameContext()
+}
+
+type TypeNameContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeNameContext() *TypeNameContext {
+	var p = new(TypeNameContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_typeName
+	return p
+}
+
+func InitEmptyTypeNameContext(p *TypeNameContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_typeName
+}
+
+func (*TypeNameContext) IsTypeNameContext() {}
+
+func NewTypeNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeNameContext {
+	var p = new(TypeNameContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_typeName
+
+	return p
+}
+
+func (s *TypeNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeNameContext) AllID() []antlr.TerminalNode {
+	return s.GetTokens(BoParserID)
+}
+
+func (s *TypeNameContext) ID(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserID, i)
+}
+
+func (s *TypeNameContext) PERIOD() antlr.TerminalNode {
+	return s.GetToken(BoParserPERIOD, 0)
+}
+
+func (s *TypeNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitTypeName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) TypeName() (localctx ITypeNameContext) {
+	localctx = NewTypeNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, BoParserRULE_typeName)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(647)
+		p.Match(BoParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(650)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == BoParserPERIOD {
+		{
+			p.SetState(648)
+			p.Match(BoParserPERIOD)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(649)
+			p.Match(BoParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IListTypeContext is an interface to support dynamic dispatch.
+type IListTypeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LBRACK() antlr.TerminalNode
+	RBRACK() antlr.TerminalNode
+	TypeSpec() ITypeSpecContext
+
+	// IsListTypeContext differentiates from other interfaces.
+	IsListTypeContext()
+}
+
+type ListTypeContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyListTypeContext() *ListTypeContext {
+	var p = new(ListTypeContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_listType
+	return p
+}
+
+func InitEmptyListTypeContext(p *ListTypeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_listType
+}
+
+func (*ListTypeContext) IsListTypeContext() {}
+
+func NewListTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ListTypeContext {
+	var p = new(ListTypeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_listType
+
+	return p
+}
+
+func (s *ListTypeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ListTypeContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACK, 0)
+}
+
+func (s *ListTypeContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACK, 0)
+}
+
+func (s *ListTypeContext) TypeSpec() ITypeSpecContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *ListTypeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ListTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ListTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case BoVisitor:
+		return t.VisitListType(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *BoParser) ListType() (localctx IListTypeContext) {
+	localctx = NewListTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, BoParserRULE_listType)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(652)
+		p.Match(BoParserLBRACK)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(653)
+		p.Match(BoParserRBRACK)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(654)
+		p.TypeSpec()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMapTypeContext is an interface to support dynamic dispatch.
+type IMapTypeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MAP() antlr.TerminalNode
+	LBRACK() antlr.TerminalNode
+	AllTypeSpec() []ITypeSpecContext
+	TypeSpec(i int) ITypeSpecContext
+	RBRACK() antlr.TerminalNode
+
+	// IsMapTypeContext differentiates from other interfaces.
+	IsMapTypeContext()
+}
+
+type MapTypeContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMapTypeContext() *MapTypeContext {
+	var p = new(MapTypeContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_mapType
+	return p
+}
+
+func InitEmptyMapTypeContext(p *MapTypeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = BoParserRULE_mapType
+}
+
+func (*MapTypeContext) IsMapTypeContext() {}
+
+func NewMapTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapTypeContext {
+	var p = new(MapTypeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = BoParserRULE_mapType
+
+	return p
+}
+
+func (s *MapTypeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MapTypeContext) MAP() antlr.TerminalNode {
+	return s.GetToken(BoParserMAP, 0)
+}
+
+func (s *MapTypeContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACK, 0)
+}
+
+func (s *MapTypeContext) AllTypeSpec() []ITypeSpecContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITypeSpecContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeSpecContext); ok {
+			tst[i] = t.(ITypeSpecContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *StatementContext) FunctionCall() IFunctionCallContext {
+func (s *MapTypeContext) TypeSpec(i int) ITypeSpecContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFunctionCallContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -406,61 +11884,66 @@ func (s *StatementContext) FunctionCall() IFunctionCallContext {
 		return nil
 	}
 
-	return t.(IFunctionCallContext)
+	return t.(ITypeSpecContext)
 }
 
-func (s *StatementContext) GetRuleContext() antlr.RuleContext {
+func (s *MapTypeContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACK, 0)
+}
+
+func (s *MapTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MapTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MapTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case BoVisitor:
-		return t.VisitStatement(s)
+		return t.VisitMapType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *BoParser) Statement() (localctx IStatementContext) {
-	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 2, BoParserRULE_statement)
-	p.SetState(29)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetTokenStream().LA(1) {
-	case BoParserREQUIRE:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(26)
-			p.RequireStatement()
+func (p *BoParser) MapType() (localctx IMapTypeContext) {
+	localctx = NewMapTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, BoParserRULE_mapType)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(656)
+		p.Match(BoParserMAP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case BoParserT__0, BoParserT__1, BoParserT__2, BoParserT__3:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(27)
-			p.VariableDeclaration()
+	}
+	{
+		p.SetState(657)
+		p.Match(BoParserLBRACK)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case BoParserINT, BoParserFLOAT, BoParserBOOL, BoParserSTRING, BoParserID:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(28)
-			p.FunctionCall()
+	}
+	{
+		p.SetState(658)
+		p.TypeSpec()
+	}
+	{
+		p.SetState(659)
+		p.Match(BoParserRBRACK)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
+	}
+	{
+		p.SetState(660)
+		p.TypeSpec()
 	}
 
 errorExit:
@@ -476,109 +11959,131 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IExpressionContext is an interface to support dynamic dispatch.
-type IExpressionContext interface {
+// IChanTypeContext is an interface to support dynamic dispatch.
+type IChanTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	INT() antlr.TerminalNode
-	FLOAT() antlr.TerminalNode
-	STRING() antlr.TerminalNode
-	BOOL() antlr.TerminalNode
-	ID() antlr.TerminalNode
+	CHAN() antlr.TerminalNode
+	LBRACK() antlr.TerminalNode
+	TypeSpec() ITypeSpecContext
+	RBRACK() antlr.TerminalNode
 
-	// IsExpressionContext differentiates from other interfaces.
-	IsExpressionContext()
+	// IsChanTypeContext differentiates from other interfaces.
+	IsChanTypeContext()
 }
 
-type ExpressionContext struct {
+type ChanTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionContext() *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEmptyChanTypeContext() *ChanTypeContext {
+	var p = new(ChanTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = BoParserRULE_expression
+	p.RuleIndex = BoParserRULE_chanType
 	return p
 }
 
-func InitEmptyExpressionContext(p *ExpressionContext) {
+func InitEmptyChanTypeContext(p *ChanTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = BoParserRULE_expression
+	p.RuleIndex = BoParserRULE_chanType
 }
 
-func (*ExpressionContext) IsExpressionContext() {}
+func (*ChanTypeContext) IsChanTypeContext() {}
 
-func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewChanTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChanTypeContext {
+	var p = new(ChanTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = BoParserRULE_expression
+	p.RuleIndex = BoParserRULE_chanType
 
 	return p
 }
 
-func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *ChanTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionContext) INT() antlr.TerminalNode {
-	return s.GetToken(BoParserINT, 0)
+func (s *ChanTypeContext) CHAN() antlr.TerminalNode {
+	return s.GetToken(BoParserCHAN, 0)
 }
 
-func (s *ExpressionContext) FLOAT() antlr.TerminalNode {
-	return s.GetToken(BoParserFLOAT, 0)
+func (s *ChanTypeContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACK, 0)
 }
 
-func (s *ExpressionContext) STRING() antlr.TerminalNode {
-	return s.GetToken(BoParserSTRING, 0)
-}
+func (s *ChanTypeContext) TypeSpec() ITypeSpecContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *ExpressionContext) BOOL() antlr.TerminalNode {
-	return s.GetToken(BoParserBOOL, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
 }
 
-func (s *ExpressionContext) ID() antlr.TerminalNode {
-	return s.GetToken(BoParserID, 0)
+func (s *ChanTypeContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACK, 0)
 }
 
-func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *ChanTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ChanTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ChanTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case BoVisitor:
-		return t.VisitExpression(s)
+		return t.VisitChanType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *BoParser) Expression() (localctx IExpressionContext) {
-	localctx = NewExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 4, BoParserRULE_expression)
-	var _la int
-
+func (p *BoParser) ChanType() (localctx IChanTypeContext) {
+	localctx = NewChanTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, BoParserRULE_chanType)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(31)
-		_la = p.GetTokenStream().LA(1)
-
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4063232) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+		p.SetState(662)
+		p.Match(BoParserCHAN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(663)
+		p.Match(BoParserLBRACK)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(664)
+		p.TypeSpec()
+	}
+	{
+		p.SetState(665)
+		p.Match(BoParserRBRACK)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
 
@@ -595,96 +12100,69 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFunctionParametersContext is an interface to support dynamic dispatch.
-type IFunctionParametersContext interface {
+// IFutureTypeContext is an interface to support dynamic dispatch.
+type IFutureTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LPAREN() antlr.TerminalNode
-	RPAREN() antlr.TerminalNode
-	AllExpression() []IExpressionContext
-	Expression(i int) IExpressionContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	FUTURE() antlr.TerminalNode
+	LBRACK() antlr.TerminalNode
+	TypeSpec() ITypeSpecContext
+	RBRACK() antlr.TerminalNode
 
-	// IsFunctionParametersContext differentiates from other interfaces.
-	IsFunctionParametersContext()
+	// IsFutureTypeContext differentiates from other interfaces.
+	IsFutureTypeContext()
 }
 
-type FunctionParametersContext struct {
+type FutureTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionParametersContext() *FunctionParametersContext {
-	var p = new(FunctionParametersContext)
+func NewEmptyFutureTypeContext() *FutureTypeContext {
+	var p = new(FutureTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = BoParserRULE_functionParameters
+	p.RuleIndex = BoParserRULE_futureType
 	return p
 }
 
-func InitEmptyFunctionParametersContext(p *FunctionParametersContext) {
+func InitEmptyFutureTypeContext(p *FutureTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = BoParserRULE_functionParameters
+	p.RuleIndex = BoParserRULE_futureType
 }
 
-func (*FunctionParametersContext) IsFunctionParametersContext() {}
+func (*FutureTypeContext) IsFutureTypeContext() {}
 
-func NewFunctionParametersContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionParametersContext {
-	var p = new(FunctionParametersContext)
+func NewFutureTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FutureTypeContext {
+	var p = new(FutureTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = BoParserRULE_functionParameters
+	p.RuleIndex = BoParserRULE_futureType
 
 	return p
 }
 
-func (s *FunctionParametersContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *FunctionParametersContext) LPAREN() antlr.TerminalNode {
-	return s.GetToken(BoParserLPAREN, 0)
-}
+func (s *FutureTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionParametersContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(BoParserRPAREN, 0)
+func (s *FutureTypeContext) FUTURE() antlr.TerminalNode {
+	return s.GetToken(BoParserFUTURE, 0)
 }
 
-func (s *FunctionParametersContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
-	}
-
-	return tst
+func (s *FutureTypeContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACK, 0)
 }
 
-func (s *FunctionParametersContext) Expression(i int) IExpressionContext {
+func (s *FutureTypeContext) TypeSpec() ITypeSpecContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -692,94 +12170,58 @@ func (s *FunctionParametersContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
-}
-
-func (s *FunctionParametersContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(BoParserCOMMA)
+	return t.(ITypeSpecContext)
 }
 
-func (s *FunctionParametersContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(BoParserCOMMA, i)
+func (s *FutureTypeContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACK, 0)
 }
 
-func (s *FunctionParametersContext) GetRuleContext() antlr.RuleContext {
+func (s *FutureTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionParametersContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FutureTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionParametersContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FutureTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case BoVisitor:
-		return t.VisitFunctionParameters(s)
+		return t.VisitFutureType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *BoParser) FunctionParameters() (localctx IFunctionParametersContext) {
-	localctx = NewFunctionParametersContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, BoParserRULE_functionParameters)
-	var _la int
-
+func (p *BoParser) FutureType() (localctx IFutureTypeContext) {
+	localctx = NewFutureTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, BoParserRULE_futureType)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(33)
-		p.Match(BoParserLPAREN)
+		p.SetState(667)
+		p.Match(BoParserFUTURE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(42)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4063232) != 0 {
-		{
-			p.SetState(34)
-			p.Expression()
-		}
-		p.SetState(39)
-		p.GetErrorHandler().Sync(p)
+	{
+		p.SetState(668)
+		p.Match(BoParserLBRACK)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == BoParserCOMMA {
-			{
-				p.SetState(35)
-				p.Match(BoParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(36)
-				p.Expression()
-			}
-
-			p.SetState(41)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-		}
-
 	}
 	{
-		p.SetState(44)
-		p.Match(BoParserRPAREN)
+		p.SetState(669)
+		p.TypeSpec()
+	}
+	{
+		p.SetState(670)
+		p.Match(BoParserRBRACK)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -799,79 +12241,67 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFunctionCallContext is an interface to support dynamic dispatch.
-type IFunctionCallContext interface {
+// IIteratorTypeContext is an interface to support dynamic dispatch.
+type IIteratorTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ID() antlr.TerminalNode
-	FunctionParameters() IFunctionParametersContext
-	Expression() IExpressionContext
-	PERIOD() antlr.TerminalNode
+	ITERATOR() antlr.TerminalNode
+	LBRACK() antlr.TerminalNode
+	TypeSpec() ITypeSpecContext
+	RBRACK() antlr.TerminalNode
 
-	// IsFunctionCallContext differentiates from other interfaces.
-	IsFunctionCallContext()
+	// IsIteratorTypeContext differentiates from other interfaces.
+	IsIteratorTypeContext()
 }
 
-type FunctionCallContext struct {
+type IteratorTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionCallContext() *FunctionCallContext {
-	var p = new(FunctionCallContext)
+func NewEmptyIteratorTypeContext() *IteratorTypeContext {
+	var p = new(IteratorTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = BoParserRULE_functionCall
+	p.RuleIndex = BoParserRULE_iteratorType
 	return p
 }
 
-func InitEmptyFunctionCallContext(p *FunctionCallContext) {
+func InitEmptyIteratorTypeContext(p *IteratorTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = BoParserRULE_functionCall
+	p.RuleIndex = BoParserRULE_iteratorType
 }
 
-func (*FunctionCallContext) IsFunctionCallContext() {}
+func (*IteratorTypeContext) IsIteratorTypeContext() {}
 
-func NewFunctionCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallContext {
-	var p = new(FunctionCallContext)
+func NewIteratorTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IteratorTypeContext {
+	var p = new(IteratorTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = BoParserRULE_functionCall
+	p.RuleIndex = BoParserRULE_iteratorType
 
 	return p
 }
 
-func (s *FunctionCallContext) GetParser() antlr.Parser { return s.parser }
+func (s *IteratorTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionCallContext) ID() antlr.TerminalNode {
-	return s.GetToken(BoParserID, 0)
+func (s *IteratorTypeContext) ITERATOR() antlr.TerminalNode {
+	return s.GetToken(BoParserITERATOR, 0)
 }
 
-func (s *FunctionCallContext) FunctionParameters() IFunctionParametersContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFunctionParametersContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFunctionParametersContext)
+func (s *IteratorTypeContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserLBRACK, 0)
 }
 
-func (s *FunctionCallContext) Expression() IExpressionContext {
+func (s *IteratorTypeContext) TypeSpec() ITypeSpecContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(ITypeSpecContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -881,85 +12311,62 @@ func (s *FunctionCallContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ITypeSpecContext)
 }
 
-func (s *FunctionCallContext) PERIOD() antlr.TerminalNode {
-	return s.GetToken(BoParserPERIOD, 0)
+func (s *IteratorTypeContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(BoParserRBRACK, 0)
 }
 
-func (s *FunctionCallContext) GetRuleContext() antlr.RuleContext {
+func (s *IteratorTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IteratorTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionCallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IteratorTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case BoVisitor:
-		return t.VisitFunctionCall(s)
+		return t.VisitIteratorType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *BoParser) FunctionCall() (localctx IFunctionCallContext) {
-	localctx = NewFunctionCallContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, BoParserRULE_functionCall)
-	p.SetState(53)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 4, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(46)
-			p.Match(BoParserID)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(47)
-			p.FunctionParameters()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(48)
-			p.Expression()
-		}
-		{
-			p.SetState(49)
-			p.Match(BoParserPERIOD)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+func (p *BoParser) IteratorType() (localctx IIteratorTypeContext) {
+	localctx = NewIteratorTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, BoParserRULE_iteratorType)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(672)
+		p.Match(BoParserITERATOR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-		{
-			p.SetState(50)
-			p.Match(BoParserID)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+	}
+	{
+		p.SetState(673)
+		p.Match(BoParserLBRACK)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-		{
-			p.SetState(51)
-			p.FunctionParameters()
+	}
+	{
+		p.SetState(674)
+		p.TypeSpec()
+	}
+	{
+		p.SetState(675)
+		p.Match(BoParserRBRACK)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -975,85 +12382,92 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IVariableDeclarationContext is an interface to support dynamic dispatch.
-type IVariableDeclarationContext interface {
+// ITupleTypeContext is an interface to support dynamic dispatch.
+type ITupleTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	TypeSpec() ITypeSpecContext
-	ID() antlr.TerminalNode
-	ASSIGN() antlr.TerminalNode
-	Expression() IExpressionContext
+	LPAREN() antlr.TerminalNode
+	AllTypeSpec() []ITypeSpecContext
+	TypeSpec(i int) ITypeSpecContext
+	RPAREN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsVariableDeclarationContext differentiates from other interfaces.
-	IsVariableDeclarationContext()
+	// IsTupleTypeContext differentiates from other interfaces.
+	IsTupleTypeContext()
 }
 
-type VariableDeclarationContext struct {
+type TupleTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyVariableDeclarationContext() *VariableDeclarationContext {
-	var p = new(VariableDeclarationContext)
+func NewEmptyTupleTypeContext() *TupleTypeContext {
+	var p = new(TupleTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = BoParserRULE_variableDeclaration
+	p.RuleIndex = BoParserRULE_tupleType
 	return p
 }
 
-func InitEmptyVariableDeclarationContext(p *VariableDeclarationContext) {
+func InitEmptyTupleTypeContext(p *TupleTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = BoParserRULE_variableDeclaration
+	p.RuleIndex = BoParserRULE_tupleType
 }
 
-func (*VariableDeclarationContext) IsVariableDeclarationContext() {}
+func (*TupleTypeContext) IsTupleTypeContext() {}
 
-func NewVariableDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableDeclarationContext {
-	var p = new(VariableDeclarationContext)
+func NewTupleTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TupleTypeContext {
+	var p = new(TupleTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = BoParserRULE_variableDeclaration
+	p.RuleIndex = BoParserRULE_tupleType
 
 	return p
 }
 
-func (s *VariableDeclarationContext) GetParser() antlr.Parser { return s.parser }
+func (s *TupleTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *VariableDeclarationContext) TypeSpec() ITypeSpecContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
+func (s *TupleTypeContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserLPAREN, 0)
+}
+
+func (s *TupleTypeContext) AllTypeSpec() []ITypeSpecContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
 		if _, ok := ctx.(ITypeSpecContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]ITypeSpecContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeSpecContext); ok {
+			tst[i] = t.(ITypeSpecContext)
+			i++
+		}
 	}
 
-	return t.(ITypeSpecContext)
-}
-
-func (s *VariableDeclarationContext) ID() antlr.TerminalNode {
-	return s.GetToken(BoParserID, 0)
-}
-
-func (s *VariableDeclarationContext) ASSIGN() antlr.TerminalNode {
-	return s.GetToken(BoParserASSIGN, 0)
+	return tst
 }
 
-func (s *VariableDeclarationContext) Expression() IExpressionContext {
+func (s *TupleTypeContext) TypeSpec(i int) ITypeSpecContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ITypeSpecContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -1061,54 +12475,92 @@ func (s *VariableDeclarationContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ITypeSpecContext)
 }
 
-func (s *VariableDeclarationContext) GetRuleContext() antlr.RuleContext {
+func (s *TupleTypeContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(BoParserRPAREN, 0)
+}
+
+func (s *TupleTypeContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(BoParserCOMMA)
+}
+
+func (s *TupleTypeContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserCOMMA, i)
+}
+
+func (s *TupleTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *VariableDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TupleTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *VariableDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TupleTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case BoVisitor:
-		return t.VisitVariableDeclaration(s)
+		return t.VisitTupleType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *BoParser) VariableDeclaration() (localctx IVariableDeclarationContext) {
-	localctx = NewVariableDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, BoParserRULE_variableDeclaration)
+func (p *BoParser) TupleType() (localctx ITupleTypeContext) {
+	localctx = NewTupleTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, BoParserRULE_tupleType)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(55)
-		p.TypeSpec()
-	}
-	{
-		p.SetState(56)
-		p.Match(BoParserID)
+		p.SetState(677)
+		p.Match(BoParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(57)
-		p.Match(BoParserASSIGN)
+		p.SetState(678)
+		p.TypeSpec()
+	}
+	p.SetState(681)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == BoParserCOMMA {
+		{
+			p.SetState(679)
+			p.Match(BoParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(680)
+			p.TypeSpec()
+		}
+
+		p.SetState(683)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(58)
-		p.Expression()
+		p.SetState(685)
+		p.Match(BoParserRPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -1124,76 +12576,76 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITypeSpecContext is an interface to support dynamic dispatch.
-type ITypeSpecContext interface {
+// IBasicTypeContext is an interface to support dynamic dispatch.
+type IBasicTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsTypeSpecContext differentiates from other interfaces.
-	IsTypeSpecContext()
+	// IsBasicTypeContext differentiates from other interfaces.
+	IsBasicTypeContext()
 }
 
-type TypeSpecContext struct {
+type BasicTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeSpecContext() *TypeSpecContext {
-	var p = new(TypeSpecContext)
+func NewEmptyBasicTypeContext() *BasicTypeContext {
+	var p = new(BasicTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = BoParserRULE_typeSpec
+	p.RuleIndex = BoParserRULE_basicType
 	return p
 }
 
-func InitEmptyTypeSpecContext(p *TypeSpecContext) {
+func InitEmptyBasicTypeContext(p *BasicTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = BoParserRULE_typeSpec
+	p.RuleIndex = BoParserRULE_basicType
 }
 
-func (*TypeSpecContext) IsTypeSpecContext() {}
+func (*BasicTypeContext) IsBasicTypeContext() {}
 
-func NewTypeSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSpecContext {
-	var p = new(TypeSpecContext)
+func NewBasicTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BasicTypeContext {
+	var p = new(BasicTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = BoParserRULE_typeSpec
+	p.RuleIndex = BoParserRULE_basicType
 
 	return p
 }
 
-func (s *TypeSpecContext) GetParser() antlr.Parser { return s.parser }
-func (s *TypeSpecContext) GetRuleContext() antlr.RuleContext {
+func (s *BasicTypeContext) GetParser() antlr.Parser { return s.parser }
+func (s *BasicTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BasicTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeSpecContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BasicTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case BoVisitor:
-		return t.VisitTypeSpec(s)
+		return t.VisitBasicType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *BoParser) TypeSpec() (localctx ITypeSpecContext) {
-	localctx = NewTypeSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, BoParserRULE_typeSpec)
+func (p *BoParser) BasicType() (localctx IBasicTypeContext) {
+	localctx = NewBasicTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, BoParserRULE_basicType)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(60)
+		p.SetState(687)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&30) != 0) {
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&524286) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -1301,10 +12753,10 @@ func (s *RequireStatementContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *BoParser) RequireStatement() (localctx IRequireStatementContext) {
 	localctx = NewRequireStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, BoParserRULE_requireStatement)
+	p.EnterRule(localctx, 100, BoParserRULE_requireStatement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(62)
+		p.SetState(689)
 		p.Match(BoParserREQUIRE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1312,7 +12764,7 @@ func (p *BoParser) RequireStatement() (localctx IRequireStatementContext) {
 		}
 	}
 	{
-		p.SetState(63)
+		p.SetState(690)
 		p.ImportPath()
 	}
 
@@ -1341,6 +12793,8 @@ type IImportPathContext interface {
 	AllID() []antlr.TerminalNode
 	ID(i int) antlr.TerminalNode
 	GT() antlr.TerminalNode
+	AllDIV() []antlr.TerminalNode
+	DIV(i int) antlr.TerminalNode
 	STRING() antlr.TerminalNode
 
 	// IsImportPathContext differentiates from other interfaces.
@@ -1395,6 +12849,14 @@ func (s *ImportPathContext) GT() antlr.TerminalNode {
 	return s.GetToken(BoParserGT, 0)
 }
 
+func (s *ImportPathContext) AllDIV() []antlr.TerminalNode {
+	return s.GetTokens(BoParserDIV)
+}
+
+func (s *ImportPathContext) DIV(i int) antlr.TerminalNode {
+	return s.GetToken(BoParserDIV, i)
+}
+
 func (s *ImportPathContext) STRING() antlr.TerminalNode {
 	return s.GetToken(BoParserSTRING, 0)
 }
@@ -1419,10 +12881,10 @@ func (s *ImportPathContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *BoParser) ImportPath() (localctx IImportPathContext) {
 	localctx = NewImportPathContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, BoParserRULE_importPath)
+	p.EnterRule(localctx, 102, BoParserRULE_importPath)
 	var _la int
 
-	p.SetState(76)
+	p.SetState(703)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1432,7 +12894,7 @@ func (p *BoParser) ImportPath() (localctx IImportPathContext) {
 	case BoParserLT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(65)
+			p.SetState(692)
 			p.Match(BoParserLT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1440,31 +12902,31 @@ func (p *BoParser) ImportPath() (localctx IImportPathContext) {
 			}
 		}
 		{
-			p.SetState(66)
+			p.SetState(693)
 			p.Match(BoParserID)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(71)
+		p.SetState(698)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		for _la == BoParserT__4 {
+		for _la == BoParserDIV {
 			{
-				p.SetState(67)
-				p.Match(BoParserT__4)
+				p.SetState(694)
+				p.Match(BoParserDIV)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
 			{
-				p.SetState(68)
+				p.SetState(695)
 				p.Match(BoParserID)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -1472,7 +12934,7 @@ func (p *BoParser) ImportPath() (localctx IImportPathContext) {
 				}
 			}
 
-			p.SetState(73)
+			p.SetState(700)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -1480,7 +12942,7 @@ func (p *BoParser) ImportPath() (localctx IImportPathContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(74)
+			p.SetState(701)
 			p.Match(BoParserGT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1491,7 +12953,7 @@ func (p *BoParser) ImportPath() (localctx IImportPathContext) {
 	case BoParserSTRING:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(75)
+			p.SetState(702)
 			p.Match(BoParserSTRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1516,3 +12978,60 @@ errorExit:
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
+
+func (p *BoParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
+	switch ruleIndex {
+	case 3:
+		var t *ExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*ExpressionContext)
+		}
+		return p.Expression_Sempred(t, predIndex)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(ruleIndex))
+	}
+}
+
+func (p *BoParser) Expression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 0:
+		return p.Precpred(p.GetParserRuleContext(), 9)
+
+	case 1:
+		return p.Precpred(p.GetParserRuleContext(), 8)
+
+	case 2:
+		return p.Precpred(p.GetParserRuleContext(), 7)
+
+	case 3:
+		return p.Precpred(p.GetParserRuleContext(), 6)
+
+	case 4:
+		return p.Precpred(p.GetParserRuleContext(), 5)
+
+	case 5:
+		return p.Precpred(p.GetParserRuleContext(), 4)
+
+	case 6:
+		return p.Precpred(p.GetParserRuleContext(), 3)
+
+	case 7:
+		return p.Precpred(p.GetParserRuleContext(), 2)
+
+	case 8:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	case 9:
+		return p.Precpred(p.GetParserRuleContext(), 15)
+
+	case 10:
+		return p.Precpred(p.GetParserRuleContext(), 14)
+
+	case 11:
+		return p.Precpred(p.GetParserRuleContext(), 13)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
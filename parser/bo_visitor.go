@@ -13,21 +13,231 @@ type BoVisitor interface {
 	// Visit a parse tree produced by BoParser#statement.
 	VisitStatement(ctx *StatementContext) interface{}
 
-	// Visit a parse tree produced by BoParser#expression.
-	VisitExpression(ctx *ExpressionContext) interface{}
+	// Visit a parse tree produced by BoParser#block.
+	VisitBlock(ctx *BlockContext) interface{}
+
+	// Visit a parse tree produced by BoParser#primaryExpression.
+	VisitPrimaryExpression(ctx *PrimaryExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#matchExpression.
+	VisitMatchExpression(ctx *MatchExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#conversionExpression.
+	VisitConversionExpression(ctx *ConversionExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#unaryExpression.
+	VisitUnaryExpression(ctx *UnaryExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#receiveExpression.
+	VisitReceiveExpression(ctx *ReceiveExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#awaitExpression.
+	VisitAwaitExpression(ctx *AwaitExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#multiplicativeExpression.
+	VisitMultiplicativeExpression(ctx *MultiplicativeExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#additiveExpression.
+	VisitAdditiveExpression(ctx *AdditiveExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#rangeExpression.
+	VisitRangeExpression(ctx *RangeExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#stepExpression.
+	VisitStepExpression(ctx *StepExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#relationalExpression.
+	VisitRelationalExpression(ctx *RelationalExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#equalityExpression.
+	VisitEqualityExpression(ctx *EqualityExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#andExpression.
+	VisitAndExpression(ctx *AndExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#orExpression.
+	VisitOrExpression(ctx *OrExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#coalesceExpression.
+	VisitCoalesceExpression(ctx *CoalesceExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#memberExpression.
+	VisitMemberExpression(ctx *MemberExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#callExpression.
+	VisitCallExpression(ctx *CallExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#indexExpression.
+	VisitIndexExpression(ctx *IndexExpressionContext) interface{}
+
+	// Visit a parse tree produced by BoParser#primary.
+	VisitPrimary(ctx *PrimaryContext) interface{}
+
+	// Visit a parse tree produced by BoParser#listLiteral.
+	VisitListLiteral(ctx *ListLiteralContext) interface{}
+
+	// Visit a parse tree produced by BoParser#mapLiteral.
+	VisitMapLiteral(ctx *MapLiteralContext) interface{}
+
+	// Visit a parse tree produced by BoParser#mapEntry.
+	VisitMapEntry(ctx *MapEntryContext) interface{}
+
+	// Visit a parse tree produced by BoParser#tupleLiteral.
+	VisitTupleLiteral(ctx *TupleLiteralContext) interface{}
+
+	// Visit a parse tree produced by BoParser#structLiteral.
+	VisitStructLiteral(ctx *StructLiteralContext) interface{}
+
+	// Visit a parse tree produced by BoParser#fieldValue.
+	VisitFieldValue(ctx *FieldValueContext) interface{}
+
+	// Visit a parse tree produced by BoParser#embeddedExpression.
+	VisitEmbeddedExpression(ctx *EmbeddedExpressionContext) interface{}
 
 	// Visit a parse tree produced by BoParser#functionParameters.
 	VisitFunctionParameters(ctx *FunctionParametersContext) interface{}
 
+	// Visit a parse tree produced by BoParser#argument.
+	VisitArgument(ctx *ArgumentContext) interface{}
+
 	// Visit a parse tree produced by BoParser#functionCall.
 	VisitFunctionCall(ctx *FunctionCallContext) interface{}
 
+	// Visit a parse tree produced by BoParser#functionDeclaration.
+	VisitFunctionDeclaration(ctx *FunctionDeclarationContext) interface{}
+
+	// Visit a parse tree produced by BoParser#operator.
+	VisitOperator(ctx *OperatorContext) interface{}
+
+	// Visit a parse tree produced by BoParser#receiver.
+	VisitReceiver(ctx *ReceiverContext) interface{}
+
+	// Visit a parse tree produced by BoParser#parameter.
+	VisitParameter(ctx *ParameterContext) interface{}
+
+	// Visit a parse tree produced by BoParser#returnStatement.
+	VisitReturnStatement(ctx *ReturnStatementContext) interface{}
+
+	// Visit a parse tree produced by BoParser#yieldStatement.
+	VisitYieldStatement(ctx *YieldStatementContext) interface{}
+
+	// Visit a parse tree produced by BoParser#forStatement.
+	VisitForStatement(ctx *ForStatementContext) interface{}
+
+	// Visit a parse tree produced by BoParser#deferStatement.
+	VisitDeferStatement(ctx *DeferStatementContext) interface{}
+
+	// Visit a parse tree produced by BoParser#enumDeclaration.
+	VisitEnumDeclaration(ctx *EnumDeclarationContext) interface{}
+
+	// Visit a parse tree produced by BoParser#enumCase.
+	VisitEnumCase(ctx *EnumCaseContext) interface{}
+
+	// Visit a parse tree produced by BoParser#structDeclaration.
+	VisitStructDeclaration(ctx *StructDeclarationContext) interface{}
+
+	// Visit a parse tree produced by BoParser#structField.
+	VisitStructField(ctx *StructFieldContext) interface{}
+
+	// Visit a parse tree produced by BoParser#matchArm.
+	VisitMatchArm(ctx *MatchArmContext) interface{}
+
+	// Visit a parse tree produced by BoParser#switchStatement.
+	VisitSwitchStatement(ctx *SwitchStatementContext) interface{}
+
+	// Visit a parse tree produced by BoParser#switchArm.
+	VisitSwitchArm(ctx *SwitchArmContext) interface{}
+
+	// Visit a parse tree produced by BoParser#guard.
+	VisitGuard(ctx *GuardContext) interface{}
+
+	// Visit a parse tree produced by BoParser#spawnStatement.
+	VisitSpawnStatement(ctx *SpawnStatementContext) interface{}
+
+	// Visit a parse tree produced by BoParser#awaitStatement.
+	VisitAwaitStatement(ctx *AwaitStatementContext) interface{}
+
+	// Visit a parse tree produced by BoParser#sendStatement.
+	VisitSendStatement(ctx *SendStatementContext) interface{}
+
+	// Visit a parse tree produced by BoParser#selectStatement.
+	VisitSelectStatement(ctx *SelectStatementContext) interface{}
+
+	// Visit a parse tree produced by BoParser#receiveArm.
+	VisitReceiveArm(ctx *ReceiveArmContext) interface{}
+
+	// Visit a parse tree produced by BoParser#sendArm.
+	VisitSendArm(ctx *SendArmContext) interface{}
+
+	// Visit a parse tree produced by BoParser#defaultArm.
+	VisitDefaultArm(ctx *DefaultArmContext) interface{}
+
+	// Visit a parse tree produced by BoParser#wildcardPattern.
+	VisitWildcardPattern(ctx *WildcardPatternContext) interface{}
+
+	// Visit a parse tree produced by BoParser#literalPattern.
+	VisitLiteralPattern(ctx *LiteralPatternContext) interface{}
+
+	// Visit a parse tree produced by BoParser#casePattern.
+	VisitCasePattern(ctx *CasePatternContext) interface{}
+
+	// Visit a parse tree produced by BoParser#listPattern.
+	VisitListPattern(ctx *ListPatternContext) interface{}
+
+	// Visit a parse tree produced by BoParser#restPattern.
+	VisitRestPattern(ctx *RestPatternContext) interface{}
+
+	// Visit a parse tree produced by BoParser#mapPattern.
+	VisitMapPattern(ctx *MapPatternContext) interface{}
+
+	// Visit a parse tree produced by BoParser#tuplePattern.
+	VisitTuplePattern(ctx *TuplePatternContext) interface{}
+
+	// Visit a parse tree produced by BoParser#structPattern.
+	VisitStructPattern(ctx *StructPatternContext) interface{}
+
+	// Visit a parse tree produced by BoParser#bindingPattern.
+	VisitBindingPattern(ctx *BindingPatternContext) interface{}
+
+	// Visit a parse tree produced by BoParser#entryPattern.
+	VisitEntryPattern(ctx *EntryPatternContext) interface{}
+
+	// Visit a parse tree produced by BoParser#fieldPattern.
+	VisitFieldPattern(ctx *FieldPatternContext) interface{}
+
 	// Visit a parse tree produced by BoParser#variableDeclaration.
 	VisitVariableDeclaration(ctx *VariableDeclarationContext) interface{}
 
+	// Visit a parse tree produced by BoParser#destructuringDeclaration.
+	VisitDestructuringDeclaration(ctx *DestructuringDeclarationContext) interface{}
+
 	// Visit a parse tree produced by BoParser#typeSpec.
 	VisitTypeSpec(ctx *TypeSpecContext) interface{}
 
+	// Visit a parse tree produced by BoParser#typeName.
+	VisitTypeName(ctx *TypeNameContext) interface{}
+
+	// Visit a parse tree produced by BoParser#listType.
+	VisitListType(ctx *ListTypeContext) interface{}
+
+	// Visit a parse tree produced by BoParser#mapType.
+	VisitMapType(ctx *MapTypeContext) interface{}
+
+	// Visit a parse tree produced by BoParser#chanType.
+	VisitChanType(ctx *ChanTypeContext) interface{}
+
+	// Visit a parse tree produced by BoParser#futureType.
+	VisitFutureType(ctx *FutureTypeContext) interface{}
+
+	// Visit a parse tree produced by BoParser#iteratorType.
+	VisitIteratorType(ctx *IteratorTypeContext) interface{}
+
+	// Visit a parse tree produced by BoParser#tupleType.
+	VisitTupleType(ctx *TupleTypeContext) interface{}
+
+	// Visit a parse tree produced by BoParser#basicType.
+	VisitBasicType(ctx *BasicTypeContext) interface{}
+
 	// Visit a parse tree produced by BoParser#requireStatement.
 	VisitRequireStatement(ctx *RequireStatementContext) interface{}
 
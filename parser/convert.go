@@ -0,0 +1,512 @@
+package parser
+
+import (
+	"bo/ast"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// The functions below convert the parse tree ANTLR builds to the syntax
+// tree of the ast package. Syntax errors in the expressions embedded in
+// string literals are raised as the strings are converted.
+
+func convertProgram(ctx IProgramContext) *ast.Program {
+	return &ast.Program{Span: span(ctx), Stmts: convertStatements(ctx.AllStatement())}
+}
+
+func convertBlock(ctx IBlockContext) *ast.Block {
+	return &ast.Block{Span: span(ctx), Stmts: convertStatements(ctx.AllStatement())}
+}
+
+func convertStatements(statements []IStatementContext) []ast.Stmt {
+	stmts := make([]ast.Stmt, len(statements))
+	for i, statement := range statements {
+		stmts[i] = convertStatement(statement)
+	}
+	return stmts
+}
+
+func convertStatement(ctx IStatementContext) ast.Stmt {
+	switch ctx := ctx.GetChild(0).(type) {
+	case *RequireStatementContext:
+		path := ctx.ImportPath()
+		if path.STRING() != nil {
+			text := path.STRING().GetText()
+			return &ast.Require{Span: span(ctx), Path: text[1 : len(text)-1]}
+		}
+		return &ast.Require{Span: span(ctx), Path: strings.Trim(path.GetText(), "<>"), Std: true}
+	case *EnumDeclarationContext:
+		decl := &ast.EnumDecl{Span: span(ctx), Name: ident(ctx.ID())}
+		for _, c := range ctx.AllEnumCase() {
+			decl.Cases = append(decl.Cases, &ast.EnumCase{Span: span(c), Name: ident(c.ID()), Fields: convertTypes(c.AllTypeSpec())})
+		}
+		return decl
+	case *StructDeclarationContext:
+		decl := &ast.StructDecl{Span: span(ctx), Name: ident(ctx.ID())}
+		for _, f := range ctx.AllStructField() {
+			decl.Fields = append(decl.Fields, &ast.Field{Span: span(f), Type: convertType(f.TypeSpec()), Name: ident(f.ID())})
+		}
+		return decl
+	case *FunctionDeclarationContext:
+		return convertFunction(ctx)
+	case *ReturnStatementContext:
+		return &ast.Return{Span: span(ctx), Results: convertExpressions(ctx.AllExpression())}
+	case *DeferStatementContext:
+		return &ast.Defer{Span: span(ctx), Call: convertCallStatement(ctx.FunctionCall())}
+	case *YieldStatementContext:
+		return &ast.Yield{Span: span(ctx), Value: convertExpression(ctx.Expression())}
+	case *ForStatementContext:
+		return &ast.For{
+			Span:    span(ctx),
+			Pattern: convertPattern(ctx.Pattern()),
+			X:       convertExpression(ctx.Expression()),
+			Body:    convertBlock(ctx.Block()),
+		}
+	case *VariableDeclarationContext:
+		decl := &ast.VarDecl{Span: span(ctx), Value: convertExpression(ctx.Expression())}
+		for i, id := range ctx.AllID() {
+			spec := ctx.TypeSpec(i)
+			decl.Vars = append(decl.Vars, &ast.Var{
+				Span: ast.Span{From: start(spec.GetStart()), To: end(id.GetSymbol())},
+				Type: convertType(spec),
+				Name: ident(id),
+			})
+		}
+		return decl
+	case *DestructuringDeclarationContext:
+		return &ast.Destructure{Span: span(ctx), Pattern: convertPattern(ctx.Pattern()), Value: convertExpression(ctx.Expression())}
+	case *SwitchStatementContext:
+		s := &ast.Switch{Span: span(ctx), X: convertExpression(ctx.Expression())}
+		for _, arm := range ctx.AllSwitchArm() {
+			s.Arms = append(s.Arms, &ast.SwitchArm{
+				Span:    span(arm),
+				Pattern: convertPattern(arm.Pattern()),
+				Guard:   convertGuard(arm.Guard()),
+				Body:    convertBlock(arm.Block()),
+			})
+		}
+		return s
+	case *SpawnStatementContext:
+		return &ast.Spawn{Span: span(ctx), Call: convertCallStatement(ctx.FunctionCall())}
+	case *SendStatementContext:
+		return &ast.Send{Span: span(ctx), Chan: convertExpression(ctx.Expression(0)), Value: convertExpression(ctx.Expression(1))}
+	case *SelectStatementContext:
+		s := &ast.Select{Span: span(ctx)}
+		for _, arm := range ctx.AllSelectArm() {
+			s.Arms = append(s.Arms, convertSelectArm(arm))
+		}
+		return s
+	case *AwaitStatementContext:
+		return &ast.AwaitStmt{Span: span(ctx), X: convertExpression(ctx.Expression())}
+	case *FunctionCallContext:
+		return &ast.CallStmt{Span: span(ctx), Call: convertCallStatement(ctx)}
+	default:
+		panic(fmt.Sprintf("convertStatement -> unhandled statement type: %T", ctx))
+	}
+}
+
+func convertFunction(ctx *FunctionDeclarationContext) *ast.FuncDecl {
+	fn := &ast.FuncDecl{Span: span(ctx), Async: ctx.ASYNC() != nil, Body: convertBlock(ctx.Block())}
+
+	if recv := ctx.Receiver(); recv != nil {
+		fn.Recv = &ast.Receiver{Span: span(recv), Type: convertTypeName(recv.TypeName()), Name: ident(recv.ID())}
+	}
+
+	if op := ctx.Operator(); op != nil {
+		fn.Name = &ast.Ident{Span: span(op), Name: op.GetText()}
+		fn.Operator = true
+	} else {
+		fn.Name = ident(ctx.ID())
+	}
+
+	for _, param := range ctx.AllParameter() {
+		p := &ast.Param{
+			Span:     span(param),
+			Type:     convertType(param.TypeSpec()),
+			Name:     ident(param.ID()),
+			Variadic: param.ELLIPSIS() != nil,
+		}
+		if param.Expression() != nil {
+			p.Default = convertExpression(param.Expression())
+		}
+		fn.Params = append(fn.Params, p)
+	}
+
+	if ctx.TypeSpec() != nil {
+		fn.Result = convertType(ctx.TypeSpec())
+	}
+
+	return fn
+}
+
+// convertCallStatement converts a call of a function by name, or of a
+// method.
+func convertCallStatement(c IFunctionCallContext) *ast.Call {
+	ctx := c.(*FunctionCallContext)
+
+	var fun ast.Expr = ident(ctx.ID())
+	if ctx.Expression() != nil {
+		x := convertExpression(ctx.Expression())
+		fun = &ast.Member{
+			Span: ast.Span{From: x.Pos(), To: end(ctx.ID().GetSymbol())},
+			X:    x,
+			Name: ident(ctx.ID()),
+			Safe: ctx.SAFE_PERIOD() != nil,
+		}
+	}
+
+	return &ast.Call{Span: span(ctx), Fun: fun, Args: convertArguments(ctx.FunctionParameters())}
+}
+
+func convertArguments(ctx IFunctionParametersContext) []*ast.Arg {
+	var args []*ast.Arg
+	for _, arg := range ctx.AllArgument() {
+		a := &ast.Arg{Span: span(arg), Value: convertExpression(arg.Expression())}
+		if arg.ID() != nil {
+			a.Name = ident(arg.ID())
+		}
+		args = append(args, a)
+	}
+	return args
+}
+
+func convertSelectArm(ctx ISelectArmContext) ast.SelectArm {
+	switch ctx := ctx.(type) {
+	case *ReceiveArmContext:
+		arm := &ast.ReceiveArm{Span: span(ctx), Chan: convertExpression(ctx.Expression()), Body: convertBlock(ctx.Block())}
+		if ctx.ID() != nil {
+			arm.Name = ident(ctx.ID())
+		}
+		return arm
+	case *SendArmContext:
+		return &ast.SendArm{
+			Span:  span(ctx),
+			Chan:  convertExpression(ctx.Expression(0)),
+			Value: convertExpression(ctx.Expression(1)),
+			Body:  convertBlock(ctx.Block()),
+		}
+	case *DefaultArmContext:
+		return &ast.DefaultArm{Span: span(ctx), Body: convertBlock(ctx.Block())}
+	default:
+		panic(fmt.Sprintf("convertSelectArm -> unhandled arm type: %T", ctx))
+	}
+}
+
+func convertGuard(ctx IGuardContext) ast.Expr {
+	if ctx == nil {
+		return nil
+	}
+	return convertExpression(ctx.Expression())
+}
+
+func convertExpressions(exprs []IExpressionContext) []ast.Expr {
+	var converted []ast.Expr
+	for _, expr := range exprs {
+		converted = append(converted, convertExpression(expr))
+	}
+	return converted
+}
+
+func convertExpression(ctx IExpressionContext) ast.Expr {
+	switch ctx := ctx.(type) {
+	case *PrimaryExpressionContext:
+		return convertPrimary(ctx.Primary())
+	case *MatchExpressionContext:
+		m := &ast.Match{Span: span(ctx), X: convertExpression(ctx.Expression())}
+		for _, arm := range ctx.AllMatchArm() {
+			m.Arms = append(m.Arms, &ast.MatchArm{
+				Span:    span(arm),
+				Pattern: convertPattern(arm.Pattern()),
+				Guard:   convertGuard(arm.Guard()),
+				Value:   convertExpression(arm.Expression()),
+			})
+		}
+		return m
+	case *ConversionExpressionContext:
+		return &ast.Conversion{Span: span(ctx), Type: convertType(ctx.TypeSpec()), X: convertExpression(ctx.Expression())}
+	case *MemberExpressionContext:
+		return &ast.Member{
+			Span: span(ctx),
+			X:    convertExpression(ctx.Expression()),
+			Name: ident(ctx.ID()),
+			Safe: ctx.SAFE_PERIOD() != nil,
+		}
+	case *CallExpressionContext:
+		return &ast.Call{Span: span(ctx), Fun: convertExpression(ctx.Expression()), Args: convertArguments(ctx.FunctionParameters())}
+	case *IndexExpressionContext:
+		return &ast.Index{Span: span(ctx), X: convertExpression(ctx.Expression(0)), Index: convertExpression(ctx.Expression(1))}
+	case *UnaryExpressionContext:
+		return &ast.Unary{Span: span(ctx), Op: ctx.GetChild(0).(antlr.TerminalNode).GetText(), X: convertExpression(ctx.Expression())}
+	case *ReceiveExpressionContext:
+		return &ast.Receive{Span: span(ctx), Chan: convertExpression(ctx.Expression())}
+	case *AwaitExpressionContext:
+		return &ast.Await{Span: span(ctx), X: convertExpression(ctx.Expression())}
+	case *MultiplicativeExpressionContext:
+		return binary(ctx, ctx.Expression(0), ctx.Expression(1))
+	case *AdditiveExpressionContext:
+		return binary(ctx, ctx.Expression(0), ctx.Expression(1))
+	case *RangeExpressionContext:
+		return &ast.Range{
+			Span:      span(ctx),
+			From:      convertExpression(ctx.Expression(0)),
+			To:        convertExpression(ctx.Expression(1)),
+			Exclusive: ctx.RANGE_EXCL() != nil,
+		}
+	case *StepExpressionContext:
+		return &ast.Step{Span: span(ctx), X: convertExpression(ctx.Expression(0)), Step: convertExpression(ctx.Expression(1))}
+	case *RelationalExpressionContext:
+		return binary(ctx, ctx.Expression(0), ctx.Expression(1))
+	case *EqualityExpressionContext:
+		return binary(ctx, ctx.Expression(0), ctx.Expression(1))
+	case *AndExpressionContext:
+		return binary(ctx, ctx.Expression(0), ctx.Expression(1))
+	case *OrExpressionContext:
+		return binary(ctx, ctx.Expression(0), ctx.Expression(1))
+	case *CoalesceExpressionContext:
+		return binary(ctx, ctx.Expression(0), ctx.Expression(1))
+	default:
+		panic(fmt.Sprintf("convertExpression -> unhandled expression type: %T", ctx))
+	}
+}
+
+// binary converts an expression whose operator is its second child.
+func binary(ctx antlr.ParserRuleContext, x, y IExpressionContext) *ast.Binary {
+	return &ast.Binary{
+		Span: span(ctx),
+		Op:   ctx.GetChild(1).(antlr.TerminalNode).GetText(),
+		X:    convertExpression(x),
+		Y:    convertExpression(y),
+	}
+}
+
+func convertPrimary(ctx IPrimaryContext) ast.Expr {
+	switch {
+	case ctx.INT() != nil:
+		return basicLit(ctx.INT(), ast.Int)
+	case ctx.FLOAT() != nil:
+		return basicLit(ctx.FLOAT(), ast.Float)
+	case ctx.BIGINT() != nil:
+		return basicLit(ctx.BIGINT(), ast.BigInt)
+	case ctx.DECIMAL() != nil:
+		return basicLit(ctx.DECIMAL(), ast.Decimal)
+	case ctx.STRING() != nil:
+		return stringLit(ctx.STRING())
+	case ctx.BOOL() != nil:
+		return basicLit(ctx.BOOL(), ast.Bool)
+	case ctx.NIL() != nil:
+		return basicLit(ctx.NIL(), ast.Nil)
+	case ctx.ID() != nil:
+		return ident(ctx.ID())
+	case ctx.FUTURE() != nil:
+		return ident(ctx.FUTURE())
+	case ctx.Expression() != nil:
+		return &ast.Paren{Span: span(ctx), X: convertExpression(ctx.Expression())}
+	case ctx.ListLiteral() != nil:
+		l := ctx.ListLiteral()
+		return &ast.ListLit{Span: span(l), Elems: convertExpressions(l.AllExpression())}
+	case ctx.MapLiteral() != nil:
+		m := &ast.MapLit{Span: span(ctx.MapLiteral())}
+		for _, entry := range ctx.MapLiteral().AllMapEntry() {
+			m.Entries = append(m.Entries, &ast.Entry{
+				Span:  span(entry),
+				Key:   convertExpression(entry.Expression(0)),
+				Value: convertExpression(entry.Expression(1)),
+			})
+		}
+		return m
+	case ctx.TupleLiteral() != nil:
+		t := ctx.TupleLiteral()
+		return &ast.TupleLit{Span: span(t), Elems: convertExpressions(t.AllExpression())}
+	case ctx.StructLiteral() != nil:
+		s := &ast.StructLit{Span: span(ctx.StructLiteral()), Type: ident(ctx.StructLiteral().ID())}
+		for _, value := range ctx.StructLiteral().AllFieldValue() {
+			s.Fields = append(s.Fields, &ast.FieldValue{
+				Span:  span(value),
+				Name:  ident(value.ID()),
+				Value: convertExpression(value.Expression()),
+			})
+		}
+		return s
+	default:
+		panic(fmt.Sprintf("convertPrimary -> unhandled expression type: %T", ctx))
+	}
+}
+
+func convertPattern(ctx IPatternContext) ast.Pattern {
+	switch ctx := ctx.(type) {
+	case *WildcardPatternContext:
+		return &ast.WildcardPattern{Span: span(ctx)}
+	case *BindingPatternContext:
+		return &ast.BindingPattern{Span: span(ctx), Name: ident(ctx.ID())}
+	case *LiteralPatternContext:
+		var value ast.Expr
+		switch {
+		case ctx.INT() != nil:
+			value = basicLit(ctx.INT(), ast.Int)
+		case ctx.FLOAT() != nil:
+			value = basicLit(ctx.FLOAT(), ast.Float)
+		case ctx.BIGINT() != nil:
+			value = basicLit(ctx.BIGINT(), ast.BigInt)
+		case ctx.DECIMAL() != nil:
+			value = basicLit(ctx.DECIMAL(), ast.Decimal)
+		case ctx.STRING() != nil:
+			value = stringLit(ctx.STRING())
+		case ctx.BOOL() != nil:
+			value = basicLit(ctx.BOOL(), ast.Bool)
+		default:
+			value = basicLit(ctx.NIL(), ast.Nil)
+		}
+		return &ast.LiteralPattern{Span: span(ctx), Neg: ctx.SUB() != nil, Value: value}
+	case *CasePatternContext:
+		ids := ctx.AllID()
+		p := &ast.CasePattern{
+			Span:   span(ctx),
+			Case:   ident(ids[len(ids)-1]),
+			Parens: ctx.LPAREN() != nil,
+			Fields: convertPatterns(ctx.AllPattern()),
+		}
+		if len(ids) == 2 {
+			p.Enum = ident(ids[0])
+		}
+		return p
+	case *ListPatternContext:
+		return &ast.ListPattern{Span: span(ctx), Elems: convertPatterns(ctx.AllPattern())}
+	case *RestPatternContext:
+		p := &ast.RestPattern{Span: span(ctx)}
+		if ctx.ID() != nil {
+			p.Name = ident(ctx.ID())
+		}
+		return p
+	case *MapPatternContext:
+		p := &ast.MapPattern{Span: span(ctx)}
+		for _, entry := range ctx.AllEntryPattern() {
+			p.Entries = append(p.Entries, &ast.EntryPattern{
+				Span:  span(entry),
+				Key:   convertPattern(entry.Pattern(0)),
+				Value: convertPattern(entry.Pattern(1)),
+			})
+		}
+		return p
+	case *TuplePatternContext:
+		return &ast.TuplePattern{Span: span(ctx), Elems: convertPatterns(ctx.AllPattern())}
+	case *StructPatternContext:
+		p := &ast.StructPattern{Span: span(ctx), Type: ident(ctx.ID())}
+		for _, field := range ctx.AllFieldPattern() {
+			fp := &ast.FieldPattern{Span: span(field), Name: ident(field.ID())}
+			if field.Pattern() != nil {
+				fp.Pattern = convertPattern(field.Pattern())
+			}
+			p.Fields = append(p.Fields, fp)
+		}
+		return p
+	default:
+		panic(fmt.Sprintf("convertPattern -> unhandled pattern type: %T", ctx))
+	}
+}
+
+func convertPatterns(patterns []IPatternContext) []ast.Pattern {
+	var converted []ast.Pattern
+	for _, pattern := range patterns {
+		converted = append(converted, convertPattern(pattern))
+	}
+	return converted
+}
+
+func convertTypes(specs []ITypeSpecContext) []ast.TypeExpr {
+	var types []ast.TypeExpr
+	for _, spec := range specs {
+		types = append(types, convertType(spec))
+	}
+	return types
+}
+
+func convertType(ctx ITypeSpecContext) ast.TypeExpr {
+	var t ast.TypeExpr
+	switch {
+	case ctx.BasicType() != nil:
+		t = &ast.BasicType{Span: span(ctx.BasicType()), Name: ctx.BasicType().GetText()}
+	case ctx.TypeName() != nil:
+		t = convertTypeName(ctx.TypeName())
+	case ctx.ListType() != nil:
+		t = &ast.ListType{Span: span(ctx.ListType()), Elem: convertType(ctx.ListType().TypeSpec())}
+	case ctx.MapType() != nil:
+		m := ctx.MapType()
+		t = &ast.MapType{Span: span(m), Key: convertType(m.TypeSpec(0)), Value: convertType(m.TypeSpec(1))}
+	case ctx.TupleType() != nil:
+		t = &ast.TupleType{Span: span(ctx.TupleType()), Elems: convertTypes(ctx.TupleType().AllTypeSpec())}
+	case ctx.ChanType() != nil:
+		t = &ast.ChanType{Span: span(ctx.ChanType()), Elem: convertType(ctx.ChanType().TypeSpec())}
+	case ctx.FutureType() != nil:
+		t = &ast.FutureType{Span: span(ctx.FutureType()), Elem: convertType(ctx.FutureType().TypeSpec())}
+	case ctx.IteratorType() != nil:
+		t = &ast.IteratorType{Span: span(ctx.IteratorType()), Elem: convertType(ctx.IteratorType().TypeSpec())}
+	default:
+		panic(fmt.Sprintf("convertType -> unhandled type: %s", ctx.GetText()))
+	}
+
+	if ctx.QUESTION() != nil {
+		return &ast.OptionalType{Span: span(ctx), Elem: t}
+	}
+	return t
+}
+
+func convertTypeName(ctx ITypeNameContext) *ast.TypeName {
+	ids := ctx.AllID()
+	if len(ids) == 2 {
+		return &ast.TypeName{Span: span(ctx), Module: ident(ids[0]), Name: ident(ids[1])}
+	}
+	return &ast.TypeName{Span: span(ctx), Name: ident(ids[0])}
+}
+
+func ident(node antlr.TerminalNode) *ast.Ident {
+	return &ast.Ident{Span: tokenSpan(node.GetSymbol()), Name: node.GetText()}
+}
+
+func basicLit(node antlr.TerminalNode, kind ast.LitKind) *ast.BasicLit {
+	return &ast.BasicLit{Span: tokenSpan(node.GetSymbol()), Kind: kind, Value: node.GetText()}
+}
+
+// stringLit converts a string literal, parsing the expressions it embeds.
+func stringLit(node antlr.TerminalNode) *ast.StringLit {
+	parts, err := splitString(node.GetText(), start(node.GetSymbol()), parseEmbeddedExpression)
+	if err != nil {
+		panic(err)
+	}
+	return &ast.StringLit{Span: tokenSpan(node.GetSymbol()), Value: node.GetText(), Parts: parts}
+}
+
+func span(ctx antlr.ParserRuleContext) ast.Span {
+	// An empty program has no stop token
+	if ctx.GetStop() == nil {
+		return ast.Span{From: start(ctx.GetStart()), To: start(ctx.GetStart())}
+	}
+	return ast.Span{From: start(ctx.GetStart()), To: end(ctx.GetStop())}
+}
+
+func tokenSpan(token antlr.Token) ast.Span {
+	return ast.Span{From: start(token), To: end(token)}
+}
+
+func start(token antlr.Token) ast.Pos {
+	return ast.Pos{Line: token.GetLine(), Column: token.GetColumn()}
+}
+
+// end returns the position just after token, which may span lines.
+func end(token antlr.Token) ast.Pos {
+	pos := start(token)
+	if token.GetTokenType() == antlr.TokenEOF {
+		return pos
+	}
+
+	text := token.GetText()
+	if nl := strings.LastIndexByte(text, '\n'); nl >= 0 {
+		pos.Line += strings.Count(text, "\n")
+		pos.Column = utf8.RuneCountInString(text[nl+1:])
+	} else {
+		pos.Column += utf8.RuneCountInString(text)
+	}
+	return pos
+}
@@ -0,0 +1,1316 @@
+package parser
+
+import (
+	"bo/ast"
+	"fmt"
+	"strings"
+)
+
+// descent is the hand-written recursive-descent parser. It builds the same
+// syntax tree as the parser ANTLR generates from grammar/Bo.g4, and where
+// the grammar needs more than a token of lookahead it looks ahead over the
+// tokens of a type or pattern, or parses speculatively and backs up.
+//
+// Expressions that a block follows, as in switch p { ... }, are parsed as
+// heads: there p {} is not a struct literal unless another block follows.
+type descent struct {
+	tokens []token
+	pos    int
+
+	// err is the first syntax error in an expression embedded in a string,
+	// which ANTLR reports only once the whole program has parsed.
+	err error
+}
+
+// parseDescent parses a program with the recursive-descent parser.
+func parseDescent(input string) (prog *ast.Program, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rErr, ok := r.(*syntaxError); ok {
+				prog, err = nil, rErr
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	p := &descent{tokens: scan(input, ast.Pos{Line: 1})}
+	prog = p.program()
+	if p.err != nil {
+		return nil, p.err
+	}
+	return prog, nil
+}
+
+// parseEmbedded parses an expression embedded in a string literal, whose
+// first character is at pos.
+func parseEmbedded(input string, pos ast.Pos) (expr ast.Expr, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rErr, ok := r.(*syntaxError); ok {
+				expr, err = nil, rErr
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	p := &descent{tokens: scan(input, pos)}
+	expr = p.expression()
+	p.expect(tokenEOF)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return expr, nil
+}
+
+// Tokens
+
+func (p *descent) tok() token {
+	return p.tokens[p.pos]
+}
+
+// kind returns the kind of tokens[i], or EOF past the end.
+func (p *descent) kind(i int) tokenKind {
+	if i < len(p.tokens) {
+		return p.tokens[i].kind
+	}
+	return tokenEOF
+}
+
+func (p *descent) peek(n int) tokenKind {
+	return p.kind(p.pos + n)
+}
+
+func (p *descent) is(kind tokenKind) bool {
+	return p.tokens[p.pos].kind == kind
+}
+
+func (p *descent) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *descent) accept(kind tokenKind) bool {
+	if p.is(kind) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *descent) expect(kind tokenKind) token {
+	if t := p.tok(); t.kind != kind {
+		p.errorf(t, "mismatched input %s expecting %s", t, kind)
+	}
+	return p.next()
+}
+
+func (p *descent) errorf(t token, format string, args ...interface{}) {
+	panic(&syntaxError{line: t.pos.Line, column: t.pos.Column, msg: fmt.Sprintf(format, args...)})
+}
+
+// speculate runs parse from the current token and then backs up. It
+// returns the index of the token after what parse consumed, or -1 if parse
+// failed.
+func (p *descent) speculate(parse func()) (end int) {
+	pos, err := p.pos, p.err
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(*syntaxError); !ok {
+				panic(r)
+			}
+			end = -1
+		}
+		p.pos, p.err = pos, err
+	}()
+
+	parse()
+	return p.pos
+}
+
+// Statements
+
+func (p *descent) program() *ast.Program {
+	from := p.tok().pos
+
+	stmts := make([]ast.Stmt, 0)
+	for !p.is(tokenEOF) {
+		stmts = append(stmts, p.statement())
+	}
+
+	// The program ends with its last token, not EOF, as in ANTLR
+	to := from
+	if p.pos > 0 {
+		to = p.tokens[p.pos-1].end
+	}
+	return &ast.Program{Span: ast.Span{From: from, To: to}, Stmts: stmts}
+}
+
+func (p *descent) block() *ast.Block {
+	lbrace := p.expect(tokenLBrace)
+
+	stmts := make([]ast.Stmt, 0)
+	for !p.is(tokenRBrace) && !p.is(tokenEOF) {
+		stmts = append(stmts, p.statement())
+	}
+	rbrace := p.expect(tokenRBrace)
+
+	return &ast.Block{Span: ast.Span{From: lbrace.pos, To: rbrace.end}, Stmts: stmts}
+}
+
+func (p *descent) statement() ast.Stmt {
+	switch t := p.tok(); t.kind {
+	case tokenRequire:
+		return p.require()
+	case tokenEnum:
+		return p.enum()
+	case tokenStruct:
+		return p.structDecl()
+	case tokenAsync, tokenFunc:
+		return p.function()
+	case tokenReturn:
+		return p.returnStmt()
+	case tokenDefer:
+		p.next()
+		call := p.functionCall()
+		return &ast.Defer{Span: ast.Span{From: t.pos, To: call.End()}, Call: call}
+	case tokenYield:
+		p.next()
+		x := p.expression()
+		return &ast.Yield{Span: ast.Span{From: t.pos, To: x.End()}, Value: x}
+	case tokenFor:
+		return p.forStmt()
+	case tokenSwitch:
+		return p.switchStmt()
+	case tokenSpawn:
+		p.next()
+		call := p.functionCall()
+		return &ast.Spawn{Span: ast.Span{From: t.pos, To: call.End()}, Call: call}
+	case tokenSelect:
+		return p.selectStmt()
+	case tokenAwait:
+		p.next()
+		x := p.expression()
+		return &ast.AwaitStmt{Span: ast.Span{From: t.pos, To: x.End()}, X: x}
+	}
+	return p.simpleStatement()
+}
+
+// simpleStatement parses a variable or destructuring declaration, a send
+// or a call, which all start with a type, pattern or expression.
+func (p *descent) simpleStatement() ast.Stmt {
+	if p.isVarDecl(p.pos) {
+		return p.varDecl()
+	}
+
+	if p.isDestructure(p.pos) {
+		pattern := p.pattern(false)
+		p.expect(tokenAssign)
+		value := p.expression()
+		return &ast.Destructure{Span: ast.Span{From: pattern.Pos(), To: value.End()}, Pattern: pattern, Value: value}
+	}
+
+	start := p.pos
+	x := p.expression()
+	if p.accept(tokenReceive) {
+		value := p.expression()
+		return &ast.Send{Span: ast.Span{From: x.Pos(), To: value.End()}, Chan: x, Value: value}
+	}
+
+	call := p.asFunctionCall(x, start)
+	return &ast.CallStmt{Span: call.Span, Call: call}
+}
+
+// isVarDecl reports whether a variable declaration starts at tokens[i]:
+// types and names up to an =.
+func (p *descent) isVarDecl(i int) bool {
+	for {
+		if i = p.skipType(i); i < 0 || p.kind(i) != tokenIdent {
+			return false
+		}
+		switch p.kind(i + 1) {
+		case tokenAssign:
+			return true
+		case tokenComma:
+			i += 2
+		default:
+			return false
+		}
+	}
+}
+
+// isDestructure reports whether a pattern followed by = starts at
+// tokens[i].
+func (p *descent) isDestructure(i int) bool {
+	i = p.skipPattern(i, false)
+	return i >= 0 && p.kind(i) == tokenAssign
+}
+
+func (p *descent) varDecl() *ast.VarDecl {
+	decl := &ast.VarDecl{}
+	for {
+		typ := p.typeSpec()
+		name := p.ident()
+		decl.Vars = append(decl.Vars, &ast.Var{Span: ast.Span{From: typ.Pos(), To: name.End()}, Type: typ, Name: name})
+		if !p.accept(tokenComma) {
+			break
+		}
+	}
+	p.expect(tokenAssign)
+	decl.Value = p.expression()
+	decl.Span = ast.Span{From: decl.Vars[0].Pos(), To: decl.Value.End()}
+
+	return decl
+}
+
+// functionCall parses a call of a function by name or of a method, the
+// calls that may be statements.
+func (p *descent) functionCall() *ast.Call {
+	start := p.pos
+	return p.asFunctionCall(p.expression(), start)
+}
+
+// asFunctionCall returns x, parsed from tokens[start], if it may be a
+// statement.
+func (p *descent) asFunctionCall(x ast.Expr, start int) *ast.Call {
+	if call, ok := x.(*ast.Call); ok {
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			// Future is a keyword, not the name of a function
+			if fun.Name != "Future" {
+				return call
+			}
+		case *ast.Member:
+			return call
+		}
+	}
+
+	// ANTLR quotes the tokens from the start of the statement
+	var text strings.Builder
+	for _, t := range p.tokens[start : p.pos+1] {
+		if t.kind != tokenEOF {
+			text.WriteString(t.text)
+		}
+	}
+	p.errorf(p.tok(), "no viable alternative at input '%s'", text.String())
+	return nil
+}
+
+func (p *descent) require() *ast.Require {
+	t := p.next()
+
+	if path := p.tok(); path.kind == tokenString {
+		p.next()
+		return &ast.Require{Span: ast.Span{From: t.pos, To: path.end}, Path: path.text[1 : len(path.text)-1]}
+	}
+
+	p.expect(tokenLT)
+	path := p.ident().Name
+	for p.accept(tokenDiv) {
+		path += "/" + p.ident().Name
+	}
+	gt := p.expect(tokenGT)
+
+	return &ast.Require{Span: ast.Span{From: t.pos, To: gt.end}, Path: path, Std: true}
+}
+
+func (p *descent) enum() *ast.EnumDecl {
+	t := p.next()
+	decl := &ast.EnumDecl{Name: p.ident()}
+
+	p.expect(tokenLBrace)
+	for {
+		name := p.ident()
+		c := &ast.EnumCase{Span: name.Span, Name: name}
+		if p.accept(tokenLParen) {
+			c.Fields = p.typeSpecs()
+			c.Span.To = p.expect(tokenRParen).end
+		}
+		decl.Cases = append(decl.Cases, c)
+
+		if !p.accept(tokenComma) || p.is(tokenRBrace) {
+			break
+		}
+	}
+	decl.Span = ast.Span{From: t.pos, To: p.expect(tokenRBrace).end}
+
+	return decl
+}
+
+func (p *descent) structDecl() *ast.StructDecl {
+	t := p.next()
+	decl := &ast.StructDecl{Name: p.ident()}
+
+	p.expect(tokenLBrace)
+	for !p.is(tokenRBrace) && !p.is(tokenEOF) {
+		typ := p.typeSpec()
+		name := p.ident()
+		decl.Fields = append(decl.Fields, &ast.Field{Span: ast.Span{From: typ.Pos(), To: name.End()}, Type: typ, Name: name})
+		p.accept(tokenComma)
+	}
+	decl.Span = ast.Span{From: t.pos, To: p.expect(tokenRBrace).end}
+
+	return decl
+}
+
+func (p *descent) function() *ast.FuncDecl {
+	start := p.tok()
+	fn := &ast.FuncDecl{Async: p.accept(tokenAsync)}
+	p.expect(tokenFunc)
+
+	if p.accept(tokenLParen) {
+		typ := p.typeName()
+		name := p.ident()
+		fn.Recv = &ast.Receiver{Span: ast.Span{From: typ.Pos(), To: name.End()}, Type: typ, Name: name}
+		p.expect(tokenRParen)
+	}
+
+	fn.Name, fn.Operator = p.functionName()
+
+	p.expect(tokenLParen)
+	if !p.is(tokenRParen) {
+		for {
+			fn.Params = append(fn.Params, p.parameter())
+			if !p.accept(tokenComma) {
+				break
+			}
+		}
+	}
+	p.expect(tokenRParen)
+
+	if !p.is(tokenLBrace) {
+		fn.Result = p.typeSpec()
+	}
+	fn.Body = p.block()
+	fn.Span = ast.Span{From: start.pos, To: fn.Body.End()}
+
+	return fn
+}
+
+// functionName parses the name of a function, or the symbol of an
+// operator method.
+func (p *descent) functionName() (*ast.Ident, bool) {
+	switch t := p.tok(); t.kind {
+	case tokenIdent:
+		return p.ident(), false
+	case tokenAdd, tokenSub, tokenMul, tokenDiv, tokenMod, tokenEQ, tokenLT:
+		p.next()
+		return &ast.Ident{Span: t.span(), Name: t.text}, true
+	case tokenLBrack:
+		p.next()
+		rbrack := p.expect(tokenRBrack)
+		return &ast.Ident{Span: ast.Span{From: t.pos, To: rbrack.end}, Name: "[]"}, true
+	case tokenBasicType:
+		if t.text == "string" {
+			p.next()
+			return &ast.Ident{Span: t.span(), Name: t.text}, true
+		}
+	}
+
+	t := p.tok()
+	p.errorf(t, "mismatched input %s expecting {ID, operator}", t)
+	return nil, false
+}
+
+func (p *descent) parameter() *ast.Param {
+	start := p.tok()
+	param := &ast.Param{Variadic: p.accept(tokenEllipsis)}
+	param.Type = p.typeSpec()
+	param.Name = p.ident()
+	param.Span = ast.Span{From: start.pos, To: param.Name.End()}
+
+	if !param.Variadic && p.accept(tokenAssign) {
+		param.Default = p.expression()
+		param.Span.To = param.Default.End()
+	}
+
+	return param
+}
+
+func (p *descent) returnStmt() *ast.Return {
+	t := p.next()
+	ret := &ast.Return{Span: t.span()}
+
+	// The results are optional, so a declaration may follow directly
+	if !startsExpression(p.tok().kind) || p.isVarDecl(p.pos) || p.isDestructure(p.pos) {
+		return ret
+	}
+
+	for {
+		ret.Results = append(ret.Results, p.expression())
+		if !p.accept(tokenComma) {
+			break
+		}
+	}
+	ret.Span.To = ret.Results[len(ret.Results)-1].End()
+
+	return ret
+}
+
+func (p *descent) forStmt() *ast.For {
+	t := p.next()
+	stmt := &ast.For{Pattern: p.pattern(false)}
+	p.expect(tokenIn)
+	stmt.X = p.headExpression()
+	stmt.Body = p.block()
+	stmt.Span = ast.Span{From: t.pos, To: stmt.Body.End()}
+
+	return stmt
+}
+
+func (p *descent) switchStmt() *ast.Switch {
+	t := p.next()
+	stmt := &ast.Switch{X: p.headExpression()}
+
+	p.expect(tokenLBrace)
+	for p.is(tokenCase) {
+		c := p.next()
+		arm := &ast.SwitchArm{Pattern: p.pattern(true)}
+		if p.accept(tokenIf) {
+			arm.Guard = p.headExpression()
+		}
+		arm.Body = p.block()
+		arm.Span = ast.Span{From: c.pos, To: arm.Body.End()}
+		stmt.Arms = append(stmt.Arms, arm)
+	}
+	stmt.Span = ast.Span{From: t.pos, To: p.expect(tokenRBrace).end}
+
+	return stmt
+}
+
+func (p *descent) selectStmt() *ast.Select {
+	t := p.next()
+	stmt := &ast.Select{}
+
+	p.expect(tokenLBrace)
+	for p.is(tokenCase) || p.is(tokenDefault) {
+		stmt.Arms = append(stmt.Arms, p.selectArm())
+	}
+	stmt.Span = ast.Span{From: t.pos, To: p.expect(tokenRBrace).end}
+
+	return stmt
+}
+
+func (p *descent) selectArm() ast.SelectArm {
+	t := p.next()
+
+	if t.kind == tokenDefault {
+		body := p.block()
+		return &ast.DefaultArm{Span: ast.Span{From: t.pos, To: body.End()}, Body: body}
+	}
+
+	if p.is(tokenReceive) || p.is(tokenIdent) && p.peek(1) == tokenAssign {
+		arm := &ast.ReceiveArm{}
+		if p.is(tokenIdent) {
+			arm.Name = p.ident()
+			p.next()
+		}
+		p.expect(tokenReceive)
+		arm.Chan = p.headExpression()
+		arm.Body = p.block()
+		arm.Span = ast.Span{From: t.pos, To: arm.Body.End()}
+		return arm
+	}
+
+	arm := &ast.SendArm{Chan: p.expression()}
+	p.expect(tokenReceive)
+	arm.Value = p.headExpression()
+	arm.Body = p.block()
+	arm.Span = ast.Span{From: t.pos, To: arm.Body.End()}
+
+	return arm
+}
+
+// Expressions
+
+func (p *descent) expression() ast.Expr {
+	return p.binary(1, false)
+}
+
+// headExpression parses an expression a block follows.
+func (p *descent) headExpression() ast.Expr {
+	return p.binary(1, true)
+}
+
+// precedence returns the precedence of a binary operator, from 1 for ??
+// up, or 0 if kind is not one.
+func precedence(kind tokenKind) int {
+	switch kind {
+	case tokenCoalesce:
+		return 1
+	case tokenOr:
+		return 2
+	case tokenAnd:
+		return 3
+	case tokenEQ, tokenNE:
+		return 4
+	case tokenLT, tokenLE, tokenGT, tokenGE:
+		return 5
+	case tokenStep:
+		return 6
+	case tokenRange, tokenRangeExcl:
+		return 7
+	case tokenAdd, tokenSub, tokenAddWrap, tokenSubWrap:
+		return 8
+	case tokenMul, tokenDiv, tokenMod, tokenMulWrap:
+		return 9
+	}
+	return 0
+}
+
+// binary parses an expression whose binary operators have at least the
+// precedence prec. All of them associate to the left.
+func (p *descent) binary(prec int, head bool) ast.Expr {
+	x := p.unary(head)
+	for {
+		op := p.tok()
+		opPrec := precedence(op.kind)
+		if opPrec < prec {
+			return x
+		}
+		p.next()
+
+		y := p.binary(opPrec+1, head)
+		span := ast.Span{From: x.Pos(), To: y.End()}
+		switch op.kind {
+		case tokenRange, tokenRangeExcl:
+			x = &ast.Range{Span: span, From: x, To: y, Exclusive: op.kind == tokenRangeExcl}
+		case tokenStep:
+			x = &ast.Step{Span: span, X: x, Step: y}
+		default:
+			x = &ast.Binary{Span: span, Op: op.text, X: x, Y: y}
+		}
+	}
+}
+
+// unary parses an expression with prefix operators, which bind less
+// tightly than member access, calls and indexing.
+func (p *descent) unary(head bool) ast.Expr {
+	switch t := p.tok(); t.kind {
+	case tokenSub, tokenNot:
+		p.next()
+		x := p.unary(head)
+		return &ast.Unary{Span: ast.Span{From: t.pos, To: x.End()}, Op: t.text, X: x}
+	case tokenReceive:
+		p.next()
+		x := p.unary(head)
+		return &ast.Receive{Span: ast.Span{From: t.pos, To: x.End()}, Chan: x}
+	case tokenAwait:
+		p.next()
+		x := p.unary(head)
+		return &ast.Await{Span: ast.Span{From: t.pos, To: x.End()}, X: x}
+	}
+	return p.postfix(p.primary(head), true)
+}
+
+// postfix parses the member accesses, calls, if calls is set, and index
+// expressions that follow x.
+func (p *descent) postfix(x ast.Expr, calls bool) ast.Expr {
+	for {
+		switch t := p.tok(); t.kind {
+		case tokenPeriod, tokenSafePeriod:
+			p.next()
+			name := p.ident()
+			x = &ast.Member{Span: ast.Span{From: x.Pos(), To: name.End()}, X: x, Name: name, Safe: t.kind == tokenSafePeriod}
+		case tokenLParen:
+			if !calls || p.startsDeclaration() {
+				return x
+			}
+			args, rparen := p.arguments()
+			x = &ast.Call{Span: ast.Span{From: x.Pos(), To: rparen.end}, Fun: x, Args: args}
+		case tokenLBrack:
+			if p.startsDeclaration() {
+				return x
+			}
+			p.next()
+			index := p.expression()
+			rbrack := p.expect(tokenRBrack)
+			x = &ast.Index{Span: ast.Span{From: x.Pos(), To: rbrack.end}, X: x, Index: index}
+		default:
+			return x
+		}
+	}
+}
+
+// startsDeclaration reports whether the ( or [ at the current token starts
+// a declaration on a line of its own, as in (a, b) = t, rather than a call
+// or index of the expression before it.
+func (p *descent) startsDeclaration() bool {
+	if p.pos == 0 || p.tok().pos.Line == p.tokens[p.pos-1].end.Line {
+		return false
+	}
+	return p.isVarDecl(p.pos) || p.isDestructure(p.pos)
+}
+
+func (p *descent) arguments() ([]*ast.Arg, token) {
+	p.expect(tokenLParen)
+
+	var args []*ast.Arg
+	if !p.is(tokenRParen) {
+		for {
+			args = append(args, p.argument())
+			if !p.accept(tokenComma) {
+				break
+			}
+		}
+	}
+
+	return args, p.expect(tokenRParen)
+}
+
+func (p *descent) argument() *ast.Arg {
+	if p.is(tokenIdent) && p.peek(1) == tokenColon {
+		name := p.ident()
+		p.next()
+		value := p.expression()
+		return &ast.Arg{Span: ast.Span{From: name.Pos(), To: value.End()}, Name: name, Value: value}
+	}
+
+	value := p.expression()
+	return &ast.Arg{Span: ast.Span{From: value.Pos(), To: value.End()}, Value: value}
+}
+
+func (p *descent) primary(head bool) ast.Expr {
+	if p.isConversion(head) {
+		typ := p.typeSpec()
+		p.expect(tokenLParen)
+		x := p.expression()
+		rparen := p.expect(tokenRParen)
+		return &ast.Conversion{Span: ast.Span{From: typ.Pos(), To: rparen.end}, Type: typ, X: x}
+	}
+	return p.operand(head)
+}
+
+// isConversion reports whether a conversion like int(x) starts at the
+// current token. A type followed by ( may also be called, as in Point(x)
+// or Future[T](x): ANTLR then prefers the call, and so does this.
+func (p *descent) isConversion(head bool) bool {
+	t := p.tok()
+	switch t.kind {
+	case tokenBasicType, tokenMap, tokenChan, tokenIterator:
+		return true
+	case tokenIdent, tokenFuture, tokenLBrack, tokenLParen:
+	default:
+		return false
+	}
+
+	end := p.skipType(p.pos)
+	if end < 0 || p.kind(end) != tokenLParen {
+		return false
+	}
+
+	// f(x) and m.f(x) are calls
+	if t.kind == tokenIdent && (end == p.pos+1 || end == p.pos+3) {
+		return false
+	}
+
+	// Otherwise it is a call if an expression ends where the type does.
+	// Where both parse, as in [] followed by f(x) on the next line, ANTLR
+	// prefers the expression too.
+	switch i := p.speculate(func() { p.postfix(p.operand(head), false) }); {
+	case i == end:
+		return false
+	case i > 0 && p.tokens[i].pos.Line > p.tokens[i-1].end.Line:
+		return false
+	}
+	return true
+}
+
+// operand parses a literal, name or parenthesized expression, or a match.
+func (p *descent) operand(head bool) ast.Expr {
+	t := p.tok()
+	switch t.kind {
+	case tokenInt:
+		p.next()
+		return &ast.BasicLit{Span: t.span(), Kind: ast.Int, Value: t.text}
+	case tokenFloat:
+		p.next()
+		return &ast.BasicLit{Span: t.span(), Kind: ast.Float, Value: t.text}
+	case tokenBigInt:
+		p.next()
+		return &ast.BasicLit{Span: t.span(), Kind: ast.BigInt, Value: t.text}
+	case tokenDecimal:
+		p.next()
+		return &ast.BasicLit{Span: t.span(), Kind: ast.Decimal, Value: t.text}
+	case tokenBool:
+		p.next()
+		return &ast.BasicLit{Span: t.span(), Kind: ast.Bool, Value: t.text}
+	case tokenNil:
+		p.next()
+		return &ast.BasicLit{Span: t.span(), Kind: ast.Nil, Value: t.text}
+	case tokenString:
+		p.next()
+		return p.stringLit(t)
+	case tokenIdent:
+		if p.peek(1) == tokenLBrace && p.isStructLiteral(head) {
+			return p.structLiteral()
+		}
+		return p.ident()
+	case tokenFuture:
+		// Future.all(a, b)
+		p.next()
+		return &ast.Ident{Span: t.span(), Name: t.text}
+	case tokenLParen:
+		return p.parenOrTuple()
+	case tokenLBrack:
+		return p.listLiteral()
+	case tokenLBrace:
+		return p.mapLiteral()
+	case tokenMatch:
+		return p.match()
+	}
+
+	p.errorf(t, "no viable alternative at input %s", t)
+	return nil
+}
+
+// isStructLiteral reports whether the ID { at the current token starts a
+// struct literal. At the head of a block, P {} is one only if the block
+// follows it.
+func (p *descent) isStructLiteral(head bool) bool {
+	switch p.peek(2) {
+	case tokenRBrace:
+		return !head || p.peek(3) == tokenLBrace
+	case tokenIdent:
+		return p.peek(3) == tokenColon
+	}
+	return false
+}
+
+func (p *descent) parenOrTuple() ast.Expr {
+	lparen := p.next()
+	x := p.expression()
+
+	if p.is(tokenComma) {
+		elems := []ast.Expr{x}
+		for p.accept(tokenComma) {
+			elems = append(elems, p.expression())
+		}
+		rparen := p.expect(tokenRParen)
+		return &ast.TupleLit{Span: ast.Span{From: lparen.pos, To: rparen.end}, Elems: elems}
+	}
+
+	rparen := p.expect(tokenRParen)
+	return &ast.Paren{Span: ast.Span{From: lparen.pos, To: rparen.end}, X: x}
+}
+
+func (p *descent) listLiteral() *ast.ListLit {
+	lbrack := p.next()
+
+	var elems []ast.Expr
+	for !p.is(tokenRBrack) {
+		elems = append(elems, p.expression())
+		if !p.accept(tokenComma) {
+			break
+		}
+	}
+	rbrack := p.expect(tokenRBrack)
+
+	return &ast.ListLit{Span: ast.Span{From: lbrack.pos, To: rbrack.end}, Elems: elems}
+}
+
+func (p *descent) mapLiteral() *ast.MapLit {
+	lbrace := p.next()
+
+	m := &ast.MapLit{}
+	for !p.is(tokenRBrace) {
+		key := p.expression()
+		p.expect(tokenColon)
+		value := p.expression()
+		m.Entries = append(m.Entries, &ast.Entry{Span: ast.Span{From: key.Pos(), To: value.End()}, Key: key, Value: value})
+
+		if !p.accept(tokenComma) {
+			break
+		}
+	}
+	m.Span = ast.Span{From: lbrace.pos, To: p.expect(tokenRBrace).end}
+
+	return m
+}
+
+func (p *descent) structLiteral() *ast.StructLit {
+	lit := &ast.StructLit{Type: p.ident()}
+
+	p.expect(tokenLBrace)
+	for !p.is(tokenRBrace) {
+		name := p.ident()
+		p.expect(tokenColon)
+		value := p.expression()
+		lit.Fields = append(lit.Fields, &ast.FieldValue{Span: ast.Span{From: name.Pos(), To: value.End()}, Name: name, Value: value})
+
+		if !p.accept(tokenComma) {
+			break
+		}
+	}
+	lit.Span = ast.Span{From: lit.Type.Pos(), To: p.expect(tokenRBrace).end}
+
+	return lit
+}
+
+func (p *descent) match() *ast.Match {
+	t := p.next()
+	m := &ast.Match{X: p.headExpression()}
+
+	p.expect(tokenLBrace)
+	for {
+		arm := &ast.MatchArm{Pattern: p.pattern(false)}
+		if p.accept(tokenIf) {
+			arm.Guard = p.expression()
+		}
+		p.expect(tokenArrow)
+		arm.Value = p.expression()
+		arm.Span = ast.Span{From: arm.Pattern.Pos(), To: arm.Value.End()}
+		m.Arms = append(m.Arms, arm)
+
+		if !p.accept(tokenComma) || p.is(tokenRBrace) {
+			break
+		}
+	}
+	m.Span = ast.Span{From: t.pos, To: p.expect(tokenRBrace).end}
+
+	return m
+}
+
+// stringLit converts a STRING token. A syntax error in an embedded
+// expression is kept until the program has parsed, as ANTLR only reports
+// one while converting its parse tree.
+func (p *descent) stringLit(t token) *ast.StringLit {
+	parts, err := splitString(t.text, t.pos, parseEmbedded)
+	if err != nil && p.err == nil {
+		p.err = err
+	}
+	return &ast.StringLit{Span: t.span(), Value: t.text, Parts: parts}
+}
+
+func (p *descent) ident() *ast.Ident {
+	t := p.expect(tokenIdent)
+	return &ast.Ident{Span: t.span(), Name: t.text}
+}
+
+// startsExpression reports whether an expression may start with a token of
+// the kind.
+func startsExpression(kind tokenKind) bool {
+	switch kind {
+	case tokenInt, tokenFloat, tokenBigInt, tokenDecimal, tokenString, tokenBool, tokenNil, tokenIdent,
+		tokenLParen, tokenFuture, tokenLBrack, tokenLBrace, tokenMatch,
+		tokenBasicType, tokenMap, tokenChan, tokenIterator,
+		tokenSub, tokenNot, tokenReceive, tokenAwait:
+		return true
+	}
+	return false
+}
+
+// Patterns
+
+// pattern parses a pattern. At the head of a block, as in a switch arm,
+// P {...} is a struct pattern only if a block or guard follows it.
+func (p *descent) pattern(head bool) ast.Pattern {
+	t := p.tok()
+	switch t.kind {
+	case tokenUnderscore:
+		p.next()
+		return &ast.WildcardPattern{Span: t.span()}
+	case tokenSub:
+		p.next()
+		lit := p.tok()
+		switch lit.kind {
+		case tokenInt, tokenFloat, tokenBigInt, tokenDecimal:
+			value := p.operand(false)
+			return &ast.LiteralPattern{Span: ast.Span{From: t.pos, To: lit.end}, Neg: true, Value: value}
+		}
+		p.errorf(lit, "mismatched input %s expecting {INT, FLOAT, BIGINT, DECIMAL}", lit)
+	case tokenInt, tokenFloat, tokenBigInt, tokenDecimal, tokenString, tokenBool, tokenNil:
+		return &ast.LiteralPattern{Span: t.span(), Value: p.operand(false)}
+	case tokenIdent:
+		switch p.peek(1) {
+		case tokenPeriod:
+			return p.casePattern()
+		case tokenLBrace:
+			if p.isStructPattern(p.pos, head) {
+				return p.structPattern()
+			}
+		}
+		name := p.ident()
+		return &ast.BindingPattern{Span: name.Span, Name: name}
+	case tokenPeriod:
+		return p.casePattern()
+	case tokenLBrack:
+		p.next()
+		elems := p.patterns(tokenRBrack)
+		rbrack := p.expect(tokenRBrack)
+		return &ast.ListPattern{Span: ast.Span{From: t.pos, To: rbrack.end}, Elems: elems}
+	case tokenEllipsis:
+		p.next()
+		rest := &ast.RestPattern{Span: t.span()}
+		if p.is(tokenIdent) {
+			rest.Name = p.ident()
+			rest.Span.To = rest.Name.End()
+		}
+		return rest
+	case tokenLBrace:
+		return p.mapPattern()
+	case tokenLParen:
+		p.next()
+		elems := []ast.Pattern{p.pattern(false)}
+		p.expect(tokenComma)
+		elems = append(elems, p.pattern(false))
+		for p.accept(tokenComma) {
+			elems = append(elems, p.pattern(false))
+		}
+		rparen := p.expect(tokenRParen)
+		return &ast.TuplePattern{Span: ast.Span{From: t.pos, To: rparen.end}, Elems: elems}
+	}
+
+	p.errorf(t, "no viable alternative at input %s", t)
+	return nil
+}
+
+// patterns parses patterns separated by commas, maybe none, up to the
+// closing token.
+func (p *descent) patterns(closing tokenKind) []ast.Pattern {
+	if p.is(closing) {
+		return nil
+	}
+
+	var patterns []ast.Pattern
+	for {
+		patterns = append(patterns, p.pattern(false))
+		if !p.accept(tokenComma) {
+			return patterns
+		}
+	}
+}
+
+func (p *descent) casePattern() *ast.CasePattern {
+	start := p.tok()
+
+	var enum *ast.Ident
+	if p.is(tokenIdent) {
+		enum = p.ident()
+	}
+	p.expect(tokenPeriod)
+	name := p.ident()
+
+	pattern := &ast.CasePattern{Span: ast.Span{From: start.pos, To: name.End()}, Enum: enum, Case: name}
+	if p.accept(tokenLParen) {
+		pattern.Parens = true
+		pattern.Fields = p.patterns(tokenRParen)
+		pattern.Span.To = p.expect(tokenRParen).end
+	}
+
+	return pattern
+}
+
+func (p *descent) mapPattern() *ast.MapPattern {
+	lbrace := p.next()
+
+	pattern := &ast.MapPattern{}
+	if !p.is(tokenRBrace) {
+		for {
+			key := p.pattern(false)
+			p.expect(tokenColon)
+			value := p.pattern(false)
+			pattern.Entries = append(pattern.Entries, &ast.EntryPattern{Span: ast.Span{From: key.Pos(), To: value.End()}, Key: key, Value: value})
+
+			if !p.accept(tokenComma) {
+				break
+			}
+		}
+	}
+	pattern.Span = ast.Span{From: lbrace.pos, To: p.expect(tokenRBrace).end}
+
+	return pattern
+}
+
+func (p *descent) structPattern() *ast.StructPattern {
+	pattern := &ast.StructPattern{Type: p.ident()}
+
+	p.expect(tokenLBrace)
+	if !p.is(tokenRBrace) {
+		for {
+			name := p.ident()
+			field := &ast.FieldPattern{Span: name.Span, Name: name}
+			if p.accept(tokenColon) {
+				field.Pattern = p.pattern(false)
+				field.Span.To = field.Pattern.End()
+			}
+			pattern.Fields = append(pattern.Fields, field)
+
+			if !p.accept(tokenComma) {
+				break
+			}
+		}
+	}
+	pattern.Span = ast.Span{From: pattern.Type.Pos(), To: p.expect(tokenRBrace).end}
+
+	return pattern
+}
+
+// isStructPattern reports whether the ID { at tokens[i] starts a struct
+// pattern rather than being a binding followed by a block.
+func (p *descent) isStructPattern(i int, head bool) bool {
+	end := p.skipStructPattern(i)
+	if end < 0 {
+		return false
+	}
+	return !head || p.kind(end) == tokenLBrace || p.kind(end) == tokenIf
+}
+
+// The skip functions return the index of the token after the pattern or
+// type that starts at tokens[i], or -1 if none does. They look ahead
+// without building nodes.
+
+func (p *descent) skipPattern(i int, head bool) int {
+	switch p.kind(i) {
+	case tokenUnderscore, tokenInt, tokenFloat, tokenBigInt, tokenDecimal, tokenString, tokenBool, tokenNil:
+		return i + 1
+	case tokenSub:
+		switch p.kind(i + 1) {
+		case tokenInt, tokenFloat, tokenBigInt, tokenDecimal:
+			return i + 2
+		}
+	case tokenIdent:
+		switch p.kind(i + 1) {
+		case tokenPeriod:
+			return p.skipCasePattern(i + 1)
+		case tokenLBrace:
+			if p.isStructPattern(i, head) {
+				return p.skipStructPattern(i)
+			}
+		}
+		return i + 1
+	case tokenPeriod:
+		return p.skipCasePattern(i)
+	case tokenLBrack:
+		return p.skipPatterns(i+1, tokenRBrack)
+	case tokenEllipsis:
+		if p.kind(i+1) == tokenIdent {
+			return i + 2
+		}
+		return i + 1
+	case tokenLBrace:
+		i++
+		if p.kind(i) != tokenRBrace {
+			for {
+				if i = p.skipPattern(i, false); i < 0 || p.kind(i) != tokenColon {
+					return -1
+				}
+				if i = p.skipPattern(i+1, false); i < 0 {
+					return -1
+				}
+				if p.kind(i) != tokenComma {
+					break
+				}
+				i++
+			}
+		}
+		if p.kind(i) != tokenRBrace {
+			return -1
+		}
+		return i + 1
+	case tokenLParen:
+		// At least two elements
+		if i = p.skipPattern(i+1, false); i < 0 || p.kind(i) != tokenComma || p.kind(i+1) == tokenRParen {
+			return -1
+		}
+		return p.skipPatterns(i+1, tokenRParen)
+	}
+	return -1
+}
+
+// skipPatterns skips patterns separated by commas, maybe none, and the
+// token closing them.
+func (p *descent) skipPatterns(i int, closing tokenKind) int {
+	if p.kind(i) != closing {
+		for {
+			if i = p.skipPattern(i, false); i < 0 {
+				return -1
+			}
+			if p.kind(i) != tokenComma {
+				break
+			}
+			i++
+		}
+	}
+	if p.kind(i) != closing {
+		return -1
+	}
+	return i + 1
+}
+
+// skipCasePattern skips the case pattern whose period is tokens[i].
+func (p *descent) skipCasePattern(i int) int {
+	if p.kind(i+1) != tokenIdent {
+		return -1
+	}
+	i += 2
+	if p.kind(i) == tokenLParen {
+		return p.skipPatterns(i+1, tokenRParen)
+	}
+	return i
+}
+
+func (p *descent) skipStructPattern(i int) int {
+	i += 2
+	if p.kind(i) != tokenRBrace {
+		for {
+			if p.kind(i) != tokenIdent {
+				return -1
+			}
+			i++
+			if p.kind(i) == tokenColon {
+				if i = p.skipPattern(i+1, false); i < 0 {
+					return -1
+				}
+			}
+			if p.kind(i) != tokenComma {
+				break
+			}
+			i++
+		}
+	}
+	if p.kind(i) != tokenRBrace {
+		return -1
+	}
+	return i + 1
+}
+
+func (p *descent) skipType(i int) int {
+	switch p.kind(i) {
+	case tokenBasicType:
+		i++
+	case tokenIdent:
+		i++
+		if p.kind(i) == tokenPeriod && p.kind(i+1) == tokenIdent {
+			i += 2
+		}
+	case tokenLBrack:
+		if p.kind(i+1) != tokenRBrack {
+			return -1
+		}
+		if i = p.skipType(i + 2); i < 0 {
+			return -1
+		}
+	case tokenMap:
+		if p.kind(i+1) != tokenLBrack {
+			return -1
+		}
+		if i = p.skipType(i + 2); i < 0 || p.kind(i) != tokenRBrack {
+			return -1
+		}
+		if i = p.skipType(i + 1); i < 0 {
+			return -1
+		}
+	case tokenLParen:
+		n := 0
+		for {
+			if i = p.skipType(i + 1); i < 0 {
+				return -1
+			}
+			n++
+			if p.kind(i) != tokenComma {
+				break
+			}
+		}
+		if n < 2 || p.kind(i) != tokenRParen {
+			return -1
+		}
+		i++
+	case tokenChan, tokenFuture, tokenIterator:
+		if p.kind(i+1) != tokenLBrack {
+			return -1
+		}
+		if i = p.skipType(i + 2); i < 0 || p.kind(i) != tokenRBrack {
+			return -1
+		}
+		i++
+	default:
+		return -1
+	}
+
+	if p.kind(i) == tokenQuestion {
+		i++
+	}
+	return i
+}
+
+// Types
+
+func (p *descent) typeSpec() ast.TypeExpr {
+	t := p.tok()
+
+	var typ ast.TypeExpr
+	switch t.kind {
+	case tokenBasicType:
+		p.next()
+		typ = &ast.BasicType{Span: t.span(), Name: t.text}
+	case tokenIdent:
+		typ = p.typeName()
+	case tokenLBrack:
+		p.next()
+		p.expect(tokenRBrack)
+		elem := p.typeSpec()
+		typ = &ast.ListType{Span: ast.Span{From: t.pos, To: elem.End()}, Elem: elem}
+	case tokenMap:
+		p.next()
+		p.expect(tokenLBrack)
+		key := p.typeSpec()
+		p.expect(tokenRBrack)
+		value := p.typeSpec()
+		typ = &ast.MapType{Span: ast.Span{From: t.pos, To: value.End()}, Key: key, Value: value}
+	case tokenLParen:
+		p.next()
+		elems := p.typeSpecs()
+		if len(elems) < 2 {
+			p.expect(tokenComma)
+		}
+		rparen := p.expect(tokenRParen)
+		typ = &ast.TupleType{Span: ast.Span{From: t.pos, To: rparen.end}, Elems: elems}
+	case tokenChan, tokenFuture, tokenIterator:
+		p.next()
+		p.expect(tokenLBrack)
+		elem := p.typeSpec()
+		span := ast.Span{From: t.pos, To: p.expect(tokenRBrack).end}
+		switch t.kind {
+		case tokenChan:
+			typ = &ast.ChanType{Span: span, Elem: elem}
+		case tokenFuture:
+			typ = &ast.FutureType{Span: span, Elem: elem}
+		default:
+			typ = &ast.IteratorType{Span: span, Elem: elem}
+		}
+	default:
+		p.errorf(t, "no viable alternative at input %s", t)
+	}
+
+	if q := p.tok(); q.kind == tokenQuestion {
+		p.next()
+		return &ast.OptionalType{Span: ast.Span{From: t.pos, To: q.end}, Elem: typ}
+	}
+	return typ
+}
+
+// typeSpecs parses types separated by commas.
+func (p *descent) typeSpecs() []ast.TypeExpr {
+	var types []ast.TypeExpr
+	for {
+		types = append(types, p.typeSpec())
+		if !p.accept(tokenComma) {
+			return types
+		}
+	}
+}
+
+func (p *descent) typeName() *ast.TypeName {
+	name := p.ident()
+	if p.is(tokenPeriod) && p.peek(1) == tokenIdent {
+		p.next()
+		sel := p.ident()
+		return &ast.TypeName{Span: ast.Span{From: name.Pos(), To: sel.End()}, Module: name, Name: sel}
+	}
+	return &ast.TypeName{Span: name.Span, Name: name}
+}
@@ -0,0 +1,161 @@
+package parser_test
+
+import (
+	"bo/ast"
+	"bo/parser"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// sources returns the .bo files under testdata, the invalid ones of
+// testdata/invalid included.
+func sources(t testing.TB) []string {
+	var files []string
+	err := filepath.WalkDir("testdata", func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && filepath.Ext(path) == ".bo" {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no .bo files in testdata")
+	}
+	return files
+}
+
+func TestFrontendsAgree(t *testing.T) {
+	for _, file := range sources(t) {
+		t.Run(file, func(t *testing.T) {
+			src, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if msg := compare(string(src)); msg != "" {
+				t.Error(msg)
+			}
+
+			// Only the files of testdata/invalid are to fail, so that the
+			// front ends agreeing by both failing is not taken for a pass
+			_, err = parser.ParseString(string(src), parser.WithFrontend(parser.Descent))
+			invalid := filepath.Base(filepath.Dir(file)) == "invalid"
+			switch {
+			case invalid && err == nil:
+				t.Error("parses")
+			case !invalid && err != nil:
+				t.Errorf("does not parse: %v", err)
+			}
+		})
+	}
+}
+
+// compare returns how the trees the front ends build from src differ, or ""
+// if they are the same. Both failing on invalid source is agreement.
+func compare(src string) string {
+	want, wantErr := parser.ParseString(src, parser.WithFrontend(parser.ANTLR))
+	got, gotErr := parser.ParseString(src, parser.WithFrontend(parser.Descent))
+
+	switch {
+	case wantErr != nil && gotErr != nil:
+		return ""
+	case wantErr != nil:
+		return fmt.Sprintf("only ANTLR fails: %v", wantErr)
+	case gotErr != nil:
+		return fmt.Sprintf("only descent fails: %v", gotErr)
+	case reflect.DeepEqual(want, got):
+		return ""
+	}
+
+	// Report the first node that differs
+	wantNodes, gotNodes := flatten(want), flatten(got)
+	for i := 0; i < len(wantNodes) && i < len(gotNodes); i++ {
+		if wantNodes[i] != gotNodes[i] {
+			return fmt.Sprintf("ANTLR has %s where descent has %s", wantNodes[i], gotNodes[i])
+		}
+	}
+	if len(wantNodes) != len(gotNodes) {
+		return fmt.Sprintf("ANTLR has %d nodes, descent %d", len(wantNodes), len(gotNodes))
+	}
+	return "the trees differ in a field"
+}
+
+// flatten describes the nodes of prog in the order ast.Inspect visits them.
+func flatten(prog *ast.Program) []string {
+	var nodes []string
+	ast.Inspect(prog, func(n ast.Node) bool {
+		if n != nil {
+			nodes = append(nodes, fmt.Sprintf("%T %s-%s %q", n, n.Pos(), n.End(), leaf(n)))
+		}
+		return true
+	})
+	return nodes
+}
+
+// leaf returns the text a node holds itself, if any.
+func leaf(n ast.Node) string {
+	switch n := n.(type) {
+	case *ast.Ident:
+		return n.Name
+	case *ast.BasicLit:
+		return n.Value
+	case *ast.StringLit:
+		return n.Value
+	case *ast.Unary:
+		return n.Op
+	case *ast.Binary:
+		return n.Op
+	case *ast.BasicType:
+		return n.Name
+	case *ast.Require:
+		return n.Path
+	}
+	return ""
+}
+
+// benchInput returns the valid files of testdata repeated up to 256 KiB.
+func benchInput(b *testing.B) string {
+	var parts []string
+	for _, file := range sources(b) {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := parser.ParseString(string(src), parser.WithFrontend(parser.Descent)); err == nil {
+			parts = append(parts, string(src))
+		}
+	}
+
+	var sb strings.Builder
+	for sb.Len() < 256<<10 {
+		for _, part := range parts {
+			sb.WriteString(part)
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+func benchmarkParse(b *testing.B, f parser.Frontend) {
+	src := benchInput(b)
+	b.SetBytes(int64(len(src)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := parser.ParseString(src, parser.WithFrontend(f)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseANTLR(b *testing.B) { benchmarkParse(b, parser.ANTLR) }
+
+func BenchmarkParseDescent(b *testing.B) { benchmarkParse(b, parser.Descent) }
@@ -0,0 +1,152 @@
+package parser
+
+import (
+	"bo/ast"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// splitString splits the text of a STRING token at pos into its literal
+// and interpolated parts, which parse parses. Escape sequences are decoded
+// and single-quoted strings are never interpolated.
+func splitString(text string, pos ast.Pos, parse func(input string, pos ast.Pos) (ast.Expr, error)) ([]*ast.StringPart, error) {
+	quote, body := text[0], text[1:len(text)-1]
+
+	var parts []*ast.StringPart
+	var literal strings.Builder
+
+	for i := 0; i < len(body); i++ {
+		switch {
+		case body[i] == '\\':
+			r, n, err := unescape(body[i:])
+			if err != nil {
+				line, column := position(pos, text, i+1)
+				return nil, &syntaxError{line: line, column: column, msg: err.Error()}
+			}
+			literal.WriteRune(r)
+			i += n - 1
+		case quote == '"' && strings.HasPrefix(body[i:], "${"):
+			line, column := position(pos, text, i+1)
+			end := closingBrace(body, i+2)
+			if end < 0 {
+				return nil, &syntaxError{line: line, column: column, msg: "unterminated interpolation"}
+			}
+
+			input := body[i+2 : end]
+			if strings.TrimSpace(input) == "" {
+				return nil, &syntaxError{line: line, column: column + 2, msg: "empty interpolation"}
+			}
+
+			expr, err := parse(input, ast.Pos{Line: line, Column: column + 2})
+			if err != nil {
+				return nil, err
+			}
+
+			if literal.Len() > 0 {
+				parts = append(parts, &ast.StringPart{Text: literal.String()})
+				literal.Reset()
+			}
+			parts = append(parts, &ast.StringPart{Expr: expr})
+			i = end
+		default:
+			literal.WriteByte(body[i])
+		}
+	}
+
+	if literal.Len() > 0 || len(parts) == 0 {
+		parts = append(parts, &ast.StringPart{Text: literal.String()})
+	}
+
+	return parts, nil
+}
+
+// parseEmbeddedExpression parses an embedded expression with ANTLR.
+func parseEmbeddedExpression(input string, pos ast.Pos) (expr ast.Expr, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rErr, ok := r.(*syntaxError); ok {
+				expr, err = nil, rErr
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	// Start counting positions where the expression sits in the source file
+	lexer := newLexer(antlr.NewInputStream(input))
+	lexer.Interpreter.(*antlr.LexerATNSimulator).Line = pos.Line
+	lexer.Interpreter.(*antlr.LexerATNSimulator).CharPositionInLine = pos.Column
+
+	parser := newParser(lexer)
+	expr = convertExpression(parser.EmbeddedExpression().Expression())
+
+	return expr, nil
+}
+
+// closingBrace returns the index of the brace closing an interpolation that
+// starts at body[start], skipping nested braces and string literals.
+func closingBrace(body string, start int) int {
+	depth := 0
+	for i := start; i < len(body); i++ {
+		switch c := body[i]; c {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case '"', '\'':
+			for i++; i < len(body) && body[i] != c; i++ {
+				if body[i] == '\\' {
+					i++
+				}
+			}
+		}
+	}
+	return -1
+}
+
+// unescape decodes the escape sequence at the start of s and returns the
+// rune together with the number of bytes consumed.
+func unescape(s string) (rune, int, error) {
+	if len(s) < 2 {
+		return 0, 0, fmt.Errorf("invalid escape sequence %q", s)
+	}
+
+	switch s[1] {
+	case '"', '\'', '\\', '/', '$':
+		return rune(s[1]), 2, nil
+	case 'b':
+		return '\b', 2, nil
+	case 'f':
+		return '\f', 2, nil
+	case 'n':
+		return '\n', 2, nil
+	case 'r':
+		return '\r', 2, nil
+	case 't':
+		return '\t', 2, nil
+	case 'u':
+		if len(s) >= 6 {
+			if code, err := strconv.ParseUint(s[2:6], 16, 32); err == nil {
+				return rune(code), 6, nil
+			}
+		}
+	}
+
+	return 0, 0, fmt.Errorf("invalid escape sequence %q", s[:2])
+}
+
+// position returns the line and column of text[offset] for a token at pos.
+func position(pos ast.Pos, text string, offset int) (int, int) {
+	line, column := pos.Line, pos.Column+offset
+	if nl := strings.LastIndexByte(text[:offset], '\n'); nl >= 0 {
+		line += strings.Count(text[:offset], "\n")
+		column = offset - nl - 1
+	}
+	return line, column
+}
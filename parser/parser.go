@@ -1,40 +1,94 @@
 package parser
 
 import (
+	"bo/ast"
+	"os"
+
 	"github.com/antlr4-go/antlr/v4"
 )
 
-func Parse(input *antlr.InputStream) (tree IProgramContext, err error) {
+// Frontend selects the parser that builds the syntax tree.
+type Frontend int
+
+const (
+	// ANTLR is the parser generated from grammar/Bo.g4.
+	ANTLR Frontend = iota
+	// Descent is the hand-written recursive-descent parser. It builds the
+	// same tree as ANTLR, several times faster.
+	Descent
+)
+
+type options struct {
+	frontend Frontend
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Option configures Parse.
+type Option func(*options)
+
+// WithFrontend selects the parser to use, ANTLR by default.
+func WithFrontend(f Frontend) Option {
+	return func(o *options) {
+		o.frontend = f
+	}
+}
+
+// Parse parses a program and returns its syntax tree.
+func Parse(input *antlr.InputStream, opts ...Option) (prog *ast.Program, err error) {
+	if newOptions(opts).frontend == Descent {
+		return parseDescent(input.GetText(0, input.Size()-1))
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
 			if rErr, ok := r.(*syntaxError); ok {
-				tree, err = nil, rErr
+				prog, err = nil, rErr
 			} else {
 				panic(r)
 			}
 		}
 	}()
 
+	parser := newParser(newLexer(input))
+
+	return convertProgram(parser.Program()), nil
+}
+
+func ParseString(input string, opts ...Option) (*ast.Program, error) {
+	if newOptions(opts).frontend == Descent {
+		return parseDescent(input)
+	}
+	return Parse(antlr.NewInputStream(input), opts...)
+}
+
+func ParseFile(filename string, opts ...Option) (*ast.Program, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseString(string(src), opts...)
+}
+
+func newLexer(input antlr.CharStream) *BoLexer {
 	lexer := NewBoLexer(input)
 	lexer.RemoveErrorListeners()
 	lexer.AddErrorListener(newErrorListener())
 
+	return lexer
+}
+
+func newParser(lexer *BoLexer) *BoParser {
 	tokens := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
 	parser := NewBoParser(tokens)
 	parser.RemoveErrorListeners()
 	parser.AddErrorListener(newErrorListener())
 
-	return parser.Program(), nil
-}
-
-func ParseString(input string) (antlr.ParseTree, error) {
-	return Parse(antlr.NewInputStream(input))
-}
-
-func ParseFile(filename string) (antlr.ParseTree, error) {
-	fs, err := antlr.NewFileStream(filename)
-	if err != nil {
-		return nil, err
-	}
-	return Parse(&fs.InputStream)
+	return parser
 }
@@ -0,0 +1,507 @@
+package parser
+
+import (
+	"bo/ast"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// tokenKind is the kind of a token of the hand-written scanner. It follows
+// the lexer rules of grammar/Bo.g4.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenInt
+	tokenFloat
+	tokenBigInt
+	tokenDecimal
+	tokenString
+	tokenBool
+	tokenNil
+	tokenUnderscore
+	tokenBasicType // int, string, ...
+
+	// Keywords
+	tokenRequire
+	tokenEnum
+	tokenMatch
+	tokenSwitch
+	tokenCase
+	tokenIf
+	tokenStruct
+	tokenMap
+	tokenFunc
+	tokenReturn
+	tokenSpawn
+	tokenSelect
+	tokenDefault
+	tokenChan
+	tokenAsync
+	tokenDefer
+	tokenAwait
+	tokenFuture
+	tokenYield
+	tokenFor
+	tokenIn
+	tokenIterator
+	tokenStep
+
+	// Operators and punctuation
+	tokenLE
+	tokenGE
+	tokenEQ
+	tokenNE
+	tokenLT
+	tokenGT
+	tokenAssign
+	tokenArrow
+	tokenReceive
+	tokenAdd
+	tokenSub
+	tokenMul
+	tokenDiv
+	tokenMod
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenAddWrap
+	tokenSubWrap
+	tokenMulWrap
+	tokenLParen
+	tokenRParen
+	tokenLBrace
+	tokenRBrace
+	tokenLBrack
+	tokenRBrack
+	tokenColon
+	tokenEllipsis
+	tokenRangeExcl
+	tokenRange
+	tokenPeriod
+	tokenComma
+	tokenSemicolon
+	tokenQuestion
+	tokenSafePeriod
+	tokenCoalesce
+)
+
+var keywords = map[string]tokenKind{
+	"require":  tokenRequire,
+	"enum":     tokenEnum,
+	"match":    tokenMatch,
+	"switch":   tokenSwitch,
+	"case":     tokenCase,
+	"if":       tokenIf,
+	"struct":   tokenStruct,
+	"map":      tokenMap,
+	"func":     tokenFunc,
+	"return":   tokenReturn,
+	"spawn":    tokenSpawn,
+	"select":   tokenSelect,
+	"default":  tokenDefault,
+	"chan":     tokenChan,
+	"async":    tokenAsync,
+	"defer":    tokenDefer,
+	"await":    tokenAwait,
+	"Future":   tokenFuture,
+	"yield":    tokenYield,
+	"for":      tokenFor,
+	"in":       tokenIn,
+	"Iterator": tokenIterator,
+	"step":     tokenStep,
+	"true":     tokenBool,
+	"false":    tokenBool,
+	"nil":      tokenNil,
+	"int":      tokenBasicType,
+	"int8":     tokenBasicType,
+	"int16":    tokenBasicType,
+	"int32":    tokenBasicType,
+	"int64":    tokenBasicType,
+	"uint8":    tokenBasicType,
+	"uint16":   tokenBasicType,
+	"uint32":   tokenBasicType,
+	"uint64":   tokenBasicType,
+	"float":    tokenBasicType,
+	"float32":  tokenBasicType,
+	"bigint":   tokenBasicType,
+	"decimal":  tokenBasicType,
+	"byte":     tokenBasicType,
+	"char":     tokenBasicType,
+	"rune":     tokenBasicType,
+	"string":   tokenBasicType,
+	"bool":     tokenBasicType,
+}
+
+// operators are the operator and punctuation tokens, longest first among
+// those sharing a prefix.
+var operators = []struct {
+	text string
+	kind tokenKind
+}{
+	{"<=", tokenLE}, {"<-", tokenReceive}, {"<", tokenLT},
+	{">=", tokenGE}, {">", tokenGT},
+	{"==", tokenEQ}, {"=>", tokenArrow}, {"=", tokenAssign},
+	{"!=", tokenNE}, {"!", tokenNot},
+	{"+%", tokenAddWrap}, {"+", tokenAdd},
+	{"-%", tokenSubWrap}, {"-", tokenSub},
+	{"*%", tokenMulWrap}, {"*", tokenMul},
+	{"/", tokenDiv}, {"%", tokenMod},
+	{"&&", tokenAnd}, {"||", tokenOr},
+	{"(", tokenLParen}, {")", tokenRParen},
+	{"{", tokenLBrace}, {"}", tokenRBrace},
+	{"[", tokenLBrack}, {"]", tokenRBrack},
+	{":", tokenColon}, {",", tokenComma}, {";", tokenSemicolon},
+	{"...", tokenEllipsis}, {"..<", tokenRangeExcl}, {"..", tokenRange}, {".", tokenPeriod},
+	{"?.", tokenSafePeriod}, {"??", tokenCoalesce}, {"?", tokenQuestion},
+}
+
+// tokenNames are the names of the kinds of tokens in error messages,
+// completed with the quoted keywords and operators.
+var tokenNames = map[tokenKind]string{
+	tokenEOF:        "<EOF>",
+	tokenIdent:      "ID",
+	tokenInt:        "INT",
+	tokenFloat:      "FLOAT",
+	tokenBigInt:     "BIGINT",
+	tokenDecimal:    "DECIMAL",
+	tokenString:     "STRING",
+	tokenBool:       "BOOL",
+	tokenNil:        "NIL",
+	tokenUnderscore: "'_'",
+	tokenBasicType:  "type",
+}
+
+// operatorsFrom indexes the operators by their first byte.
+var operatorsFrom [256][]int
+
+func init() {
+	for i, op := range operators {
+		operatorsFrom[op.text[0]] = append(operatorsFrom[op.text[0]], i)
+	}
+
+	for text, kind := range keywords {
+		if _, ok := tokenNames[kind]; !ok {
+			tokenNames[kind] = "'" + text + "'"
+		}
+	}
+	for _, op := range operators {
+		tokenNames[op.kind] = "'" + op.text + "'"
+	}
+}
+
+func (k tokenKind) String() string {
+	return tokenNames[k]
+}
+
+type token struct {
+	kind     tokenKind
+	text     string
+	pos, end ast.Pos
+}
+
+func (t token) span() ast.Span {
+	return ast.Span{From: t.pos, To: t.end}
+}
+
+// String returns the token as ANTLR quotes it in error messages.
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "'<EOF>'"
+	}
+	return "'" + strings.NewReplacer("\n", "\\n", "\r", "\\r", "\t", "\\t").Replace(t.text) + "'"
+}
+
+// scanner splits source into tokens, skipping white space and comments.
+// Positions count lines from 1 and columns, in runes, from 0, as ANTLR
+// does.
+type scanner struct {
+	src    string
+	offset int
+	pos    ast.Pos
+}
+
+// scan returns the tokens of src, ending with EOF, whose first character
+// is at pos.
+func scan(src string, pos ast.Pos) []token {
+	s := &scanner{src: src, pos: pos}
+
+	tokens := make([]token, 0, len(src)/3)
+	for {
+		t := s.next()
+		tokens = append(tokens, t)
+		if t.kind == tokenEOF {
+			return tokens
+		}
+	}
+}
+
+// advance moves n bytes ahead.
+func (s *scanner) advance(n int) {
+	for i := s.offset; i < s.offset+n; i++ {
+		if b := s.src[i]; b == '\n' {
+			s.pos.Line++
+			s.pos.Column = 0
+		} else if utf8.RuneStart(b) {
+			s.pos.Column++
+		}
+	}
+	s.offset += n
+}
+
+func (s *scanner) errorf(format string, args ...interface{}) {
+	panic(&syntaxError{line: s.pos.Line, column: s.pos.Column, msg: fmt.Sprintf(format, args...)})
+}
+
+func (s *scanner) next() token {
+	s.skip()
+
+	t := token{pos: s.pos}
+	rest := s.src[s.offset:]
+
+	var n int
+	switch c := byteAt(rest, 0); {
+	case len(rest) == 0:
+		t.kind = tokenEOF
+	case isLetter(c):
+		n = 1
+		for n < len(rest) && (isLetter(rest[n]) || isDigit(rest[n])) {
+			n++
+		}
+		t.kind = tokenIdent
+		if kind, ok := keywords[rest[:n]]; ok {
+			t.kind = kind
+		} else if rest[:n] == "_" {
+			t.kind = tokenUnderscore
+		}
+	case isDigit(c):
+		t.kind, n = number(rest)
+	case c == '"' || c == '\'':
+		var ok bool
+		if n, ok = stringLength(rest); !ok {
+			s.errorf("token recognition error at: '%s'", rest[:n])
+		}
+		t.kind = tokenString
+	default:
+		for _, i := range operatorsFrom[c] {
+			if op := operators[i]; strings.HasPrefix(rest, op.text) {
+				t.kind, n = op.kind, len(op.text)
+				break
+			}
+		}
+		if n == 0 {
+			r, _ := utf8.DecodeRuneInString(rest)
+			s.errorf("token recognition error at: '%c'", r)
+		}
+	}
+
+	t.text = rest[:n]
+	s.advance(n)
+	t.end = s.pos
+
+	return t
+}
+
+// skip moves past white space and comments. A line comment must end with
+// a newline and a block comment with */, as in the grammar: otherwise the
+// slash is a division.
+func (s *scanner) skip() {
+	for s.offset < len(s.src) {
+		rest := s.src[s.offset:]
+		switch {
+		case rest[0] == ' ' || rest[0] == '\t' || rest[0] == '\r' || rest[0] == '\n':
+			s.advance(1)
+		case len(rest) >= 2 && rest[:2] == "//":
+			nl := strings.IndexByte(rest, '\n')
+			if nl < 0 {
+				return
+			}
+			s.advance(nl + 1)
+		case len(rest) >= 2 && rest[:2] == "/*":
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				return
+			}
+			s.advance(end + 4)
+		default:
+			return
+		}
+	}
+}
+
+// number returns the kind and length of the longest number literal at the
+// start of s, which starts with a digit.
+func number(s string) (tokenKind, int) {
+	kind, n := tokenInt, digits(s, isDigit)
+
+	// 0x, 0o and 0b integers
+	if s[0] == '0' && len(s) > 1 {
+		var isDigitOf func(byte) bool
+		switch s[1] {
+		case 'x', 'X':
+			isDigitOf = isHex
+		case 'o', 'O':
+			isDigitOf = isOctal
+		case 'b', 'B':
+			isDigitOf = isBinary
+		}
+		if isDigitOf != nil {
+			i := 2
+			if byteAt(s, i) == '_' {
+				i++
+			}
+			if m := digits(s[i:], isDigitOf); m > 0 {
+				n = i + m
+				if byteAt(s, n) == 'n' {
+					return tokenBigInt, n + 1
+				}
+				return tokenInt, n
+			}
+		}
+	}
+
+	// A fraction needs digits after the period, so that 0..10 is a range
+	fraction := 0
+	if byteAt(s, n) == '.' {
+		fraction = digits(s[n+1:], isDigit)
+		if fraction > 0 {
+			kind, n = tokenFloat, n+1+fraction
+		}
+	}
+
+	switch byteAt(s, n) {
+	case 'e', 'E':
+		i := n + 1
+		if c := byteAt(s, i); c == '+' || c == '-' {
+			i++
+		}
+		if m := digits(s[i:], isDigit); m > 0 {
+			return tokenFloat, i + m
+		}
+	case 'n':
+		if kind == tokenInt {
+			return tokenBigInt, n + 1
+		}
+	case 'm':
+		return tokenDecimal, n + 1
+	}
+
+	return kind, n
+}
+
+// digits returns the length of the digits at the start of s, which may be
+// separated by single underscores.
+func digits(s string, isDigitOf func(byte) bool) int {
+	if len(s) == 0 || !isDigitOf(s[0]) {
+		return 0
+	}
+
+	n := 1
+	for n < len(s) {
+		if isDigitOf(s[n]) {
+			n++
+		} else if s[n] == '_' && n+1 < len(s) && isDigitOf(s[n+1]) {
+			n += 2
+		} else {
+			break
+		}
+	}
+	return n
+}
+
+// stringLength returns the length of the string literal at the start of s.
+// If it is not terminated or has an invalid escape sequence, it returns
+// false and the length up to the character in error. Double quoted strings
+// may embed expressions with ${...}, whose braces and nested strings are
+// balanced.
+func stringLength(s string) (int, bool) {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == quote:
+			return i + 1, true
+		case c == '\\':
+			n, ok := escapeLength(s[i:])
+			if !ok {
+				return i + n, false
+			}
+			i += n - 1
+		case quote == '"' && c == '$' && byteAt(s, i+1) == '{':
+			// Without a closing brace the $ is a character of its own
+			if n := interpolationLength(s[i:]); n > 0 {
+				i += n - 1
+			}
+		}
+	}
+	return len(s), false
+}
+
+// interpolationLength returns the length of the ${...} at the start of s,
+// or -1 if it is not closed.
+func interpolationLength(s string) int {
+	depth := 0
+	for i := 2; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i + 1
+			}
+			depth--
+		case '"', '\'':
+			n, ok := stringLength(s[i:])
+			if !ok {
+				return -1
+			}
+			i += n - 1
+		}
+	}
+	return -1
+}
+
+// escapeLength returns the length of the escape sequence at the start of s,
+// or false and the length up to the character in error.
+func escapeLength(s string) (int, bool) {
+	switch byteAt(s, 1) {
+	case '"', '\\', '/', '$', 'b', 'f', 'n', 'r', 't':
+		return 2, true
+	case 'u':
+		for i := 2; i < 6; i++ {
+			if !isHex(byteAt(s, i)) {
+				return min(i+1, len(s)), false
+			}
+		}
+		return 6, true
+	}
+	return min(2, len(s)), false
+}
+
+func byteAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return 0
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHex(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isOctal(c byte) bool {
+	return '0' <= c && c <= '7'
+}
+
+func isBinary(c byte) bool {
+	return c == '0' || c == '1'
+}
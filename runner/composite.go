@@ -0,0 +1,107 @@
+package runner
+
+import (
+	"bo/ast"
+	"bo/value"
+	"fmt"
+)
+
+func (v *BoVisitor) VisitStructDeclaration(decl *ast.StructDecl) interface{} {
+	var fields []string
+	for _, field := range decl.Fields {
+		fields = append(fields, field.Name.Name)
+	}
+
+	v.symbolTable[decl.Name.Name] = value.NewStructDef(decl.Name.Name, fields)
+
+	return nil
+}
+
+func (v *BoVisitor) VisitListLiteral(lit *ast.ListLit) interface{} {
+	l := make(value.List, 0, len(lit.Elems))
+	for _, elem := range lit.Elems {
+		l = append(l, v.eval(elem))
+	}
+	return l
+}
+
+func (v *BoVisitor) VisitMapLiteral(lit *ast.MapLit) interface{} {
+	m := value.NewMap()
+	for _, entry := range lit.Entries {
+		m.Set(v.eval(entry.Key), v.eval(entry.Value))
+	}
+	return m
+}
+
+func (v *BoVisitor) VisitTupleLiteral(lit *ast.TupleLit) interface{} {
+	t := make(value.Tuple, 0, len(lit.Elems))
+	for _, elem := range lit.Elems {
+		t = append(t, v.eval(elem))
+	}
+	return t
+}
+
+// VisitStructLiteral evaluates a struct literal. Fields left out are nil.
+func (v *BoVisitor) VisitStructLiteral(lit *ast.StructLit) interface{} {
+	def, ok := v.symbolTable[lit.Type.Name].(*value.StructDef)
+	if !ok {
+		panic(fmt.Sprintf("VisitStructLiteral -> %s is not a struct", lit.Type.Name))
+	}
+
+	s := value.NewStructValue(def)
+	for _, field := range lit.Fields {
+		s.Fields[def.Field(field.Name.Name)] = v.eval(field.Value)
+	}
+	return s
+}
+
+func (v *BoVisitor) VisitIndexExpression(expr *ast.Index) interface{} {
+	obj := v.eval(expr.X)
+	index := v.eval(expr.Index)
+	if o := v.info.Operators[expr]; o != nil {
+		return v.overloaded(o, obj, index)
+	}
+
+	return value.Index(obj, index)
+}
+
+func (v *BoVisitor) VisitDestructuringDeclaration(decl *ast.Destructure) interface{} {
+	val := v.eval(decl.Value)
+
+	bindings := make(map[string]interface{})
+	if !v.match(decl.Pattern, val, bindings) {
+		value.Mismatch(val, ast.String(decl.Pattern))
+	}
+	for name, val := range bindings {
+		v.symbolTable[name] = val
+	}
+
+	return nil
+}
+
+// matchList reports whether the elements of l match the patterns of a list
+// pattern, the last of which may be a rest pattern.
+func (v *BoVisitor) matchList(patterns []ast.Pattern, l value.List, bindings map[string]interface{}) bool {
+	if len(patterns) > 0 {
+		if rest, ok := patterns[len(patterns)-1].(*ast.RestPattern); ok {
+			patterns = patterns[:len(patterns)-1]
+			if len(l) < len(patterns) {
+				return false
+			}
+			if rest.Name != nil {
+				bindings[rest.Name.Name] = append(value.List(nil), l[len(patterns):]...)
+			}
+			l = l[:len(patterns)]
+		}
+	}
+
+	if len(l) != len(patterns) {
+		return false
+	}
+	for i, elem := range patterns {
+		if !v.match(elem, l[i], bindings) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package runner
+
+import (
+	"bo/ast"
+	"bo/value"
+)
+
+// start runs run as a task of its own and returns the future of its result.
+func (v *BoVisitor) start(run func(task *BoVisitor) interface{}) *value.Future {
+	task := v.fork()
+	return value.Start(v.ctx, func() interface{} {
+		return run(task)
+	})
+}
+
+// VisitSpawnStatement runs a call as a task of its own. The callee and its
+// arguments are evaluated by the spawning task, the call by the new one.
+//
+// Each task has its own frames: what it declares is not seen by the others.
+// Variables never change once declared, so tasks share values freely and
+// communicate through channels.
+func (v *BoVisitor) VisitSpawnStatement(stmt *ast.Spawn) interface{} {
+	fn := v.callee(stmt.Call)
+	if fn == nil {
+		return nil
+	}
+	args := v.arguments(stmt.Call)
+
+	task, call := v.fork(), v.info.Calls[stmt.Call]
+	value.Go(v.ctx, func() {
+		task.apply(fn, args, call)
+	})
+
+	return nil
+}
+
+func (v *BoVisitor) VisitSendStatement(stmt *ast.Send) interface{} {
+	ch := v.eval(stmt.Chan).(*value.Channel)
+	ch.Send(v.ctx, v.eval(stmt.Value))
+
+	return nil
+}
+
+// VisitReceiveExpression receives a value, or nil once the channel is
+// closed and drained.
+func (v *BoVisitor) VisitReceiveExpression(expr *ast.Receive) interface{} {
+	return v.eval(expr.Chan).(*value.Channel).Receive(v.ctx)
+}
+
+// VisitSelectStatement runs the arm of the first send or receive that can
+// go ahead, or the default arm if none can right away.
+func (v *BoVisitor) VisitSelectStatement(stmt *ast.Select) interface{} {
+	cases := make([]value.SelectCase, len(stmt.Arms))
+	for i, arm := range stmt.Arms {
+		switch a := arm.(type) {
+		case *ast.ReceiveArm:
+			cases[i].Chan = v.eval(a.Chan).(*value.Channel)
+		case *ast.SendArm:
+			cases[i].Chan = v.eval(a.Chan).(*value.Channel)
+			cases[i].Send = true
+			cases[i].Value = v.eval(a.Value)
+		}
+	}
+
+	chosen, received := value.Select(v.ctx, cases)
+
+	switch arm := stmt.Arms[chosen].(type) {
+	case *ast.ReceiveArm:
+		v.block(func() {
+			if arm.Name != nil {
+				v.symbolTable[arm.Name.Name] = received
+			}
+			v.Visit(arm.Body)
+		})
+	case *ast.SendArm:
+		v.Visit(arm.Body)
+	case *ast.DefaultArm:
+		v.Visit(arm.Body)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package runner
+
+import (
+	"bo/ast"
+	"bo/value"
+)
+
+func (v *BoVisitor) VisitEnumDeclaration(decl *ast.EnumDecl) interface{} {
+	e := value.NewEnum(decl.Name.Name)
+	for _, enumCase := range decl.Cases {
+		e.AddCase(enumCase.Name.Name, len(enumCase.Fields))
+	}
+
+	v.symbolTable[e.Name] = e
+
+	return nil
+}
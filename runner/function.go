@@ -0,0 +1,154 @@
+package runner
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"bo/value"
+	"context"
+	"fmt"
+	"maps"
+)
+
+// function is the value a function declaration binds. It runs in the block
+// it was declared in, a method with the value it was taken from as its
+// receiver.
+type function struct {
+	decl     *ast.FuncDecl
+	closure  map[string]interface{}
+	receiver interface{}
+
+	// What a task needs to run the function, for the builtins that call it
+	ctx  context.Context
+	info *checker.Info
+}
+
+// Bind returns the method fn with receiver as its receiver.
+func (fn *function) Bind(receiver interface{}) value.Function {
+	bound := *fn
+	bound.receiver = receiver
+	return &bound
+}
+
+// Run calls fn with no arguments in a task of its own.
+func (fn *function) Run() interface{} {
+	return NewBoVisitor(fn.ctx, fn.info).apply(fn, nil, &checker.Call{})
+}
+
+// returned carries the result of a return statement up to the call it ends.
+type returned struct {
+	value interface{}
+}
+
+// VisitFunctionDeclaration binds a function that sees the variables declared
+// before it, and itself. Variables never change once declared, so the
+// function keeps a copy of them that tasks running it may share. A method
+// is added to its struct instead.
+func (v *BoVisitor) VisitFunctionDeclaration(decl *ast.FuncDecl) interface{} {
+	fn := &function{decl: decl, closure: maps.Clone(v.symbolTable), ctx: v.ctx, info: v.info}
+
+	if decl.Recv != nil {
+		name := ast.String(decl.Recv.Type)
+		s, ok := v.symbolTable[name].(*value.StructDef)
+		if !ok {
+			panic(fmt.Sprintf("VisitFunctionDeclaration -> %s is not a struct", name))
+		}
+		if decl.Operator {
+			s.AddOperator(decl, decl.Name.Name, fn)
+		} else {
+			s.AddMethod(decl.Name.Name, fn)
+		}
+
+		return nil
+	}
+
+	fn.closure[decl.Name.Name] = fn
+	v.symbolTable[decl.Name.Name] = fn
+
+	return nil
+}
+
+// VisitReturnStatement ends the call being run. Several values are returned
+// as a tuple.
+func (v *BoVisitor) VisitReturnStatement(ret *ast.Return) interface{} {
+	exprs := ret.Results
+
+	var result interface{}
+	if len(exprs) == 1 {
+		result = v.eval(exprs[0])
+	} else if len(exprs) > 1 {
+		t := make(value.Tuple, len(exprs))
+		for i, expr := range exprs {
+			t[i] = v.eval(expr)
+		}
+		result = t
+	}
+
+	panic(returned{value: result})
+}
+
+// VisitDeferStatement evaluates a call, which runs when the function being
+// run returns. Deferred calls run last to first.
+func (v *BoVisitor) VisitDeferStatement(stmt *ast.Defer) interface{} {
+	fn := v.callee(stmt.Call)
+	if fn == nil {
+		return nil
+	}
+	args := v.arguments(stmt.Call)
+
+	v.deferred = append(v.deferred, func() {
+		v.apply(fn, args, v.info.Calls[stmt.Call])
+	})
+
+	return nil
+}
+
+// runDeferred runs calls last to first. Each one runs even if a later one
+// fails.
+func runDeferred(calls []func()) {
+	if len(calls) == 0 {
+		return
+	}
+
+	defer runDeferred(calls[:len(calls)-1])
+	calls[len(calls)-1]()
+}
+
+// invoke runs the body of fn with its parameters bound to args, which are
+// already converted to the parameter types, and returns its result. The
+// parameters for which given has no argument take their default values.
+func (v *BoVisitor) invoke(fn *function, args []interface{}, given []ast.Expr) (result interface{}) {
+	symbolTable, deferred := v.symbolTable, v.deferred
+	v.symbolTable, v.deferred = maps.Clone(fn.closure), nil
+
+	defer func() {
+		r := recover()
+
+		// Deferred calls run even when a runtime error unwinds the call,
+		// which goes on once they are done
+		calls := v.deferred
+		v.symbolTable, v.deferred = symbolTable, deferred
+		runDeferred(calls)
+
+		if ret, ok := r.(returned); ok {
+			result = ret.value
+		} else if r != nil {
+			panic(r)
+		}
+	}()
+
+	if fn.decl.Recv != nil {
+		v.symbolTable[fn.decl.Recv.Name.Name] = fn.receiver
+	}
+	for i, param := range fn.decl.Params {
+		if i < len(given) && given[i] == nil {
+			// Evaluated with the parameters before it in scope
+			args[i] = v.eval(param.Default)
+		}
+		v.symbolTable[param.Name.Name] = args[i]
+	}
+	for _, stmt := range fn.decl.Body.Stmts {
+		v.VisitStatement(stmt)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package runner
+
+import (
+	"bo/ast"
+	"bo/value"
+)
+
+// generate returns an iterator over the values fn yields when called with
+// args, in a task of its own.
+func (v *BoVisitor) generate(fn *function, args []interface{}, given []ast.Expr) *value.IterValue {
+	task := v.fork()
+	return value.Generate(v.ctx, func(g *value.Generator) {
+		task.generator = g
+		task.invoke(fn, args, given)
+	})
+}
+
+// VisitYieldStatement hands a value to the task asking for it, then waits
+// until the next one is asked for.
+func (v *BoVisitor) VisitYieldStatement(stmt *ast.Yield) interface{} {
+	v.generator.Yield(v.ctx, v.eval(stmt.Value))
+
+	return nil
+}
+
+// VisitForStatement runs a block for each value of an iterable, with the
+// variables its pattern binds in scope. An iterator left before its end is
+// stopped.
+func (v *BoVisitor) VisitForStatement(stmt *ast.For) interface{} {
+	it := value.Iterate(v.eval(stmt.X))
+	defer it.Stop()
+
+	for {
+		val, ok := it.Next()
+		if !ok {
+			return nil
+		}
+
+		bindings := make(map[string]interface{})
+		if !v.match(stmt.Pattern, val, bindings) {
+			value.Mismatch(val, ast.String(stmt.Pattern))
+		}
+		v.block(func() {
+			for name, val := range bindings {
+				v.symbolTable[name] = val
+			}
+			v.Visit(stmt.Body)
+		})
+	}
+}
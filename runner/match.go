@@ -0,0 +1,171 @@
+package runner
+
+import (
+	"bo/ast"
+	"bo/decimal"
+	"bo/value"
+	"fmt"
+	"maps"
+	"math/big"
+)
+
+func (v *BoVisitor) VisitMatchExpression(expr *ast.Match) interface{} {
+	subject := v.eval(expr.X)
+
+	for _, arm := range expr.Arms {
+		var val interface{}
+		matched := v.arm(arm.Pattern, arm.Guard, subject, func() {
+			val = v.eval(arm.Value)
+		})
+		if matched {
+			return val
+		}
+	}
+
+	// Only arms with guards can leave a value unmatched
+	value.NoMatch(subject)
+	return nil
+}
+
+func (v *BoVisitor) VisitSwitchStatement(stmt *ast.Switch) interface{} {
+	subject := v.eval(stmt.X)
+
+	for _, arm := range stmt.Arms {
+		if v.arm(arm.Pattern, arm.Guard, subject, func() { v.Visit(arm.Body) }) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// arm runs body if subject matches the pattern and guard of an arm, with the
+// variables the pattern binds in scope, and reports whether it did.
+func (v *BoVisitor) arm(pattern ast.Pattern, guard ast.Expr, subject interface{}, body func()) bool {
+	bindings := make(map[string]interface{})
+	if !v.match(pattern, subject, bindings) {
+		return false
+	}
+
+	matched := false
+	v.block(func() {
+		maps.Copy(v.symbolTable, bindings)
+		if guard == nil || v.eval(guard).(bool) {
+			body()
+			matched = true
+		}
+	})
+
+	return matched
+}
+
+// match reports whether val matches pattern, adding the variables the
+// pattern binds to bindings.
+func (v *BoVisitor) match(pattern ast.Pattern, val interface{}, bindings map[string]interface{}) bool {
+	switch p := pattern.(type) {
+	case *ast.WildcardPattern:
+		return true
+	case *ast.BindingPattern:
+		bindings[p.Name.Name] = val
+		return true
+	case *ast.LiteralPattern:
+		return value.Equal(val, v.patternValue(p))
+	case *ast.CasePattern:
+		e, ok := val.(value.EnumValue)
+		if !ok || e.Name != p.Case.Name {
+			return false
+		}
+		for i, field := range p.Fields {
+			if !v.match(field, e.Fields[i], bindings) {
+				return false
+			}
+		}
+		return true
+	case *ast.ListPattern:
+		l, ok := val.(value.List)
+		return ok && v.matchList(p.Elems, l, bindings)
+	case *ast.MapPattern:
+		m, ok := val.(*value.Map)
+		if !ok {
+			return false
+		}
+		for _, entry := range p.Entries {
+			// A missing key matches as nil
+			elem, _ := m.Get(v.patternValue(entry.Key.(*ast.LiteralPattern)))
+			if !v.match(entry.Value, elem, bindings) {
+				return false
+			}
+		}
+		return true
+	case *ast.TuplePattern:
+		t, ok := val.(value.Tuple)
+		if !ok {
+			return false
+		}
+		for i, elem := range p.Elems {
+			if !v.match(elem, t[i], bindings) {
+				return false
+			}
+		}
+		return true
+	case *ast.StructPattern:
+		s, ok := val.(*value.StructValue)
+		if !ok {
+			return false
+		}
+		for _, field := range p.Fields {
+			elem := s.Fields[s.Def.Field(field.Name.Name)]
+			if field.Pattern == nil {
+				bindings[field.Name.Name] = elem
+			} else if !v.match(field.Pattern, elem, bindings) {
+				return false
+			}
+		}
+		return true
+	default:
+		panic(fmt.Sprintf("match -> unhandled pattern type: %T", p))
+	}
+}
+
+// patternValue returns the value of a literal pattern, converted to the
+// type of the values it is matched against.
+func (v *BoVisitor) patternValue(p *ast.LiteralPattern) interface{} {
+	lit, ok := p.Value.(*ast.BasicLit)
+	if !ok {
+		return v.interpolate(p.Value.(*ast.StringLit))
+	}
+
+	var val interface{}
+	switch lit.Kind {
+	case ast.Nil:
+		return nil
+	case ast.Bool:
+		return lit.Value == "true"
+	case ast.Int, ast.BigInt:
+		val = ast.BigIntLiteral(lit.Value)
+	case ast.Decimal:
+		d, err := ast.DecimalLiteral(lit.Value)
+		if err != nil {
+			panic(fmt.Sprintf("patternValue -> %s", err))
+		}
+		val = d
+	case ast.Float:
+		if d, err := ast.DecimalLiteral(lit.Value); err == nil {
+			val = d
+		} else if val, err = ast.FloatLiteral(lit.Value); err != nil {
+			panic(fmt.Sprintf("patternValue -> %s", err))
+		}
+	}
+
+	if p.Neg {
+		switch lit := val.(type) {
+		case *big.Int:
+			val = new(big.Int).Neg(lit)
+		case decimal.Decimal:
+			val = lit.Neg()
+		case float64:
+			val = -lit
+		}
+	}
+	return value.Convert(val, v.info.Types[p])
+}
@@ -0,0 +1,28 @@
+package runner
+
+import (
+	"bo/ast"
+	"bo/checker"
+	"bo/value"
+)
+
+// overloaded calls the operator method o of recv, a struct value, with
+// args.
+func (v *BoVisitor) overloaded(o *checker.Overload, recv interface{}, args ...interface{}) interface{} {
+	s := recv.(*value.StructValue)
+	result := v.apply(s.Def.Operator(o.Decl).Bind(s), args, &checker.Call{})
+	if o.Negate {
+		return !result.(bool)
+	}
+	return result
+}
+
+// binaryOverloaded evaluates the operands of a binary expression, left to
+// right, and calls the operator method o with them.
+func (v *BoVisitor) binaryOverloaded(o *checker.Overload, x, y ast.Expr) interface{} {
+	left, right := v.eval(x), v.eval(y)
+	if o.Swap {
+		left, right = right, left
+	}
+	return v.overloaded(o, left, right)
+}
@@ -0,0 +1,15 @@
+package runner
+
+import (
+	"bo/ast"
+	"bo/value"
+)
+
+func (v *BoVisitor) VisitRangeExpression(expr *ast.Range) interface{} {
+	from, to := v.eval(expr.From).(int64), v.eval(expr.To).(int64)
+	return value.NewRange(from, to, expr.Exclusive)
+}
+
+func (v *BoVisitor) VisitStepExpression(expr *ast.Step) interface{} {
+	return v.eval(expr.X).(value.Range).Every(v.eval(expr.Step).(int64))
+}
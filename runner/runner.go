@@ -1,14 +1,21 @@
 package runner
 
 import (
-	"github.com/antlr4-go/antlr/v4"
+	"bo/ast"
+	"bo/checker"
+	"bo/value"
+	"context"
 )
 
-func RunProgram(input antlr.ParseTree) {
-	if input == nil {
-		return
+// RunProgram runs a checked program until it ends or ctx is cancelled,
+// and returns the runtime error it failed with, nil if none. The tasks it
+// spawns are left running once it ends.
+func RunProgram(ctx context.Context, prog *ast.Program, info *checker.Info) error {
+	if prog == nil {
+		return nil
 	}
 
-	visitor := NewBoVisitor()
-	visitor.Visit(input)
+	return value.Run(ctx, func(ctx context.Context) {
+		NewBoVisitor(ctx, info).Visit(prog)
+	})
 }
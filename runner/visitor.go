@@ -1,117 +1,445 @@
 package runner
 
 import (
-	"bo/parser"
+	"bo/ast"
+	"bo/checker"
+	"bo/value"
+	"context"
 	"fmt"
+	"maps"
+	"path"
 	"strconv"
-
-	"github.com/antlr4-go/antlr/v4"
+	"strings"
 )
 
 type BoVisitor struct {
-	*parser.BaseBoVisitor
 	symbolTable map[string]interface{}
+	info        *checker.Info
+
+	// Calls deferred by the function being run
+	deferred []func()
+
+	// The generator the task runs, which its yields hand values to
+	generator *value.Generator
+
+	// Cancelling ctx stops the program, and every task it runs, at the
+	// next statement or await
+	ctx context.Context
 }
 
-func NewBoVisitor() *BoVisitor {
+func NewBoVisitor(ctx context.Context, info *checker.Info) *BoVisitor {
 	return &BoVisitor{
-		symbolTable: make(map[string]interface{}),
+		symbolTable: maps.Clone(value.Builtins),
+		info:        info,
+		ctx:         ctx,
 	}
 }
 
-func (v *BoVisitor) Visit(tree antlr.ParseTree) interface{} {
-	switch ctx := tree.(type) {
-	case *parser.ProgramContext:
-		return v.VisitProgram(ctx)
-	case *parser.StatementContext:
-		return v.VisitStatement(ctx)
-	case *parser.ExpressionContext:
-		return v.VisitExpression(ctx)
-	case *parser.FunctionCallContext:
-		return v.VisitFunctionCall(ctx)
-	default:
-		panic(fmt.Sprintf("Visit -> unhandled type: %T", ctx))
+// fork returns a visitor for a new task of the same program.
+func (v *BoVisitor) fork() *BoVisitor {
+	return NewBoVisitor(v.ctx, v.info)
+}
+
+func (v *BoVisitor) Visit(node ast.Node) interface{} {
+	switch node := node.(type) {
+	case *ast.Program:
+		return v.VisitProgram(node)
+	case *ast.Block:
+		return v.VisitBlock(node)
+	case ast.Stmt:
+		return v.VisitStatement(node)
+	case *ast.Ident:
+		return v.VisitIdent(node)
+	case *ast.BasicLit:
+		return v.VisitBasicLit(node)
+	case *ast.StringLit:
+		return v.interpolate(node)
+	case *ast.Paren:
+		return v.eval(node.X)
+	case *ast.ListLit:
+		return v.VisitListLiteral(node)
+	case *ast.MapLit:
+		return v.VisitMapLiteral(node)
+	case *ast.TupleLit:
+		return v.VisitTupleLiteral(node)
+	case *ast.StructLit:
+		return v.VisitStructLiteral(node)
+	case *ast.Match:
+		return v.VisitMatchExpression(node)
+	case *ast.Conversion:
+		return v.VisitConversionExpression(node)
+	case *ast.Member:
+		return v.VisitMemberExpression(node)
+	case *ast.Call:
+		return v.VisitCallExpression(node)
+	case *ast.Index:
+		return v.VisitIndexExpression(node)
+	case *ast.Unary:
+		return v.VisitUnaryExpression(node)
+	case *ast.Receive:
+		return v.VisitReceiveExpression(node)
+	case *ast.Await:
+		return v.eval(node.X).(*value.Future).Await(v.ctx)
+	case *ast.Range:
+		return v.VisitRangeExpression(node)
+	case *ast.Step:
+		return v.VisitStepExpression(node)
+	case *ast.Binary:
+		switch node.Op {
+		case "*", "/", "%", "*%", "+", "-", "+%", "-%", "<", "<=", ">", ">=", "==", "!=":
+			return v.VisitBinaryExpression(node)
+		case "&&":
+			return v.VisitAndExpression(node)
+		case "||":
+			return v.VisitOrExpression(node)
+		case "??":
+			return v.VisitCoalesceExpression(node)
+		}
 	}
+
+	panic(fmt.Sprintf("Visit -> unhandled type: %T", node))
 }
 
-func (v *BoVisitor) VisitProgram(ctx *parser.ProgramContext) interface{} {
-	for _, statement := range ctx.AllStatement() {
-		v.Visit(statement)
+func (v *BoVisitor) VisitProgram(prog *ast.Program) interface{} {
+	for _, stmt := range prog.Stmts {
+		v.VisitStatement(stmt)
 	}
 
 	return nil
 }
 
-func (v *BoVisitor) VisitStatement(ctx *parser.StatementContext) interface{} {
-	switch ctx := ctx.GetChild(0).(type) {
-	case *parser.RequireStatementContext:
-		return v.VisitRequireStatement(ctx)
-	case *parser.VariableDeclarationContext:
-		varType := ctx.TypeSpec().GetText()
-		varName := ctx.ID().GetText()
-		varValue := v.Visit(ctx.Expression()) // Evaluate the expression
-
-		switch varType {
-		case "int":
-			v.symbolTable[varName] = varValue.(int)
-		case "float":
-			v.symbolTable[varName] = varValue.(float64)
-		case "bool":
-			v.symbolTable[varName] = varValue.(bool)
-		case "string":
-			v.symbolTable[varName] = varValue.(string)
-		default:
-			panic(fmt.Sprintf("VisitStatement -> unhandled variable type: %s", varType))
+func (v *BoVisitor) VisitStatement(stmt ast.Stmt) interface{} {
+	if v.ctx.Err() != nil {
+		value.Cancelled(v.ctx)
+	}
+
+	switch stmt := stmt.(type) {
+	case *ast.Require:
+		return v.VisitRequireStatement(stmt)
+	case *ast.EnumDecl:
+		return v.VisitEnumDeclaration(stmt)
+	case *ast.StructDecl:
+		return v.VisitStructDeclaration(stmt)
+	case *ast.FuncDecl:
+		return v.VisitFunctionDeclaration(stmt)
+	case *ast.Return:
+		return v.VisitReturnStatement(stmt)
+	case *ast.Defer:
+		return v.VisitDeferStatement(stmt)
+	case *ast.Yield:
+		return v.VisitYieldStatement(stmt)
+	case *ast.For:
+		return v.VisitForStatement(stmt)
+	case *ast.VarDecl:
+		// Evaluate the expression, converted to the declared type
+		val := v.eval(stmt.Value)
+
+		if len(stmt.Vars) == 1 {
+			v.symbolTable[stmt.Vars[0].Name.Name] = val
+			return nil
+		}
+
+		// Several variables receive the elements of a tuple
+		for i, decl := range stmt.Vars {
+			v.symbolTable[decl.Name.Name] = val.(value.Tuple)[i]
 		}
 
 		return nil
-	case *parser.FunctionCallContext:
-		return v.VisitFunctionCall(ctx)
+	case *ast.Destructure:
+		return v.VisitDestructuringDeclaration(stmt)
+	case *ast.Switch:
+		return v.VisitSwitchStatement(stmt)
+	case *ast.Spawn:
+		return v.VisitSpawnStatement(stmt)
+	case *ast.Send:
+		return v.VisitSendStatement(stmt)
+	case *ast.Select:
+		return v.VisitSelectStatement(stmt)
+	case *ast.AwaitStmt:
+		v.eval(stmt.X).(*value.Future).Await(v.ctx)
+
+		return nil
+	case *ast.CallStmt:
+		return v.VisitFunctionCall(stmt.Call)
 	default:
-		panic(fmt.Sprintf("VisitStatement -> unhandled statement type: %T", ctx))
+		panic(fmt.Sprintf("VisitStatement -> unhandled statement type: %T", stmt))
 	}
 }
 
-func (v *BoVisitor) VisitRequireStatement(ctx *parser.RequireStatementContext) interface{} {
-	importPath := ctx.ImportPath().GetText()
+// block runs what run does in a new block, whose declarations shadow and
+// then go out of scope.
+func (v *BoVisitor) block(run func()) {
+	symbolTable := v.symbolTable
+	v.symbolTable = maps.Clone(symbolTable)
 
-	fmt.Printf("Importing module: %s\n", importPath)
+	run()
+
+	v.symbolTable = symbolTable
+}
+
+func (v *BoVisitor) VisitBlock(block *ast.Block) interface{} {
+	v.block(func() {
+		for _, stmt := range block.Stmts {
+			v.VisitStatement(stmt)
+		}
+	})
 
 	return nil
 }
 
-func (v *BoVisitor) VisitExpression(ctx *parser.ExpressionContext) interface{} {
-	if ctx.INT() != nil {
-		val, _ := strconv.Atoi(ctx.INT().GetText())
-		return val
-	} else if ctx.FLOAT() != nil {
-		val, _ := strconv.ParseFloat(ctx.FLOAT().GetText(), 64)
-		return val
-	} else if ctx.STRING() != nil {
-		// Remove the quotes from the string
-		return ctx.STRING().GetText()[1 : len(ctx.STRING().GetText())-1]
-	} else if ctx.BOOL() != nil {
-		// Convert the string to a boolean
-		return ctx.BOOL().GetText() == "true"
-	} else if ctx.ID() != nil {
-		// Look up the variable in the symbol table and return its value (if it exists)
-		return v.symbolTable[ctx.ID().GetText()]
+func (v *BoVisitor) VisitRequireStatement(req *ast.Require) interface{} {
+	if req.Std {
+		fmt.Printf("Importing module: <%s>\n", req.Path)
 	} else {
-		panic(fmt.Sprintf("VisitExpression -> unhandled expression type: %T", ctx))
+		fmt.Printf("Importing module: %s\n", strconv.Quote(req.Path))
+	}
+
+	if module, ok := value.StdModules[req.Path]; ok && req.Std {
+		v.symbolTable[path.Base(req.Path)] = module
+	}
+
+	return nil
+}
+
+func (v *BoVisitor) VisitIdent(id *ast.Ident) interface{} {
+	// Look up the variable in the symbol table, nil is a value of its own
+	val, ok := v.symbolTable[id.Name]
+	if !ok {
+		panic(fmt.Sprintf("VisitIdent -> undefined: %s", id.Name))
 	}
+	return val
 }
 
-func (v *BoVisitor) VisitFunctionCall(ctx *parser.FunctionCallContext) interface{} {
-	funcName := ctx.ID().GetText()
+func (v *BoVisitor) VisitBasicLit(lit *ast.BasicLit) interface{} {
+	switch lit.Kind {
+	case ast.Int:
+		if val, err := ast.IntLiteral(lit.Value, false); err == nil {
+			return val
+		}
+
+		if val, err := ast.UintLiteral(lit.Value); err == nil {
+			return val
+		}
+
+		// Only valid as a bigint or decimal, which the checker verified
+		return ast.BigIntLiteral(lit.Value)
+	case ast.Float:
+		// Read a decimal exactly rather than through the nearest float
+		if v.info.Types[lit] == checker.Decimal {
+			if val, err := ast.DecimalLiteral(lit.Value); err == nil {
+				return val
+			}
+		}
 
-	switch funcName {
-	case "println":
-		for _, arg := range ctx.FunctionParameters().AllExpression() {
-			fmt.Println(v.Visit(arg))
+		val, err := ast.FloatLiteral(lit.Value)
+		if err != nil {
+			panic(fmt.Sprintf("VisitBasicLit -> %s", err))
 		}
+		return val
+	case ast.BigInt:
+		return ast.BigIntLiteral(lit.Value)
+	case ast.Decimal:
+		val, err := ast.DecimalLiteral(lit.Value)
+		if err != nil {
+			panic(fmt.Sprintf("VisitBasicLit -> %s", err))
+		}
+		return val
+	case ast.Bool:
+		return lit.Value == "true"
+	case ast.Nil:
+		return nil
 	default:
-		panic(fmt.Sprintf("VisitFunctionCall -> unhandled function call: %s", funcName))
+		panic(fmt.Sprintf("VisitBasicLit -> unhandled literal kind: %d", lit.Kind))
+	}
+}
+
+// eval evaluates an expression and applies the implicit conversion the
+// checker recorded for it.
+func (v *BoVisitor) eval(expr ast.Expr) interface{} {
+	return value.Convert(v.Visit(expr), v.info.Types[expr])
+}
+
+// interpolate evaluates a string literal, replacing each embedded expression
+// with the canonical string form of its value.
+func (v *BoVisitor) interpolate(lit *ast.StringLit) string {
+	var sb strings.Builder
+	for _, part := range lit.Parts {
+		if part.Expr != nil {
+			sb.WriteString(value.ToString(v.eval(part.Expr)))
+		} else {
+			sb.WriteString(part.Text)
+		}
+	}
+
+	return sb.String()
+}
+
+func (v *BoVisitor) VisitConversionExpression(conv *ast.Conversion) interface{} {
+	if o := v.info.Operators[conv]; o != nil {
+		return v.overloaded(o, v.eval(conv.X))
+	}
+	if _, ok := v.info.Types[conv.Type].(*checker.Chan); ok {
+		return value.NewChannel(v.eval(conv.X).(int64))
+	}
+
+	return value.Convert(v.eval(conv.X), v.info.Types[conv.Type])
+}
+
+func (v *BoVisitor) VisitMemberExpression(expr *ast.Member) interface{} {
+	obj := v.eval(expr.X)
+
+	if obj == nil && expr.Safe {
+		return nil
+	}
+
+	return value.Member(obj, expr.Name.Name)
+}
+
+func (v *BoVisitor) VisitCallExpression(call *ast.Call) interface{} {
+	// x?.m(args) is nil if x is, without evaluating args
+	if member, ok := call.Fun.(*ast.Member); ok && member.Safe {
+		fn := v.callee(call)
+		if fn == nil {
+			return nil
+		}
+		return v.call(fn, call)
+	}
+
+	return v.call(v.eval(call.Fun), call)
+}
+
+// call evaluates the arguments of a call, converted to the parameter types,
+// and applies the callee to them. A variadic function gets the arguments
+// left over as a list.
+func (v *BoVisitor) call(callee interface{}, call *ast.Call) interface{} {
+	return v.apply(callee, v.arguments(call), v.info.Calls[call])
+}
+
+// arguments evaluates the arguments of a call in the order they are
+// written, and returns them in the order of the parameters they are passed
+// to.
+func (v *BoVisitor) arguments(expr *ast.Call) []interface{} {
+	call := v.info.Calls[expr]
+
+	// Parameters left out are nil for builtins, which apply their default
+	// values themselves
+	args := make([]interface{}, len(call.Args))
+	for _, i := range call.Written() {
+		args[i] = v.eval(call.Args[i])
+	}
+	if call.Variadic {
+		rest := make(value.List, 0, len(call.Rest))
+		for _, arg := range call.Rest {
+			rest = append(rest, v.eval(arg))
+		}
+		args = append(args, rest)
+	}
+
+	return args
+}
+
+// apply calls callee with the arguments of call, already evaluated.
+func (v *BoVisitor) apply(callee interface{}, args []interface{}, call *checker.Call) interface{} {
+	switch fn := callee.(type) {
+	case value.Builtin:
+		return fn(args)
+	case *function:
+		if v.info.Generators[fn.decl] {
+			return v.generate(fn, args, call.Args)
+		}
+		if fn.decl.Async {
+			return v.start(func(task *BoVisitor) interface{} {
+				return task.invoke(fn, args, call.Args)
+			})
+		}
+		return v.invoke(fn, args, call.Args)
+	}
+
+	panic(fmt.Sprintf("call -> cannot call %T", callee))
+}
+
+func (v *BoVisitor) VisitUnaryExpression(expr *ast.Unary) interface{} {
+	// A negated literal is evaluated as a whole so that the smallest int
+	// can be written without overflowing
+	if lit := intLiteral(expr.X); lit != nil && expr.Op == "-" {
+		if val, err := ast.IntLiteral(lit.Value, true); err == nil {
+			return val
+		}
+
+		// Only valid as a bigint or decimal, which the checker verified
+		val := ast.BigIntLiteral(lit.Value)
+		return val.Neg(val)
+	}
+
+	operand := v.eval(expr.X)
+	if o := v.info.Operators[expr]; o != nil {
+		return v.overloaded(o, operand)
+	}
+	if expr.Op == "!" {
+		return !operand.(bool)
+	}
+	return value.Neg(operand)
+}
+
+// VisitBinaryExpression evaluates an arithmetic, comparison or equality
+// operation, left operand first, unless it calls an operator method.
+func (v *BoVisitor) VisitBinaryExpression(expr *ast.Binary) interface{} {
+	if o := v.info.Operators[expr]; o != nil {
+		return v.binaryOverloaded(o, expr.X, expr.Y)
+	}
+	return value.Binary(expr.Op, v.eval(expr.X), v.eval(expr.Y))
+}
+
+func (v *BoVisitor) VisitAndExpression(expr *ast.Binary) interface{} {
+	// Short-circuit: the right operand is only evaluated when needed
+	return v.eval(expr.X).(bool) && v.eval(expr.Y).(bool)
+}
+
+func (v *BoVisitor) VisitOrExpression(expr *ast.Binary) interface{} {
+	return v.eval(expr.X).(bool) || v.eval(expr.Y).(bool)
+}
+
+func (v *BoVisitor) VisitCoalesceExpression(expr *ast.Binary) interface{} {
+	// The right operand is only evaluated when the left one is nil
+	if val := v.eval(expr.X); val != nil {
+		return val
+	}
+	return v.eval(expr.Y)
+}
+
+// intLiteral returns an expression that is a bare integer literal, or nil
+// otherwise.
+func intLiteral(expr ast.Expr) *ast.BasicLit {
+	if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == ast.Int {
+		return lit
+	}
+	return nil
+}
+
+func (v *BoVisitor) VisitFunctionCall(call *ast.Call) interface{} {
+	// Any result is discarded
+	if fn := v.callee(call); fn != nil {
+		v.call(fn, call)
 	}
 
 	return nil
 }
+
+// callee returns the function a call statement calls, or nil when it calls
+// a method of nil with ?.
+func (v *BoVisitor) callee(call *ast.Call) interface{} {
+	if fun, ok := call.Fun.(*ast.Member); ok {
+		obj := v.eval(fun.X)
+		if obj == nil && fun.Safe {
+			return nil
+		}
+		return value.Member(obj, fun.Name.Name)
+	}
+
+	name := call.Fun.(*ast.Ident).Name
+	fn, ok := v.symbolTable[name]
+	if !ok {
+		panic(fmt.Sprintf("callee -> undefined function: %s", name))
+	}
+	return fn
+}
@@ -0,0 +1,372 @@
+package value
+
+import (
+	"bo/decimal"
+	"cmp"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"reflect"
+)
+
+// Integer arithmetic is checked: each operation reports whether the result
+// overflowed its type. The wrapping operators (+%, -%, *%) use Go's native
+// two's complement arithmetic instead.
+
+type signed interface {
+	~int8 | ~int16 | ~int32 | ~int64
+}
+
+type unsigned interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+type float interface {
+	~float32 | ~float64
+}
+
+func addInt(a, b int64) (int64, bool) {
+	c := a + b
+	return c, (c > a) == (b > 0)
+}
+
+func subInt(a, b int64) (int64, bool) {
+	c := a - b
+	return c, (c < a) == (b > 0)
+}
+
+func mulInt(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	return c, c/b == a && !(a == math.MinInt64 && b == -1)
+}
+
+func divInt(a, b int64) (int64, bool) {
+	return a / b, !(a == math.MinInt64 && b == -1)
+}
+
+// signedOp applies a binary operator to signed integers, computing in 64
+// bits and checking that the result fits T.
+func signedOp[T signed](op string, a, b T) (T, bool) {
+	x, y := int64(a), int64(b)
+
+	var c int64
+	ok := true
+	switch op {
+	case "+":
+		c, ok = addInt(x, y)
+	case "-":
+		c, ok = subInt(x, y)
+	case "*":
+		c, ok = mulInt(x, y)
+	case "/":
+		c, ok = divInt(x, y)
+	case "%":
+		c = x % y
+	case "+%":
+		return a + b, true
+	case "-%":
+		return a - b, true
+	case "*%":
+		return a * b, true
+	default:
+		panic(fmt.Sprintf("signedOp -> unhandled operator: %s", op))
+	}
+
+	return T(c), ok && int64(T(c)) == c
+}
+
+// unsignedOp applies a binary operator to unsigned integers, computing in
+// 64 bits and checking that the result fits T.
+func unsignedOp[T unsigned](op string, a, b T) (T, bool) {
+	x, y := uint64(a), uint64(b)
+
+	var c uint64
+	ok := true
+	switch op {
+	case "+":
+		c = x + y
+		ok = c >= x
+	case "-":
+		c = x - y
+		ok = y <= x
+	case "*":
+		var hi uint64
+		hi, c = bits.Mul64(x, y)
+		ok = hi == 0
+	case "/":
+		c = x / y
+	case "%":
+		c = x % y
+	case "+%":
+		return a + b, true
+	case "-%":
+		return a - b, true
+	case "*%":
+		return a * b, true
+	default:
+		panic(fmt.Sprintf("unsignedOp -> unhandled operator: %s", op))
+	}
+
+	return T(c), ok && uint64(T(c)) == c
+}
+
+func floatOp[T float](op string, a, b T) T {
+	switch op {
+	case "+":
+		return a + b
+	case "-":
+		return a - b
+	case "*":
+		return a * b
+	case "/":
+		return a / b
+	default:
+		panic(fmt.Sprintf("floatOp -> unhandled operator: %s", op))
+	}
+}
+
+// bigOp applies a binary operator to arbitrary-precision integers. Division
+// truncates toward zero, as for the fixed-width integers.
+func bigOp(op string, a, b *big.Int) *big.Int {
+	switch op {
+	case "+":
+		return new(big.Int).Add(a, b)
+	case "-":
+		return new(big.Int).Sub(a, b)
+	case "*":
+		return new(big.Int).Mul(a, b)
+	case "/":
+		return new(big.Int).Quo(a, b)
+	case "%":
+		return new(big.Int).Rem(a, b)
+	default:
+		panic(fmt.Sprintf("bigOp -> unhandled operator: %s", op))
+	}
+}
+
+func decimalOp(op string, a, b decimal.Decimal) decimal.Decimal {
+	switch op {
+	case "+":
+		return a.Add(b)
+	case "-":
+		return a.Sub(b)
+	case "*":
+		return a.Mul(b)
+	case "/":
+		return a.Div(b)
+	case "%":
+		return a.Rem(b)
+	default:
+		panic(fmt.Sprintf("decimalOp -> unhandled operator: %s", op))
+	}
+}
+
+// Arith applies a binary arithmetic operator to two numeric values of the
+// same type, reporting whether an integer result overflowed.
+func Arith(op string, left, right interface{}) (interface{}, bool) {
+	switch left := left.(type) {
+	case int8:
+		return signedOp(op, left, right.(int8))
+	case int16:
+		return signedOp(op, left, right.(int16))
+	case int32:
+		return signedOp(op, left, right.(int32))
+	case int64:
+		return signedOp(op, left, right.(int64))
+	case uint8:
+		return unsignedOp(op, left, right.(uint8))
+	case uint16:
+		return unsignedOp(op, left, right.(uint16))
+	case uint32:
+		return unsignedOp(op, left, right.(uint32))
+	case uint64:
+		return unsignedOp(op, left, right.(uint64))
+	case float32:
+		return floatOp(op, left, right.(float32)), true
+	case float64:
+		return floatOp(op, left, right.(float64)), true
+	case *big.Int:
+		return bigOp(op, left, right.(*big.Int)), true
+	case decimal.Decimal:
+		return decimalOp(op, left, right.(decimal.Decimal)), true
+	default:
+		panic(fmt.Sprintf("arith -> unhandled operand type: %T", left))
+	}
+}
+
+// Binary applies a binary arithmetic, comparison or equality operator to
+// two values of the same type. A string + concatenates. Integer overflow
+// and integer or decimal division by zero are runtime errors.
+func Binary(op string, left, right interface{}) interface{} {
+	switch op {
+	case "<":
+		return Compare(left, right) < 0
+	case "<=":
+		return Compare(left, right) <= 0
+	case ">":
+		return Compare(left, right) > 0
+	case ">=":
+		return Compare(left, right) >= 0
+	case "==":
+		return Equal(left, right)
+	case "!=":
+		return !Equal(left, right)
+	case "+":
+		if left, ok := left.(string); ok {
+			return left + right.(string)
+		}
+	case "/", "%":
+		if IsZero(right) {
+			switch right.(type) {
+			case float32, float64:
+			case decimal.Decimal:
+				panic("Binary -> decimal division by zero")
+			default:
+				panic("Binary -> integer division by zero")
+			}
+		}
+	}
+
+	val, ok := Arith(op, left, right)
+	if !ok {
+		panic(fmt.Sprintf("Binary -> integer overflow: %s %s %s", ToString(left), op, ToString(right)))
+	}
+	return val
+}
+
+// Neg negates a numeric value. Negating the smallest value of a signed
+// type, or an unsigned value other than zero, overflows.
+func Neg(x interface{}) interface{} {
+	switch x := x.(type) {
+	case float32:
+		return -x
+	case float64:
+		return -x
+	case decimal.Decimal:
+		return x.Neg()
+	case *big.Int:
+		return new(big.Int).Neg(x)
+	}
+
+	val, ok := Arith("-", reflect.Zero(reflect.TypeOf(x)).Interface(), x)
+	if !ok {
+		panic(fmt.Sprintf("Neg -> integer overflow: -(%s)", ToString(x)))
+	}
+	return val
+}
+
+// Compare orders two values of the same numeric or string type.
+func Compare(left, right interface{}) int {
+	switch left := left.(type) {
+	case int8:
+		return cmp.Compare(left, right.(int8))
+	case int16:
+		return cmp.Compare(left, right.(int16))
+	case int32:
+		return cmp.Compare(left, right.(int32))
+	case int64:
+		return cmp.Compare(left, right.(int64))
+	case uint8:
+		return cmp.Compare(left, right.(uint8))
+	case uint16:
+		return cmp.Compare(left, right.(uint16))
+	case uint32:
+		return cmp.Compare(left, right.(uint32))
+	case uint64:
+		return cmp.Compare(left, right.(uint64))
+	case float32:
+		return cmp.Compare(left, right.(float32))
+	case float64:
+		return cmp.Compare(left, right.(float64))
+	case *big.Int:
+		return left.Cmp(right.(*big.Int))
+	case decimal.Decimal:
+		return left.Cmp(right.(decimal.Decimal))
+	case string:
+		return cmp.Compare(left, right.(string))
+	default:
+		panic(fmt.Sprintf("compare -> unhandled operand type: %T", left))
+	}
+}
+
+// IsZero reports whether a numeric value is zero.
+func IsZero(value interface{}) bool {
+	switch value := widen(value).(type) {
+	case int64:
+		return value == 0
+	case uint64:
+		return value == 0
+	case *big.Int:
+		return value.Sign() == 0
+	case decimal.Decimal:
+		return value.Sign() == 0
+	default:
+		return value.(float64) == 0
+	}
+}
+
+// Equal reports whether two values of the same type are equal.
+func Equal(left, right interface{}) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+
+	switch left := left.(type) {
+	case *big.Int, decimal.Decimal:
+		return Compare(left, right) == 0
+	case EnumValue:
+		right := right.(EnumValue)
+		if left.Name != right.Name {
+			return false
+		}
+		for i := range left.Fields {
+			if !Equal(left.Fields[i], right.Fields[i]) {
+				return false
+			}
+		}
+		return true
+	case List:
+		return equalAll(left, right.(List))
+	case Tuple:
+		return equalAll(left, right.(Tuple))
+	case *Map:
+		right := right.(*Map)
+		if len(left.keys) != len(right.keys) {
+			return false
+		}
+		for i, key := range left.keys {
+			val, ok := right.Get(key)
+			if !ok || !Equal(left.values[i], val) {
+				return false
+			}
+		}
+		return true
+	case *StructValue:
+		return equalAll(left.Fields, right.(*StructValue).Fields)
+	}
+	return left == right
+}
+
+func equalAll(left, right []interface{}) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for i := range left {
+		if !Equal(left[i], right[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isFloat(value interface{}) bool {
+	switch value.(type) {
+	case float32, float64:
+		return true
+	}
+	return false
+}
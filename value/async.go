@@ -0,0 +1,147 @@
+package value
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Future is the value of a Future[T] type. Once done is closed, it holds
+// either a value or the runtime error its task failed with.
+type Future struct {
+	done  chan struct{}
+	value interface{}
+	err   interface{}
+}
+
+func NewFuture() *Future {
+	return &Future{done: make(chan struct{})}
+}
+
+func (f *Future) String() string {
+	return "Future"
+}
+
+// Start runs run as a task of the program ctx is a context of, and
+// returns the future of its result.
+func Start(ctx context.Context, run func() interface{}) *Future {
+	f := NewFuture()
+
+	Go(ctx, func() {
+		defer close(f.done)
+		defer func() {
+			if r := recover(); r != nil {
+				f.err = r
+			}
+		}()
+
+		f.value = run()
+	})
+
+	return f
+}
+
+// Await waits for f and returns its value, or raises the error it failed
+// with in the awaiting task. Cancelling ctx stops the wait.
+func (f *Future) Await(ctx context.Context) interface{} {
+	defer wait(ctx)()
+
+	select {
+	case <-f.done:
+	case <-ctx.Done():
+		panic(fmt.Sprintf("await -> %s", ctx.Err()))
+	}
+
+	if f.err != nil {
+		panic(f.err)
+	}
+	return f.value
+}
+
+// futures is the value of Future, whose members combine futures.
+var futures = &Module{Members: map[string]interface{}{
+	// all is done with the values of all futures, or fails as soon as one
+	// of them does, with its error
+	"all": Builtin(func(args []interface{}) interface{} {
+		fs := args[0].(List)
+
+		f := NewFuture()
+		values := make(List, len(fs))
+		var (
+			mu      sync.Mutex
+			pending = len(fs)
+			once    sync.Once
+		)
+		finish := func(err interface{}) {
+			once.Do(func() {
+				if err != nil {
+					f.err = err
+				} else {
+					f.value = values
+				}
+				close(f.done)
+			})
+		}
+		if pending == 0 {
+			finish(nil)
+		}
+		for i, g := range fs {
+			g := g.(*Future)
+			go func() {
+				<-g.done
+				if g.err != nil {
+					finish(g.err)
+					return
+				}
+
+				mu.Lock()
+				values[i] = g.value
+				pending--
+				last := pending == 0
+				mu.Unlock()
+				if last {
+					finish(nil)
+				}
+			}()
+		}
+		return f
+	}),
+
+	// any is done as the first future to be done is
+	"any": Builtin(func(args []interface{}) interface{} {
+		f := NewFuture()
+
+		var once sync.Once
+		for _, g := range args[0].(List) {
+			g := g.(*Future)
+			go func() {
+				<-g.done
+				once.Do(func() {
+					f.value, f.err = g.value, g.err
+					close(f.done)
+				})
+			}()
+		}
+		return f
+	}),
+
+	"timeout": Builtin(func(args []interface{}) interface{} {
+		g, ms := args[0].(List)[0].(*Future), args[0].(List)[1].(int64)
+
+		f := NewFuture()
+		timers.Add(1)
+		go func() {
+			defer timers.Add(-1)
+			defer close(f.done)
+
+			select {
+			case <-g.done:
+				f.value, f.err = g.value, g.err
+			case <-time.After(time.Duration(ms) * time.Millisecond):
+				f.err = fmt.Sprintf("Future.timeout -> timed out after %dms", ms)
+			}
+		}()
+		return f
+	}),
+}}
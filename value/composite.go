@@ -0,0 +1,226 @@
+package value
+
+import (
+	"bo/decimal"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// List and Tuple are the values of list and tuple types.
+type (
+	List  []interface{}
+	Tuple []interface{}
+)
+
+// Map is the value of a map type. Its entries keep the order in which
+// their keys were first added.
+type Map struct {
+	keys    []interface{}
+	values  []interface{}
+	indices map[interface{}]int
+}
+
+func NewMap() *Map {
+	return &Map{indices: make(map[interface{}]int)}
+}
+
+// mapKey returns a comparable key that is the same for equal keys.
+func mapKey(key interface{}) interface{} {
+	switch key := key.(type) {
+	case *big.Int:
+		return "n" + key.String()
+	case decimal.Decimal:
+		s := key.String()
+		if strings.Contains(s, ".") {
+			s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+		}
+		return "m" + s
+	}
+	return key
+}
+
+func (m *Map) Get(key interface{}) (interface{}, bool) {
+	i, ok := m.indices[mapKey(key)]
+	if !ok {
+		return nil, false
+	}
+	return m.values[i], true
+}
+
+func (m *Map) Set(key, value interface{}) {
+	if i, ok := m.indices[mapKey(key)]; ok {
+		m.values[i] = value
+		return
+	}
+
+	m.indices[mapKey(key)] = len(m.keys)
+	m.keys = append(m.keys, key)
+	m.values = append(m.values, value)
+}
+
+func (m *Map) Len() int {
+	return len(m.keys)
+}
+
+// StructDef is the value a struct declaration binds. Its methods are
+// declared after it, maybe while tasks use its values.
+type StructDef struct {
+	Name   string
+	Fields []string
+
+	mu        sync.RWMutex
+	methods   map[string]Function
+	operators map[interface{}]Function
+	stringer  Function
+}
+
+func NewStructDef(name string, fields []string) *StructDef {
+	return &StructDef{
+		Name:      name,
+		Fields:    fields,
+		methods:   make(map[string]Function),
+		operators: make(map[interface{}]Function),
+	}
+}
+
+// Method returns the method name, or nil if there is none.
+func (s *StructDef) Method(name string) Function {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.methods[name]
+}
+
+func (s *StructDef) AddMethod(name string, fn Function) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.methods[name] = fn
+}
+
+// Operator returns the operator method declared as decl, whatever
+// identifies its declaration to the engine running the program.
+func (s *StructDef) Operator(decl interface{}) Function {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.operators[decl]
+}
+
+// AddOperator adds the operator method name declared as decl.
+func (s *StructDef) AddOperator(decl interface{}, name string, fn Function) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.operators[decl] = fn
+	if name == "string" {
+		s.stringer = fn
+	}
+}
+
+func (s *StructDef) Field(name string) int {
+	for i, field := range s.Fields {
+		if field == name {
+			return i
+		}
+	}
+	panic(fmt.Sprintf("Field -> %s has no field %s", s.Name, name))
+}
+
+// StructValue is a value of a struct, with a value for each of its fields.
+type StructValue struct {
+	Def    *StructDef
+	Fields []interface{}
+}
+
+// NewStructValue returns a value of def whose fields are all nil.
+func NewStructValue(def *StructDef) *StructValue {
+	return &StructValue{Def: def, Fields: make([]interface{}, len(def.Fields))}
+}
+
+// Index returns the element of a list at an int index, the elements at the
+// indices of a range, or the value of a key in a map, nil if it is missing.
+func Index(obj, index interface{}) interface{} {
+	switch obj := obj.(type) {
+	case List:
+		if r, ok := index.(Range); ok {
+			return r.Slice(obj)
+		}
+		i := index.(int64)
+		if i < 0 || i >= int64(len(obj)) {
+			panic(fmt.Sprintf("Index -> index out of range [%d] with length %d", i, len(obj)))
+		}
+		return obj[i]
+	case *Map:
+		val, _ := obj.Get(index)
+		return val
+	}
+
+	panic(fmt.Sprintf("Index -> cannot index %T", obj))
+}
+
+// Member returns the field or method name of obj.
+func Member(obj interface{}, name string) interface{} {
+	switch obj := obj.(type) {
+	case *Module:
+		return obj.Members[name]
+	case *Enum:
+		return obj.Cases[name]
+	case *StructValue:
+		if method := obj.Def.Method(name); method != nil {
+			return method.Bind(obj)
+		}
+		return obj.Fields[obj.Def.Field(name)]
+	case Object:
+		if method := obj.Method(name); method != nil {
+			return method
+		}
+	}
+
+	panic(fmt.Sprintf("Member -> %T has no field %s", obj, name))
+}
+
+// toStrings returns the string forms of values.
+func toStrings(values []interface{}) []string {
+	s := make([]string, len(values))
+	for i, val := range values {
+		s[i] = ToString(val)
+	}
+	return s
+}
+
+func (l List) String() string {
+	return "[" + strings.Join(toStrings(l), ", ") + "]"
+}
+
+func (t Tuple) String() string {
+	return "(" + strings.Join(toStrings(t), ", ") + ")"
+}
+
+func (m *Map) String() string {
+	entries := make([]string, len(m.keys))
+	for i, key := range m.keys {
+		entries[i] = ToString(key) + ": " + ToString(m.values[i])
+	}
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+// String returns the result of s's string operator, or else s as a struct
+// literal.
+func (s *StructValue) String() string {
+	s.Def.mu.RLock()
+	stringer := s.Def.stringer
+	s.Def.mu.RUnlock()
+
+	if stringer != nil {
+		return stringer.Bind(s).Run().(string)
+	}
+
+	fields := make([]string, len(s.Fields))
+	for i, name := range s.Def.Fields {
+		fields[i] = name + ": " + ToString(s.Fields[i])
+	}
+	return s.Def.Name + "{" + strings.Join(fields, ", ") + "}"
+}
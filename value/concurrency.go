@@ -0,0 +1,175 @@
+package value
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Channel is a value of a chan[T] type. Values sent on it are already
+// converted to T.
+type Channel struct {
+	ch chan interface{}
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func NewChannel(size int64) *Channel {
+	if size < 0 {
+		panic(fmt.Sprintf("NewChannel -> negative buffer size: %d", size))
+	}
+	return &Channel{ch: make(chan interface{}, size)}
+}
+
+// Send sends a value. Cancelling ctx stops the wait.
+func (c *Channel) Send(ctx context.Context, value interface{}) {
+	defer wait(ctx)()
+
+	select {
+	case c.ch <- value:
+	case <-ctx.Done():
+		panic(fmt.Sprintf("send -> %s", ctx.Err()))
+	}
+}
+
+// Receive receives a value, or nil once the channel is closed and drained.
+// Cancelling ctx stops the wait.
+func (c *Channel) Receive(ctx context.Context) interface{} {
+	defer wait(ctx)()
+
+	select {
+	case v := <-c.ch:
+		return v
+	case <-ctx.Done():
+		panic(fmt.Sprintf("receive -> %s", ctx.Err()))
+	}
+}
+
+func (c *Channel) Method(name string) Builtin {
+	if name != "close" {
+		return nil
+	}
+
+	return func(args []interface{}) interface{} {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if c.closed {
+			panic("close -> close of closed channel")
+		}
+		c.closed = true
+		close(c.ch)
+
+		return nil
+	}
+}
+
+func (c *Channel) String() string {
+	return fmt.Sprintf("chan(%d/%d)", len(c.ch), cap(c.ch))
+}
+
+// SelectCase is an arm of a select statement: a receive from Chan, a send
+// of Value on it if Send, or the default arm if Chan is nil.
+type SelectCase struct {
+	Chan  *Channel
+	Send  bool
+	Value interface{}
+}
+
+// Select goes ahead with the first send or receive of cases that can, or
+// the default case if none can right away, and returns its index and the
+// value received, nil once the channel is closed. Cancelling ctx stops the
+// wait.
+func Select(ctx context.Context, cases []SelectCase) (int, interface{}) {
+	selectCases := make([]reflect.SelectCase, len(cases), len(cases)+1)
+	blocks := true
+	for i, c := range cases {
+		switch {
+		case c.Chan == nil:
+			selectCases[i] = reflect.SelectCase{Dir: reflect.SelectDefault}
+			blocks = false
+		case c.Send:
+			// Go through a pointer so that nil is sent as an interface
+			val := c.Value
+			send := reflect.ValueOf(&val).Elem()
+			selectCases[i] = reflect.SelectCase{Dir: reflect.SelectSend, Chan: reflect.ValueOf(c.Chan.ch), Send: send}
+		default:
+			selectCases[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(c.Chan.ch)}
+		}
+	}
+
+	selectCases = append(selectCases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())})
+	if blocks {
+		defer wait(ctx)()
+	}
+
+	chosen, received, ok := reflect.Select(selectCases)
+	if chosen == len(cases) {
+		panic(fmt.Sprintf("select -> %s", ctx.Err()))
+	}
+	if !ok {
+		return chosen, nil
+	}
+	return chosen, received.Interface()
+}
+
+// waitGroup is a value of bo/sync's WaitGroup.
+type waitGroup struct {
+	wg sync.WaitGroup
+}
+
+func (w *waitGroup) Method(name string) Builtin {
+	switch name {
+	case "add":
+		return func(args []interface{}) interface{} {
+			n := int64(1)
+			if args[0] != nil {
+				n = args[0].(int64)
+			}
+			w.wg.Add(int(n))
+			return nil
+		}
+	case "done":
+		return func(args []interface{}) interface{} {
+			w.wg.Done()
+			return nil
+		}
+	case "wait":
+		return func(args []interface{}) interface{} {
+			w.wg.Wait()
+			return nil
+		}
+	}
+	return nil
+}
+
+func (w *waitGroup) String() string {
+	return "sync.WaitGroup"
+}
+
+// mutex is a value of bo/sync's Mutex.
+type mutex struct {
+	mu sync.Mutex
+}
+
+func (m *mutex) Method(name string) Builtin {
+	switch name {
+	case "lock":
+		return func(args []interface{}) interface{} {
+			m.mu.Lock()
+			return nil
+		}
+	case "unlock":
+		return func(args []interface{}) interface{} {
+			m.mu.Unlock()
+			return nil
+		}
+	}
+	return nil
+}
+
+func (m *mutex) String() string {
+	return "sync.Mutex"
+}
@@ -0,0 +1,209 @@
+package value
+
+import (
+	"bo/checker"
+	"bo/decimal"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// widen returns a numeric value as an int64, uint64, float64, *big.Int or
+// decimal.Decimal.
+func widen(value interface{}) interface{} {
+	switch value := value.(type) {
+	case int8:
+		return int64(value)
+	case int16:
+		return int64(value)
+	case int32:
+		return int64(value)
+	case int64:
+		return value
+	case uint8:
+		return uint64(value)
+	case uint16:
+		return uint64(value)
+	case uint32:
+		return uint64(value)
+	case uint64:
+		return value
+	case float32:
+		return float64(value)
+	case float64, *big.Int, decimal.Decimal:
+		return value
+	default:
+		panic(fmt.Sprintf("widen -> unhandled value type: %T", value))
+	}
+}
+
+// toBigInt returns the integer part of a numeric value.
+func toBigInt(value interface{}) *big.Int {
+	switch value := widen(value).(type) {
+	case int64:
+		return big.NewInt(value)
+	case uint64:
+		return new(big.Int).SetUint64(value)
+	case float64:
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			outOfRange(value, checker.BigInt)
+		}
+		i, _ := big.NewFloat(value).Int(nil)
+		return i
+	case *big.Int:
+		return value
+	case decimal.Decimal:
+		return value.Int()
+	}
+	return nil
+}
+
+// toDecimal returns a numeric value as a decimal.
+func toDecimal(value interface{}) decimal.Decimal {
+	switch value := widen(value).(type) {
+	case float64:
+		d, err := decimal.FromFloat(value)
+		if err != nil {
+			outOfRange(value, checker.Decimal)
+		}
+		return d
+	case decimal.Decimal:
+		return value
+	default:
+		return decimal.FromInt(toBigInt(value))
+	}
+}
+
+// toFloat returns the float nearest to a numeric value.
+func toFloat(value interface{}) float64 {
+	switch value := widen(value).(type) {
+	case int64:
+		return float64(value)
+	case uint64:
+		return float64(value)
+	case float64:
+		return value
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(value).Float64()
+		return f
+	case decimal.Decimal:
+		return value.Float64()
+	}
+	return 0
+}
+
+// Convert returns value as a value of type t. Numeric conversions are range
+// checked, a value that does not fit is a runtime error.
+func Convert(value interface{}, t checker.Type) interface{} {
+	if optional, ok := t.(*checker.Optional); ok {
+		if value == nil {
+			return nil
+		}
+		t = optional.Elem
+	}
+
+	b, ok := t.(*checker.Basic)
+	if !ok || !b.IsNumeric() {
+		return value
+	}
+
+	switch {
+	case b == checker.BigInt:
+		return toBigInt(value)
+	case b == checker.Decimal:
+		return toDecimal(value)
+	case b.IsFloat():
+		f := toFloat(value)
+		if b == checker.Float32 {
+			if math.Abs(f) > math.MaxFloat32 && !math.IsInf(f, 0) {
+				outOfRange(value, t)
+			}
+			return float32(f)
+		}
+		return f
+	case b.IsUnsigned():
+		var u uint64
+		switch value := widen(value).(type) {
+		case int64:
+			if value < 0 {
+				outOfRange(value, t)
+			}
+			u = uint64(value)
+		case uint64:
+			u = value
+		case float64:
+			if !(value > -1 && value < 1<<64) {
+				outOfRange(value, t)
+			}
+			u = uint64(value)
+		default:
+			i := toBigInt(value)
+			if !i.IsUint64() {
+				outOfRange(value, t)
+			}
+			u = i.Uint64()
+		}
+
+		if b.Bits() < 64 && u >= 1<<b.Bits() {
+			outOfRange(value, t)
+		}
+		return makeUnsigned(u, b)
+	default:
+		var i int64
+		switch value := widen(value).(type) {
+		case int64:
+			i = value
+		case uint64:
+			if value > math.MaxInt64 {
+				outOfRange(value, t)
+			}
+			i = int64(value)
+		case float64:
+			if !(value >= -1<<63 && value < 1<<63) {
+				outOfRange(value, t)
+			}
+			i = int64(value)
+		default:
+			n := toBigInt(value)
+			if !n.IsInt64() {
+				outOfRange(value, t)
+			}
+			i = n.Int64()
+		}
+
+		if b.Bits() < 64 && (i < -1<<(b.Bits()-1) || i >= 1<<(b.Bits()-1)) {
+			outOfRange(value, t)
+		}
+		return makeSigned(i, b)
+	}
+}
+
+func makeSigned(i int64, t *checker.Basic) interface{} {
+	switch t.Bits() {
+	case 8:
+		return int8(i)
+	case 16:
+		return int16(i)
+	case 32:
+		return int32(i)
+	default:
+		return i
+	}
+}
+
+func makeUnsigned(u uint64, t *checker.Basic) interface{} {
+	switch t.Bits() {
+	case 8:
+		return uint8(u)
+	case 16:
+		return uint16(u)
+	case 32:
+		return uint32(u)
+	default:
+		return u
+	}
+}
+
+func outOfRange(value interface{}, t checker.Type) {
+	panic(fmt.Sprintf("convert -> value %s out of range for %s", ToString(value), t))
+}
@@ -0,0 +1,48 @@
+package value
+
+import "strings"
+
+// Enum is the value an enum declaration binds: its cases, selected with
+// Color.Red, are values or constructors of values.
+type Enum struct {
+	Name  string
+	Cases map[string]interface{}
+}
+
+func NewEnum(name string) *Enum {
+	return &Enum{Name: name, Cases: make(map[string]interface{})}
+}
+
+// AddCase adds a case carrying fields values. A case without values is a
+// value, one with values constructs them from its arguments, which are
+// converted to the field types.
+func (e *Enum) AddCase(name string, fields int) {
+	if fields == 0 {
+		e.Cases[name] = EnumValue{Enum: e.Name, Name: name}
+		return
+	}
+
+	e.Cases[name] = Builtin(func(args []interface{}) interface{} {
+		return EnumValue{Enum: e.Name, Name: name, Fields: args}
+	})
+}
+
+// EnumValue is a value of an enum: a case and the values it carries.
+type EnumValue struct {
+	Enum   string
+	Name   string
+	Fields []interface{}
+}
+
+func (e EnumValue) String() string {
+	s := e.Enum + "." + e.Name
+	if len(e.Fields) == 0 {
+		return s
+	}
+
+	fields := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		fields[i] = ToString(field)
+	}
+	return s + "(" + strings.Join(fields, ", ") + ")"
+}
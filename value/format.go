@@ -0,0 +1,37 @@
+package value
+
+import (
+	"bo/decimal"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// ToString returns the canonical string form of a value, used both by
+// println and by string interpolation.
+func ToString(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return value
+	case int8, int16, int32, int64:
+		return strconv.FormatInt(widen(value).(int64), 10)
+	case uint8, uint16, uint32, uint64:
+		return strconv.FormatUint(widen(value).(uint64), 10)
+	case float32:
+		return strconv.FormatFloat(float64(value), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	case *big.Int:
+		return value.String()
+	case decimal.Decimal:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	case fmt.Stringer:
+		return value.String()
+	default:
+		panic(fmt.Sprintf("ToString -> unhandled value type: %T", value))
+	}
+}
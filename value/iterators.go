@@ -0,0 +1,335 @@
+package value
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Iterator goes through a sequence of values, one at a time.
+type Iterator interface {
+	// Next returns the next value, or false once there are no more.
+	Next() (interface{}, bool)
+
+	// Stop lets go of an iterator that is no longer needed, ending the
+	// generator behind it, if any.
+	Stop()
+}
+
+// IteratorFunc is an iterator implemented by functions. A nil StopFunc has
+// nothing to let go of.
+type IteratorFunc struct {
+	NextFunc func() (interface{}, bool)
+	StopFunc func()
+}
+
+func (it *IteratorFunc) Next() (interface{}, bool) {
+	return it.NextFunc()
+}
+
+func (it *IteratorFunc) Stop() {
+	if it.StopFunc != nil {
+		it.StopFunc()
+	}
+}
+
+// IterValue is a value of an Iterator[T] type. hasNext takes the next value
+// ahead of time, so that Next returns it.
+type IterValue struct {
+	mu     sync.Mutex
+	it     Iterator
+	peeked bool
+	value  interface{}
+	ok     bool
+}
+
+func NewIterValue(it Iterator) *IterValue {
+	return &IterValue{it: it}
+}
+
+func (i *IterValue) Next() (interface{}, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.peeked {
+		i.peeked = false
+		return i.value, i.ok
+	}
+	return i.it.Next()
+}
+
+func (i *IterValue) hasNext() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if !i.peeked {
+		i.value, i.ok = i.it.Next()
+		i.peeked = true
+	}
+	return i.ok
+}
+
+func (i *IterValue) Stop() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.it.Stop()
+}
+
+func (i *IterValue) Method(name string) Builtin {
+	switch name {
+	case "next":
+		return func(args []interface{}) interface{} {
+			value, _ := i.Next()
+			return value
+		}
+	case "hasNext":
+		return func(args []interface{}) interface{} {
+			return i.hasNext()
+		}
+	}
+	return nil
+}
+
+func (i *IterValue) String() string {
+	return "Iterator"
+}
+
+// Iterate returns an iterator over the values a for loop goes through: the
+// ints of a range, the elements of a list, the entries of a map as
+// (key, value) tuples, the values of an iterator, or those of the iterator
+// a struct's iter method returns.
+func Iterate(value interface{}) Iterator {
+	switch value := value.(type) {
+	case List:
+		i := 0
+		return &IteratorFunc{NextFunc: func() (interface{}, bool) {
+			if i == len(value) {
+				return nil, false
+			}
+			i++
+			return value[i-1], true
+		}}
+	case *Map:
+		i := 0
+		return &IteratorFunc{NextFunc: func() (interface{}, bool) {
+			if i == len(value.keys) {
+				return nil, false
+			}
+			i++
+			return Tuple{value.keys[i-1], value.values[i-1]}, true
+		}}
+	case *IterValue:
+		return value
+	case Range:
+		return value.Iterate()
+	case *StructValue:
+		if method := value.Def.Method("iter"); method != nil {
+			return Iterate(method.Bind(value).Run())
+		}
+	}
+
+	panic(fmt.Sprintf("Iterate -> cannot range over %s", ToString(value)))
+}
+
+// Generator runs the body of a generator function as a task of its own,
+// which starts when the first value is asked for. The task and the one
+// asking for values take turns: resume lets the generator go on to its next
+// yield, or unwinds it if false, and steps carries back what it yielded.
+type Generator struct {
+	resume chan bool
+	steps  chan step
+	ctx    context.Context
+
+	run           func()
+	started, done bool
+}
+
+// step is what a generator yielded, or, if ok is false, that it returned
+// or failed with err.
+type step struct {
+	value interface{}
+	ok    bool
+	err   interface{}
+}
+
+// stopped unwinds a generator stopped at a yield.
+type stopped struct{}
+
+// Generate returns an iterator over the values body yields through the
+// generator it is given, in a task of the program ctx is a context of.
+func Generate(ctx context.Context, body func(g *Generator)) *IterValue {
+	// The last step is buffered so that a generator whose values are no
+	// longer asked for can end
+	g := &Generator{resume: make(chan bool), steps: make(chan step, 1), ctx: ctx}
+
+	g.run = func() {
+		var last step
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(stopped); !ok {
+					last.err = r
+				}
+			}
+			g.steps <- last
+		}()
+
+		body(g)
+	}
+
+	return NewIterValue(g)
+}
+
+func (g *Generator) Next() (interface{}, bool) {
+	if g.done {
+		return nil, false
+	}
+
+	done := wait(g.ctx)
+	if g.started {
+		g.resume <- true
+	} else {
+		g.started = true
+		Go(g.ctx, g.run)
+	}
+
+	s := <-g.steps
+	done()
+	if !s.ok {
+		g.done = true
+		if s.err != nil {
+			panic(s.err)
+		}
+	}
+	return s.value, s.ok
+}
+
+// Stop unwinds a generator waiting at a yield, running its deferred calls.
+func (g *Generator) Stop() {
+	if g.done {
+		return
+	}
+	g.done = true
+
+	if g.started {
+		done := wait(g.ctx)
+		g.resume <- false
+		s := <-g.steps
+		done()
+		if s.err != nil {
+			panic(s.err)
+		}
+	}
+}
+
+// Yield hands a value to the task asking for it, then waits until the next
+// one is asked for. Cancelling ctx stops the wait.
+func (g *Generator) Yield(ctx context.Context, value interface{}) {
+	g.steps <- step{value: value, ok: true}
+
+	defer wait(ctx)()
+	select {
+	case resume := <-g.resume:
+		if !resume {
+			panic(stopped{})
+		}
+	case <-ctx.Done():
+		panic(fmt.Sprintf("Yield -> %s", ctx.Err()))
+	}
+}
+
+// iterators is the bo/iter module. Its iterators go through the values of
+// their arguments as they are asked for, and stop them when stopped.
+var iterators = &Module{Members: map[string]interface{}{
+	"take": Builtin(func(args []interface{}) interface{} {
+		it, n := Iterate(args[0].(List)[0]), args[0].(List)[1].(int64)
+		return NewIterValue(&IteratorFunc{
+			NextFunc: func() (interface{}, bool) {
+				if n <= 0 {
+					return nil, false
+				}
+				n--
+				return it.Next()
+			},
+			StopFunc: it.Stop,
+		})
+	}),
+	"zip": Builtin(func(args []interface{}) interface{} {
+		its := iterateAll(args[0].(List))
+		return NewIterValue(&IteratorFunc{
+			NextFunc: func() (interface{}, bool) {
+				values := make(Tuple, len(its))
+				for i, it := range its {
+					value, ok := it.Next()
+					if !ok {
+						return nil, false
+					}
+					values[i] = value
+				}
+				return values, true
+			},
+			StopFunc: func() { stopAll(its) },
+		})
+	}),
+	"enumerate": Builtin(func(args []interface{}) interface{} {
+		it, i := Iterate(args[0].(List)[0]), int64(0)
+		return NewIterValue(&IteratorFunc{
+			NextFunc: func() (interface{}, bool) {
+				value, ok := it.Next()
+				if !ok {
+					return nil, false
+				}
+				i++
+				return Tuple{i - 1, value}, true
+			},
+			StopFunc: it.Stop,
+		})
+	}),
+	"chain": Builtin(func(args []interface{}) interface{} {
+		its := iterateAll(args[0].(List))
+		return NewIterValue(&IteratorFunc{
+			NextFunc: func() (interface{}, bool) {
+				for len(its) > 0 {
+					if value, ok := its[0].Next(); ok {
+						return value, true
+					}
+					its = its[1:]
+				}
+				return nil, false
+			},
+			StopFunc: func() { stopAll(its) },
+		})
+	}),
+	"collect": Builtin(func(args []interface{}) interface{} {
+		it := Iterate(args[0].(List)[0])
+		defer it.Stop()
+
+		values := List{}
+		for {
+			value, ok := it.Next()
+			if !ok {
+				return values
+			}
+			values = append(values, value)
+		}
+	}),
+}}
+
+func iterateAll(values List) []Iterator {
+	its := make([]Iterator, len(values))
+	for i, value := range values {
+		its[i] = Iterate(value)
+	}
+	return its
+}
+
+// stopAll stops iterators last to first. Each one is stopped even if a
+// later one fails.
+func stopAll(its []Iterator) {
+	if len(its) == 0 {
+		return
+	}
+
+	defer stopAll(its[:len(its)-1])
+	its[len(its)-1].Stop()
+}
@@ -0,0 +1,116 @@
+package value
+
+import (
+	"bo/decimal"
+	"fmt"
+	"math/big"
+)
+
+// Builtin is a function implemented in Go. Its arguments have already been
+// converted to the parameter types the checker declared for it.
+type Builtin func(args []interface{}) interface{}
+
+// Module is the value a require statement binds.
+type Module struct {
+	Members map[string]interface{}
+}
+
+// Builtins are the functions in scope in every program.
+var Builtins = map[string]interface{}{
+	"println": Builtin(func(args []interface{}) interface{} {
+		for _, value := range args[0].(List) {
+			fmt.Println(ToString(value))
+		}
+		return nil
+	}),
+	// Future is a keyword, so that Future[T] is a type, and a module
+	"Future": futures,
+}
+
+// StdModules implements the standard library modules by import path.
+var StdModules = map[string]*Module{
+	"bo/math/big": {Members: map[string]interface{}{
+		"pow": Builtin(func(args []interface{}) interface{} {
+			x, n := args[0].(*big.Int), args[1].(int64)
+			if n < 0 {
+				panic(fmt.Sprintf("big.pow -> negative exponent: %d", n))
+			}
+			return new(big.Int).Exp(x, big.NewInt(n), nil)
+		}),
+		"modpow": Builtin(func(args []interface{}) interface{} {
+			x, y, m := args[0].(*big.Int), args[1].(*big.Int), args[2].(*big.Int)
+			if m.Sign() <= 0 || y.Sign() < 0 {
+				panic(fmt.Sprintf("big.modpow -> invalid arguments: %s, %s, %s", x, y, m))
+			}
+			return new(big.Int).Exp(x, y, m)
+		}),
+		"mod": Builtin(func(args []interface{}) interface{} {
+			x, m := args[0].(*big.Int), args[1].(*big.Int)
+			if m.Sign() == 0 {
+				panic("big.mod -> integer division by zero")
+			}
+			return new(big.Int).Mod(x, m)
+		}),
+		"gcd": Builtin(func(args []interface{}) interface{} {
+			return new(big.Int).GCD(nil, nil, args[0].(*big.Int), args[1].(*big.Int))
+		}),
+		"abs": Builtin(func(args []interface{}) interface{} {
+			return new(big.Int).Abs(args[0].(*big.Int))
+		}),
+		"round": Builtin(func(args []interface{}) interface{} {
+			d := args[0].(decimal.Decimal)
+			return d.Round(roundingScale(args[1]), roundingMode(args[2]))
+		}),
+		"div": Builtin(func(args []interface{}) interface{} {
+			d, e := args[0].(decimal.Decimal), args[1].(decimal.Decimal)
+			if e.Sign() == 0 {
+				panic("big.div -> decimal division by zero")
+			}
+			return d.Quo(e, roundingScale(args[2]), roundingMode(args[3]))
+		}),
+
+		"HalfEven": int64(decimal.HalfEven),
+		"HalfUp":   int64(decimal.HalfUp),
+		"HalfDown": int64(decimal.HalfDown),
+		"Up":       int64(decimal.Up),
+		"Down":     int64(decimal.Down),
+		"Ceiling":  int64(decimal.Ceiling),
+		"Floor":    int64(decimal.Floor),
+	}},
+	"bo/sync": {Members: map[string]interface{}{
+		"WaitGroup": Builtin(func(args []interface{}) interface{} {
+			return &waitGroup{}
+		}),
+		"Mutex": Builtin(func(args []interface{}) interface{} {
+			return &mutex{}
+		}),
+	}},
+	"bo/iter": iterators,
+}
+
+// roundingScale returns the number of fractional digits to round to, by
+// default none.
+func roundingScale(value interface{}) int32 {
+	if value == nil {
+		return 0
+	}
+
+	scale := value.(int64)
+	if scale < -1<<31 || scale >= 1<<31 {
+		panic(fmt.Sprintf("roundingScale -> scale %d out of range", scale))
+	}
+	return int32(scale)
+}
+
+// roundingMode returns the rounding mode to use, by default HalfEven.
+func roundingMode(value interface{}) decimal.RoundingMode {
+	if value == nil {
+		return decimal.HalfEven
+	}
+
+	mode := value.(int64)
+	if mode < int64(decimal.HalfEven) || mode > int64(decimal.Floor) {
+		panic(fmt.Sprintf("roundingMode -> invalid rounding mode: %d", mode))
+	}
+	return decimal.RoundingMode(mode)
+}
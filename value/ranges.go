@@ -0,0 +1,170 @@
+package value
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Range is a value of Range: the n ints start, start+step, ... Its
+// values are computed as they are asked for. Ranges with the same values
+// are the same, so that == compares them.
+type Range struct {
+	start, step, n int64
+}
+
+// NewRange returns the range from..to, or from..<to if exclusive.
+func NewRange(from, to int64, exclusive bool) Range {
+	if exclusive {
+		if to == math.MinInt64 {
+			return Range{step: 1}
+		}
+		to--
+	}
+	if to < from {
+		return Range{step: 1}
+	}
+
+	d := uint64(to) - uint64(from)
+	if d >= math.MaxInt64 {
+		panic(fmt.Sprintf("NewRange -> range %d..%d too long", from, to))
+	}
+	return Range{start: from, step: 1, n: int64(d) + 1}.normalize()
+}
+
+// normalize makes ranges with the same values equal.
+func (r Range) normalize() Range {
+	switch r.n {
+	case 0:
+		return Range{step: 1}
+	case 1:
+		r.step = 1
+	}
+	return r
+}
+
+// At returns the ith value of r. The arithmetic may wrap around on the way,
+// but not the value, which is in r.
+func (r Range) At(i int64) int64 {
+	return r.start + i*r.step
+}
+
+// Len returns the number of values of r.
+func (r Range) Len() int64 {
+	return r.n
+}
+
+func (r Range) Reverse() Range {
+	if r.n == 0 {
+		return r
+	}
+	return Range{start: r.At(r.n - 1), step: -r.step, n: r.n}.normalize()
+}
+
+// Every returns every kth value of r, going backwards from its end if k is
+// negative.
+func (r Range) Every(k int64) Range {
+	switch {
+	case k == 0:
+		panic("Every -> zero step")
+	case k < 0:
+		r = r.Reverse()
+		if k == math.MinInt64 {
+			return Range{start: r.start, step: 1, n: min(r.n, 1)}.normalize()
+		}
+		k = -k
+	}
+
+	n := r.n / k
+	if r.n%k != 0 {
+		n++
+	}
+	if n > 1 {
+		r.step *= k
+	}
+	r.n = n
+	return r.normalize()
+}
+
+func (r Range) Contains(x int64) bool {
+	if r.n == 0 {
+		return false
+	}
+
+	// Distances between ints fit in a uint64
+	var d uint64
+	switch {
+	case r.step > 0 && x >= r.start:
+		d = uint64(x) - uint64(r.start)
+	case r.step < 0 && x <= r.start:
+		d = uint64(r.start) - uint64(x)
+	default:
+		return false
+	}
+	step := uint64(r.step)
+	if r.step < 0 {
+		step = -step
+	}
+	return d%step == 0 && d/step < uint64(r.n)
+}
+
+// Slice returns the elements of l at the indices in r.
+func (r Range) Slice(l List) List {
+	s := make(List, 0, r.n)
+	for i := int64(0); i < r.n; i++ {
+		j := r.At(i)
+		if j < 0 || j >= int64(len(l)) {
+			panic(fmt.Sprintf("Slice -> slice bounds out of range [%s] with length %d", r, len(l)))
+		}
+		s = append(s, l[j])
+	}
+	return s
+}
+
+func (r Range) Iterate() Iterator {
+	i := int64(0)
+	return &IteratorFunc{NextFunc: func() (interface{}, bool) {
+		if i == r.n {
+			return nil, false
+		}
+		i++
+		return r.At(i - 1), true
+	}}
+}
+
+func (r Range) Method(name string) Builtin {
+	switch name {
+	case "contains":
+		return func(args []interface{}) interface{} {
+			return r.Contains(args[0].(int64))
+		}
+	case "len":
+		return func(args []interface{}) interface{} {
+			return r.Len()
+		}
+	case "reverse":
+		return func(args []interface{}) interface{} {
+			return r.Reverse()
+		}
+	}
+	return nil
+}
+
+// String returns r as from..to, its least and greatest values, with the
+// step between its values unless it is 1. A negative step goes from to
+// down, as it does in source.
+func (r Range) String() string {
+	if r.n == 0 {
+		return "0..<0"
+	}
+
+	from, to := r.start, r.At(r.n-1)
+	if r.step < 0 {
+		from, to = to, from
+	}
+	s := strconv.FormatInt(from, 10) + ".." + strconv.FormatInt(to, 10)
+	if r.step != 1 {
+		s += " step " + strconv.FormatInt(r.step, 10)
+	}
+	return s
+}
@@ -0,0 +1,163 @@
+package value
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// runtimeError is a runtime error a program failed with.
+type runtimeError struct {
+	msg string
+}
+
+func (e *runtimeError) Error() string {
+	return "Runtime error: " + e.msg
+}
+
+// tasks counts the tasks of a program, and those of them waiting on a
+// channel, a future or a generator. Once all of them are, none can wake
+// another: the program is deadlocked. Go no longer finds that once the
+// program handles interrupts, so the program does.
+//
+// The first runtime error a task fails with fails the program, and
+// cancels the others.
+type tasks struct {
+	mu            sync.Mutex
+	live, blocked int
+	err           error
+	failed        chan struct{}
+	cancel        context.CancelCauseFunc
+
+	// gen changes with live and blocked, so that a deadlock is only
+	// reported if nothing changed while it was confirmed
+	gen int
+
+	// done is set once the main task ends, after which the others are no
+	// longer watched
+	done bool
+}
+
+type tasksKey struct{}
+
+// deadlockDelay is how long all the tasks of a program must stay blocked
+// for it to be deadlocked, rather than between a task waking another and
+// the other resuming.
+const deadlockDelay = 100 * time.Millisecond
+
+// timers counts the timeouts of futures running, which may wake a task
+// when every task is blocked.
+var timers atomic.Int64
+
+// Run runs main, the main task of a program, with a context that the
+// program's tasks share, and returns the runtime error the program failed
+// with, nil if none. The program ends when main does, as a Go program
+// does: the tasks still running are left to the process to end.
+func Run(ctx context.Context, main func(ctx context.Context)) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	t := &tasks{failed: make(chan struct{}), cancel: cancel}
+	ctx = context.WithValue(ctx, tasksKey{}, t)
+
+	// The main task may be blocked where a failure does not stop it, so
+	// the program does not wait for it to fail too
+	ended := make(chan struct{})
+	Go(ctx, func() {
+		main(ctx)
+		close(ended)
+	})
+	select {
+	case <-ended:
+	case <-t.failed:
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.done = true
+	return t.err
+}
+
+// Go runs run as a task of the program ctx is a context of. A runtime
+// error it fails with fails the program, or, if it is not one of a
+// program run by Run, the process.
+func Go(ctx context.Context, run func()) {
+	t := tasksOf(ctx)
+	t.add(1, 0)
+	go func() {
+		defer t.add(-1, 0)
+		if t != nil {
+			defer func() {
+				if r := recover(); r != nil {
+					t.fail(r)
+				}
+			}()
+		}
+		run()
+	}()
+}
+
+// fail fails the program with what a task panicked with, unless it already
+// failed. A panic that is not a runtime error is a bug, which ends the
+// process.
+func (t *tasks) fail(r interface{}) {
+	msg, ok := r.(string)
+	if !ok {
+		panic(r)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.err != nil || t.done {
+		return
+	}
+	t.err = &runtimeError{msg: msg}
+	t.cancel(t.err)
+	close(t.failed)
+}
+
+// wait marks the task blocked until the function it returns is called.
+func wait(ctx context.Context) func() {
+	t := tasksOf(ctx)
+	t.add(0, 1)
+	return func() { t.add(0, -1) }
+}
+
+// tasksOf returns the tasks of the program ctx is a context of, nil if it
+// is not one of a program run by Run.
+func tasksOf(ctx context.Context) *tasks {
+	t, _ := ctx.Value(tasksKey{}).(*tasks)
+	return t
+}
+
+func (t *tasks) add(live, blocked int) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.live += live
+	t.blocked += blocked
+	t.gen++
+	if !t.done && t.blocked == t.live {
+		gen := t.gen
+		time.AfterFunc(deadlockDelay, func() { t.confirm(gen) })
+	}
+}
+
+// confirm fails the program if its tasks are still all blocked as they
+// were at gen, and no timeout may wake one.
+func (t *tasks) confirm(gen int) {
+	t.mu.Lock()
+	stuck := !t.done && t.gen == gen
+	t.mu.Unlock()
+
+	switch {
+	case !stuck:
+	case timers.Load() > 0:
+		time.AfterFunc(deadlockDelay, func() { t.confirm(gen) })
+	default:
+		t.fail("run -> all tasks are asleep - deadlock!")
+	}
+}
@@ -0,0 +1,47 @@
+// Package value implements the values of Bo programs and the operations on
+// them, which the tree-walking runner and the bytecode VM share.
+//
+// A value is a Go value: an int8 to int64, uint8 to uint64, float32,
+// float64, *big.Int, decimal.Decimal, string or bool, nil, or one of the
+// types of this package.
+package value
+
+import (
+	"context"
+	"fmt"
+)
+
+// Function is a function of the program, which the engine running it
+// implements.
+type Function interface {
+	// Bind returns the method with recv as its receiver.
+	Bind(recv interface{}) Function
+
+	// Run calls the function with no arguments in a task of its own.
+	Run() interface{}
+}
+
+// Object is a value with methods implemented in Go.
+type Object interface {
+	// Method returns the method name, or nil if there is none.
+	Method(name string) Builtin
+}
+
+// The runtime errors the engines raise themselves, so that both report
+// them the same way.
+
+// Mismatch fails with val not matching the pattern of a destructuring
+// declaration or a for loop.
+func Mismatch(val interface{}, pattern string) {
+	panic(fmt.Sprintf("match -> %s does not match %s", ToString(val), pattern))
+}
+
+// NoMatch fails with no arm of a match expression matching val.
+func NoMatch(val interface{}) {
+	panic(fmt.Sprintf("match -> no arm matched %s", ToString(val)))
+}
+
+// Cancelled stops a task once ctx is cancelled.
+func Cancelled(ctx context.Context) {
+	panic(fmt.Sprintf("run -> %s", ctx.Err()))
+}
@@ -0,0 +1,86 @@
+package vm
+
+import (
+	"bo/checker"
+	"bo/compiler"
+	"bo/decimal"
+	"math/big"
+
+	"bo/value"
+)
+
+// types are the numeric types by type code.
+var types [256]checker.Type
+
+func init() {
+	for kind := checker.IntKind; kind <= checker.DecimalKind; kind++ {
+		types[kind] = compiler.TypeOf(byte(kind))
+		types[kind|compiler.OptionalBit] = compiler.TypeOf(byte(kind | compiler.OptionalBit))
+	}
+}
+
+// convert converts a value to the numeric type with a type code, or to an
+// optional one. A value of that type already is left as it is.
+func convert(v interface{}, code byte) interface{} {
+	kind := checker.BasicKind(code &^ compiler.OptionalBit)
+	switch v.(type) {
+	case nil:
+		if code&compiler.OptionalBit != 0 {
+			return nil
+		}
+	case int64:
+		if kind == checker.IntKind || kind == checker.Int64Kind {
+			return v
+		}
+	case float64:
+		if kind == checker.FloatKind {
+			return v
+		}
+	case *big.Int:
+		if kind == checker.BigIntKind {
+			return v
+		}
+	case decimal.Decimal:
+		if kind == checker.DecimalKind {
+			return v
+		}
+	}
+	return value.Convert(v, types[code])
+}
+
+// binary applies a binary operator, working on ints without going through
+// the generic arithmetic unless the result overflows.
+func binary(op string, left, right interface{}) interface{} {
+	x, ok := left.(int64)
+	if !ok {
+		return value.Binary(op, left, right)
+	}
+	y, ok := right.(int64)
+	if !ok {
+		return value.Binary(op, left, right)
+	}
+
+	switch op {
+	case "+":
+		if z := x + y; (z > x) == (y > 0) {
+			return z
+		}
+	case "-":
+		if z := x - y; (z < x) == (y > 0) {
+			return z
+		}
+	case "<":
+		return x < y
+	case "<=":
+		return x <= y
+	case ">":
+		return x > y
+	case ">=":
+		return x >= y
+	case "==":
+		return x == y
+	case "!=":
+		return x != y
+	}
+	return value.Binary(op, left, right)
+}
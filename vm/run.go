@@ -0,0 +1,413 @@
+package vm
+
+import (
+	"bo/compiler"
+	"bo/value"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func u16(code []byte, i int) int {
+	return int(code[i])<<8 | int(code[i+1])
+}
+
+func u32(code []byte, i int) int {
+	return int(code[i])<<24 | int(code[i+1])<<16 | int(code[i+2])<<8 | int(code[i+3])
+}
+
+// run runs the code of cl in a frame whose locals start at base, where its
+// receiver and arguments already are, and returns its result.
+func (t *thread) run(cl *Closure, base int) interface{} {
+	fn := cl.fn
+	for len(t.stack) < base+fn.Params {
+		t.stack = append(t.stack, missing{})
+	}
+	for len(t.stack) < base+fn.Locals {
+		t.stack = append(t.stack, nil)
+	}
+
+	var f frame
+	defer f.exit()
+
+	consts := t.vm.prog.Constants
+	code := fn.Code
+	ip := 0
+	for {
+		op := compiler.Opcode(code[ip])
+		ip++
+
+		switch op {
+		case compiler.OpConst:
+			t.push(consts[u16(code, ip)])
+			ip += 2
+		case compiler.OpNil:
+			t.push(nil)
+		case compiler.OpTrue:
+			t.push(true)
+		case compiler.OpFalse:
+			t.push(false)
+		case compiler.OpMissing:
+			t.push(missing{})
+		case compiler.OpPop:
+			t.stack = t.stack[:len(t.stack)-1]
+		case compiler.OpDup:
+			t.push(t.stack[len(t.stack)-1])
+		case compiler.OpSwap:
+			n := len(t.stack)
+			t.stack[n-1], t.stack[n-2] = t.stack[n-2], t.stack[n-1]
+		case compiler.OpLoad:
+			t.push(t.stack[base+u16(code, ip)])
+			ip += 2
+		case compiler.OpStore:
+			t.stack[base+u16(code, ip)] = t.pop()
+			ip += 2
+		case compiler.OpLoadFree:
+			t.push(cl.free[u16(code, ip)])
+			ip += 2
+		case compiler.OpSelf:
+			t.push(cl)
+		case compiler.OpBuiltin:
+			t.push(value.Builtins[consts[u16(code, ip)].(string)])
+			ip += 2
+		case compiler.OpDefault:
+			if _, ok := t.stack[base+u16(code, ip)].(missing); ok {
+				ip += 6
+			} else {
+				ip = u32(code, ip+2)
+			}
+
+		case compiler.OpConvert:
+			top := &t.stack[len(t.stack)-1]
+			*top = convert(*top, code[ip])
+			ip++
+		case compiler.OpBinary:
+			right := t.pop()
+			top := &t.stack[len(t.stack)-1]
+			*top = binary(compiler.BinaryOps[code[ip]], *top, right)
+			ip++
+		case compiler.OpNeg:
+			top := &t.stack[len(t.stack)-1]
+			*top = value.Neg(*top)
+		case compiler.OpNot:
+			top := &t.stack[len(t.stack)-1]
+			*top = !(*top).(bool)
+
+		case compiler.OpJump:
+			ip = u32(code, ip)
+		case compiler.OpJumpIfFalse:
+			if t.pop().(bool) {
+				ip += 4
+			} else {
+				ip = u32(code, ip)
+			}
+		case compiler.OpJumpFalseOrPop:
+			if t.stack[len(t.stack)-1].(bool) {
+				t.stack = t.stack[:len(t.stack)-1]
+				ip += 4
+			} else {
+				ip = u32(code, ip)
+			}
+		case compiler.OpJumpTrueOrPop:
+			if t.stack[len(t.stack)-1].(bool) {
+				ip = u32(code, ip)
+			} else {
+				t.stack = t.stack[:len(t.stack)-1]
+				ip += 4
+			}
+		case compiler.OpJumpNotNilOrPop:
+			if t.stack[len(t.stack)-1] != nil {
+				ip = u32(code, ip)
+			} else {
+				t.stack = t.stack[:len(t.stack)-1]
+				ip += 4
+			}
+		case compiler.OpJumpIfNil:
+			if t.stack[len(t.stack)-1] == nil {
+				ip = u32(code, ip)
+			} else {
+				ip += 4
+			}
+
+		case compiler.OpList:
+			t.push(value.List(t.popN(u16(code, ip))))
+			ip += 2
+		case compiler.OpMap:
+			entries := t.popN(2 * u16(code, ip))
+			m := value.NewMap()
+			for i := 0; i < len(entries); i += 2 {
+				m.Set(entries[i], entries[i+1])
+			}
+			t.push(m)
+			ip += 2
+		case compiler.OpTuple:
+			t.push(value.Tuple(t.popN(u16(code, ip))))
+			ip += 2
+		case compiler.OpUnpack:
+			tuple := t.pop().(value.Tuple)
+			for i := u16(code, ip) - 1; i >= 0; i-- {
+				t.push(tuple[i])
+			}
+			ip += 2
+		case compiler.OpNewStruct:
+			top := &t.stack[len(t.stack)-1]
+			def, ok := (*top).(*value.StructDef)
+			if !ok {
+				panic(fmt.Sprintf("run -> %s is not a struct", value.ToString(*top)))
+			}
+			*top = value.NewStructValue(def)
+		case compiler.OpSetField:
+			val := t.pop()
+			s := t.stack[len(t.stack)-1].(*value.StructValue)
+			s.Fields[s.Def.Field(consts[u16(code, ip)].(string))] = val
+			ip += 2
+		case compiler.OpMember:
+			top := &t.stack[len(t.stack)-1]
+			*top = value.Member(*top, consts[u16(code, ip)].(string))
+			ip += 2
+		case compiler.OpIndex:
+			index := t.pop()
+			top := &t.stack[len(t.stack)-1]
+			*top = value.Index(*top, index)
+		case compiler.OpRange:
+			to := t.pop().(int64)
+			top := &t.stack[len(t.stack)-1]
+			*top = value.NewRange((*top).(int64), to, code[ip] == 1)
+			ip++
+		case compiler.OpStep:
+			step := t.pop().(int64)
+			top := &t.stack[len(t.stack)-1]
+			*top = (*top).(value.Range).Every(step)
+		case compiler.OpToString:
+			top := &t.stack[len(t.stack)-1]
+			*top = value.ToString(*top)
+		case compiler.OpConcat:
+			var sb strings.Builder
+			for _, s := range t.popN(u16(code, ip)) {
+				sb.WriteString(s.(string))
+			}
+			t.push(sb.String())
+			ip += 2
+
+		case compiler.OpDefStruct:
+			t.push(value.NewStructDef(consts[u16(code, ip)].(string), nil))
+			ip += 2
+		case compiler.OpDefField:
+			def := t.stack[len(t.stack)-1].(*value.StructDef)
+			def.Fields = append(def.Fields, consts[u16(code, ip)].(string))
+			ip += 2
+		case compiler.OpDefEnum:
+			t.push(value.NewEnum(consts[u16(code, ip)].(string)))
+			ip += 2
+		case compiler.OpDefCase:
+			e := t.stack[len(t.stack)-1].(*value.Enum)
+			e.AddCase(consts[u16(code, ip)].(string), int(code[ip+2]))
+			ip += 3
+		case compiler.OpClosure:
+			t.push(t.closure(cl, base, t.vm.prog.Functions[u16(code, ip)]))
+			ip += 2
+		case compiler.OpMethod:
+			method := t.pop().(*Closure)
+			t.pop().(*value.StructDef).AddMethod(consts[u16(code, ip)].(string), method)
+			ip += 2
+		case compiler.OpOperator:
+			method := t.pop().(*Closure)
+			t.pop().(*value.StructDef).AddOperator(method.fn, consts[u16(code, ip)].(string), method)
+			ip += 2
+		case compiler.OpRequire:
+			path := consts[u16(code, ip)].(string)
+			if code[ip+2] == 1 {
+				fmt.Printf("Importing module: <%s>\n", path)
+			} else {
+				fmt.Printf("Importing module: %s\n", strconv.Quote(path))
+			}
+			ip += 3
+		case compiler.OpModule:
+			t.push(value.StdModules[consts[u16(code, ip)].(string)])
+			ip += 2
+
+		case compiler.OpCall:
+			n := int(code[ip])
+			ip++
+			at := len(t.stack) - n - 1
+			t.checkDone()
+
+			// A function is run with its arguments where they are, its
+			// receiver in place of the callee
+			if callee, ok := t.stack[at].(*Closure); ok && !callee.fn.Generator && !callee.fn.Async {
+				first := at + 1
+				if callee.fn.Method {
+					t.stack[at] = callee.recv
+					first = at
+				}
+				result := t.run(callee, first)
+				t.stack = append(t.stack[:at], result)
+				break
+			}
+
+			args := t.popN(n)
+			callee := t.pop()
+			t.push(t.call(callee, args))
+		case compiler.OpOperatorCall:
+			n := int(code[ip+2])
+			at := len(t.stack) - n - 1
+			s := t.stack[at].(*value.StructValue)
+			method := s.Def.Operator(t.vm.prog.Functions[u16(code, ip)]).Bind(s).(*Closure)
+			ip += 3
+			t.checkDone()
+
+			if !method.fn.Generator && !method.fn.Async {
+				result := t.run(method, at)
+				t.stack = append(t.stack[:at], result)
+				break
+			}
+
+			args := t.popN(n)
+			t.stack = t.stack[:at]
+			t.push(t.call(method, args))
+		case compiler.OpReturn:
+			return t.pop()
+		case compiler.OpDefer:
+			args := t.popN(int(code[ip]))
+			callee := t.pop()
+			f.deferred = append(f.deferred, func() {
+				t.call(callee, args)
+			})
+			ip++
+		case compiler.OpSpawn:
+			// The callee and its arguments are evaluated by the spawning
+			// task, the call by the new one
+			args := t.popN(int(code[ip]))
+			callee := t.pop()
+			task := t.vm.thread(nil)
+			value.Go(t.vm.ctx, func() {
+				task.call(callee, args)
+			})
+			ip++
+
+		case compiler.OpIter:
+			f.iters = append(f.iters, value.Iterate(t.pop()))
+		case compiler.OpNext:
+			t.checkDone()
+			if val, ok := f.iters[len(f.iters)-1].Next(); ok {
+				t.push(val)
+				ip += 4
+			} else {
+				ip = u32(code, ip)
+			}
+		case compiler.OpEndIter:
+			it := f.iters[len(f.iters)-1]
+			f.iters = f.iters[:len(f.iters)-1]
+			it.Stop()
+		case compiler.OpYield:
+			t.generator.Yield(t.vm.ctx, t.pop())
+		case compiler.OpAwait:
+			top := &t.stack[len(t.stack)-1]
+			*top = (*top).(*value.Future).Await(t.vm.ctx)
+		case compiler.OpSend:
+			val := t.pop()
+			t.pop().(*value.Channel).Send(t.vm.ctx, val)
+		case compiler.OpReceive:
+			top := &t.stack[len(t.stack)-1]
+			*top = (*top).(*value.Channel).Receive(t.vm.ctx)
+		case compiler.OpNewChan:
+			top := &t.stack[len(t.stack)-1]
+			*top = value.NewChannel((*top).(int64))
+		case compiler.OpSelectRecv:
+			top := &t.stack[len(t.stack)-1]
+			*top = value.SelectCase{Chan: (*top).(*value.Channel)}
+		case compiler.OpSelectSend:
+			val := t.pop()
+			top := &t.stack[len(t.stack)-1]
+			*top = value.SelectCase{Chan: (*top).(*value.Channel), Send: true, Value: val}
+		case compiler.OpSelectDefault:
+			t.push(value.SelectCase{})
+		case compiler.OpSelect:
+			values := t.popN(int(code[ip]))
+			cases := make([]value.SelectCase, len(values))
+			for i, c := range values {
+				cases[i] = c.(value.SelectCase)
+			}
+			chosen, received := value.Select(t.vm.ctx, cases)
+			t.push(received)
+			t.push(chosen)
+			ip++
+		case compiler.OpArm:
+			if t.stack[len(t.stack)-1].(int) == int(code[ip]) {
+				t.stack = t.stack[:len(t.stack)-1]
+				ip += 5
+			} else {
+				ip = u32(code, ip+1)
+			}
+
+		case compiler.OpIsCase:
+			top := &t.stack[len(t.stack)-1]
+			e, ok := (*top).(value.EnumValue)
+			*top = ok && e.Name == consts[u16(code, ip)].(string)
+			ip += 2
+		case compiler.OpIsList:
+			n, more := u16(code, ip), code[ip+2] == 1
+			top := &t.stack[len(t.stack)-1]
+			l, ok := (*top).(value.List)
+			*top = ok && (len(l) == n || more && len(l) >= n)
+			ip += 3
+		case compiler.OpIsMap:
+			top := &t.stack[len(t.stack)-1]
+			_, ok := (*top).(*value.Map)
+			*top = ok
+		case compiler.OpIsTuple:
+			top := &t.stack[len(t.stack)-1]
+			_, ok := (*top).(value.Tuple)
+			*top = ok
+		case compiler.OpIsStruct:
+			top := &t.stack[len(t.stack)-1]
+			_, ok := (*top).(*value.StructValue)
+			*top = ok
+		case compiler.OpElem:
+			i := u16(code, ip)
+			top := &t.stack[len(t.stack)-1]
+			switch v := (*top).(type) {
+			case value.List:
+				*top = v[i]
+			case value.Tuple:
+				*top = v[i]
+			case value.EnumValue:
+				*top = v.Fields[i]
+			}
+			ip += 2
+		case compiler.OpField:
+			top := &t.stack[len(t.stack)-1]
+			s := (*top).(*value.StructValue)
+			*top = s.Fields[s.Def.Field(consts[u16(code, ip)].(string))]
+			ip += 2
+		case compiler.OpRest:
+			top := &t.stack[len(t.stack)-1]
+			*top = append(value.List(nil), (*top).(value.List)[u16(code, ip):]...)
+			ip += 2
+		case compiler.OpMismatch:
+			value.Mismatch(t.pop(), consts[u16(code, ip)].(string))
+		case compiler.OpNoMatch:
+			value.NoMatch(t.pop())
+
+		default:
+			panic(fmt.Sprintf("run -> unknown opcode %s at %d in %s", op, ip-1, fn.Name))
+		}
+	}
+}
+
+// closure returns a closure of fn, capturing the variables it uses from
+// the frame of cl at base.
+func (t *thread) closure(cl *Closure, base int, fn *compiler.Function) *Closure {
+	free := make([]interface{}, len(fn.Captures))
+	for i, c := range fn.Captures {
+		switch c.Kind {
+		case compiler.CaptureLocal:
+			free[i] = t.stack[base+c.Index]
+		case compiler.CaptureFree:
+			free[i] = cl.free[c.Index]
+		case compiler.CaptureSelf:
+			free[i] = cl
+		}
+	}
+	return &Closure{fn: fn, free: free, vm: t.vm}
+}
@@ -0,0 +1,177 @@
+// Package vm runs the bytecode of the compiler package. Each task of a
+// program has a thread: a stack of values on which each call has a frame,
+// its locals first and then the operands of the instructions it runs.
+package vm
+
+import (
+	"bo/compiler"
+	"bo/value"
+	"context"
+	"fmt"
+)
+
+// VM is what the threads of a program share.
+type VM struct {
+	prog *compiler.Program
+
+	// Cancelling ctx stops the program, and every task it runs, at the
+	// next call, iteration or await
+	ctx  context.Context
+	done <-chan struct{}
+}
+
+// Run runs a compiled program until it ends or ctx is cancelled, and
+// returns the runtime error it failed with, nil if none. The tasks it
+// spawns are left running once it ends.
+func Run(ctx context.Context, prog *compiler.Program) error {
+	return value.Run(ctx, func(ctx context.Context) {
+		vm := &VM{prog: prog, ctx: ctx, done: ctx.Done()}
+		main := &Closure{fn: prog.Functions[0], vm: vm}
+		vm.thread(nil).invoke(main, nil)
+	})
+}
+
+// thread returns a thread for a new task, running the generator g if it
+// is not nil.
+func (vm *VM) thread(g *value.Generator) *thread {
+	return &thread{vm: vm, stack: make([]interface{}, 0, 64), generator: g}
+}
+
+// Closure is the value a function declaration binds: its code and the
+// variables it captured, and, for a method, its receiver.
+type Closure struct {
+	fn   *compiler.Function
+	free []interface{}
+	recv interface{}
+	vm   *VM
+}
+
+// Bind returns the method cl with recv as its receiver.
+func (cl *Closure) Bind(recv interface{}) value.Function {
+	bound := *cl
+	bound.recv = recv
+	return &bound
+}
+
+// Run calls cl with no arguments in a task of its own.
+func (cl *Closure) Run() interface{} {
+	return cl.vm.thread(nil).call(cl, nil)
+}
+
+// missing is the argument of a parameter left to its default value.
+type missing struct{}
+
+type thread struct {
+	vm    *VM
+	stack []interface{}
+
+	// The generator the thread runs, which its yields hand values to
+	generator *value.Generator
+}
+
+// frame is what a call leaves to do when it returns: the iterations it is
+// in, innermost last, and its deferred calls.
+type frame struct {
+	iters    []value.Iterator
+	deferred []func()
+}
+
+// exit stops the iterations of a frame, innermost first, then runs its
+// deferred calls last to first, even when a runtime error unwinds the
+// call.
+func (f *frame) exit() {
+	defer runDeferred(f.deferred)
+	stopAll(f.iters)
+}
+
+// runDeferred runs calls last to first. Each one runs even if a later one
+// fails.
+func runDeferred(calls []func()) {
+	if len(calls) == 0 {
+		return
+	}
+
+	defer runDeferred(calls[:len(calls)-1])
+	calls[len(calls)-1]()
+}
+
+// stopAll stops iterators last to first. Each one is stopped even if a
+// later one fails.
+func stopAll(its []value.Iterator) {
+	if len(its) == 0 {
+		return
+	}
+
+	defer stopAll(its[:len(its)-1])
+	its[len(its)-1].Stop()
+}
+
+// call applies callee to args. Generators and async functions run in a
+// task of their own, and builtins take nil for parameters left to their
+// default values.
+func (t *thread) call(callee interface{}, args []interface{}) interface{} {
+	switch fn := callee.(type) {
+	case value.Builtin:
+		for i, arg := range args {
+			if _, ok := arg.(missing); ok {
+				args[i] = nil
+			}
+		}
+		return fn(args)
+	case *Closure:
+		if fn.fn.Generator {
+			return value.Generate(t.vm.ctx, func(g *value.Generator) {
+				t.vm.thread(g).invoke(fn, args)
+			})
+		}
+		if fn.fn.Async {
+			task := t.vm.thread(nil)
+			return value.Start(t.vm.ctx, func() interface{} {
+				return task.invoke(fn, args)
+			})
+		}
+		return t.invoke(fn, args)
+	}
+
+	panic(fmt.Sprintf("call -> cannot call %T", callee))
+}
+
+// invoke runs cl with args on top of the stack of t.
+func (t *thread) invoke(cl *Closure, args []interface{}) interface{} {
+	base := len(t.stack)
+	if cl.fn.Method {
+		t.stack = append(t.stack, cl.recv)
+	}
+	t.stack = append(t.stack, args...)
+
+	result := t.run(cl, base)
+	t.stack = t.stack[:base]
+	return result
+}
+
+// checkDone stops the thread once the program is cancelled.
+func (t *thread) checkDone() {
+	select {
+	case <-t.vm.done:
+		value.Cancelled(t.vm.ctx)
+	default:
+	}
+}
+
+func (t *thread) push(v interface{}) {
+	t.stack = append(t.stack, v)
+}
+
+func (t *thread) pop() interface{} {
+	v := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	return v
+}
+
+// popN pops n values into a new slice, the deepest first.
+func (t *thread) popN(n int) []interface{} {
+	values := make([]interface{}, n)
+	copy(values, t.stack[len(t.stack)-n:])
+	t.stack = t.stack[:len(t.stack)-n]
+	return values
+}
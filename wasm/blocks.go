@@ -0,0 +1,167 @@
+package wasm
+
+import (
+	"bo/ir"
+	"slices"
+)
+
+// lower lowers the blocks of a function to the body of out.
+//
+// WebAssembly has no goto: a branch leaves a block, going past its end, or
+// goes back to the start of a loop. The blocks of a function are placed as
+// Ramsey's "Beyond Relooper" places them, by a walk of the dominator tree,
+// which Bo's structured control flow keeps reducible. A block is placed
+// right where the only block that jumps to it does. A loop header is
+// wrapped in a loop that the blocks jumping back to it branch to the start
+// of. A block more than one block jumps to is placed after a block wrapped
+// around its immediate dominator, which the blocks jumping to it branch out
+// of.
+func (l *lowerer) lower(fn *ir.Func, out *Function) {
+	f := &funcState{
+		fn:     out,
+		locals: make(map[*ir.Value]int),
+		uses:   make(map[*ir.Value]int),
+		order:  make(map[*ir.Block]int),
+		loops:  make(map[*ir.Block]bool),
+		merges: make(map[*ir.Block]bool),
+	}
+	enclosing := l.fn
+	l.fn = f
+	defer func() { l.fn = enclosing }()
+
+	for i, param := range fn.Params {
+		out.Params = append(out.Params, valType(param.Type))
+		f.locals[param] = i
+	}
+	if fn.Result != nil {
+		out.Results = []ValType{valType(fn.Result)}
+	}
+
+	for _, b := range fn.Blocks {
+		for _, v := range b.Values {
+			for _, arg := range v.Args {
+				f.uses[arg]++
+			}
+		}
+		if b.Control != nil {
+			f.uses[b.Control]++
+		}
+	}
+	for _, b := range fn.Blocks {
+		for _, v := range b.Values {
+			if v.Type != nil && f.uses[v] > 0 && v.Op != ir.OpConst && v.Op != ir.OpParam {
+				f.locals[v] = l.local(valType(v.Type))
+			}
+		}
+	}
+
+	fn.ComputeDom()
+	postorder := fn.Postorder()
+	for i, b := range postorder {
+		f.order[b] = len(postorder) - 1 - i
+	}
+	for _, b := range postorder {
+		forward := 0
+		for _, p := range b.Preds {
+			if f.order[p] < f.order[b] {
+				forward++
+			} else {
+				f.loops[b] = true
+			}
+		}
+		f.merges[b] = forward > 1
+	}
+
+	l.tree(fn.Blocks[0])
+
+	// The validator does not know that control never reaches the end of
+	// a function with a result
+	if n := len(out.Code); len(out.Results) > 0 && (n == 0 || out.Code[n-1].Op != OpReturn && out.Code[n-1].Op != OpUnreachable) {
+		l.emit(OpUnreachable)
+	}
+	l.emit(OpEnd)
+}
+
+// tree emits a block and those it dominates, in a loop if it is a loop
+// header.
+func (l *lowerer) tree(b *ir.Block) {
+	// The merge blocks it immediately dominates, the last in reverse
+	// postorder first, as the block around it is the outermost
+	var merges []*ir.Block
+	for _, d := range b.Dominated {
+		if l.fn.merges[d] {
+			merges = append(merges, d)
+		}
+	}
+	slices.SortFunc(merges, func(x, y *ir.Block) int {
+		return l.fn.order[y] - l.fn.order[x]
+	})
+
+	if l.fn.loops[b] {
+		l.open(OpLoop, b)
+		l.within(b, merges)
+		l.end()
+		return
+	}
+	l.within(b, merges)
+}
+
+// within emits a block in a block for each of merges, which follow it, so
+// that it and the blocks it dominates can branch out of them to the merge
+// blocks.
+func (l *lowerer) within(b *ir.Block, merges []*ir.Block) {
+	if len(merges) > 0 {
+		l.open(OpBlock, merges[0])
+		l.within(b, merges[1:])
+		l.end()
+		l.tree(merges[0])
+		return
+	}
+
+	for _, v := range b.Values {
+		l.value(v)
+	}
+	switch b.Kind {
+	case ir.Plain:
+		l.jump(b, 0)
+	case ir.If:
+		l.get(b.Control)
+		l.open(OpIf, nil)
+		l.jump(b, 0)
+		l.emit(OpElse)
+		l.jump(b, 1)
+		l.end()
+	case ir.Return:
+		if b.Control != nil {
+			l.get(b.Control)
+		}
+		l.emit(OpReturn)
+	case ir.Unreachable:
+		l.emit(OpUnreachable)
+	}
+}
+
+// jump goes from a block to its successor i: it assigns the phis of the
+// successor their operands on that edge, then branches to the successor,
+// or emits it right there if the block is the only one that jumps to it.
+func (l *lowerer) jump(b *ir.Block, i int) {
+	to, e := b.Succs[i], b.PredIndex(i)
+
+	// The phis are assigned at once: all their operands are read first
+	var phis []*ir.Value
+	for _, phi := range to.Values {
+		if phi.Op == ir.OpPhi && l.fn.uses[phi] > 0 && phi.Args[e] != phi {
+			phis = append(phis, phi)
+			l.get(phi.Args[e])
+		}
+	}
+	for i := len(phis) - 1; i >= 0; i-- {
+		l.emit(OpLocalSet, int64(l.fn.locals[phis[i]]))
+	}
+
+	if l.fn.order[to] <= l.fn.order[b] || l.fn.merges[to] {
+		l.br(to)
+		return
+	}
+	l.tree(to)
+}
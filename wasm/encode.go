@@ -0,0 +1,180 @@
+package wasm
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// The sections of a module, in the order they appear in its binary form.
+const (
+	sectionType     byte = 1
+	sectionImport   byte = 2
+	sectionFunction byte = 3
+	sectionMemory   byte = 5
+	sectionGlobal   byte = 6
+	sectionExport   byte = 7
+	sectionCode     byte = 10
+	sectionData     byte = 11
+)
+
+// pageSize is the size of a page of memory.
+const pageSize = 1 << 16
+
+// Encode returns the binary form of a module. Each function has a type of
+// its own, and the memory holds the string constants from offset 0.
+func Encode(m *Module) []byte {
+	out := []byte("\x00asm\x01\x00\x00\x00")
+	section := func(id byte, body []byte) {
+		out = append(out, id)
+		out = binary.AppendUvarint(out, uint64(len(body)))
+		out = append(out, body...)
+	}
+
+	var types []byte
+	types = binary.AppendUvarint(types, uint64(len(m.Imports)+len(m.Functions)))
+	funcType := func(params, results []ValType) {
+		types = append(types, 0x60)
+		types = appendTypes(types, params)
+		types = appendTypes(types, results)
+	}
+	for _, imp := range m.Imports {
+		funcType(imp.Params, imp.Results)
+	}
+	for _, fn := range m.Functions {
+		funcType(fn.Params, fn.Results)
+	}
+	section(sectionType, types)
+
+	var imports []byte
+	imports = binary.AppendUvarint(imports, uint64(len(m.Imports)))
+	for i, imp := range m.Imports {
+		imports = appendName(imports, "bo")
+		imports = appendName(imports, imp.Name)
+		imports = append(imports, 0x00)
+		imports = binary.AppendUvarint(imports, uint64(i))
+	}
+	section(sectionImport, imports)
+
+	var funcs []byte
+	funcs = binary.AppendUvarint(funcs, uint64(len(m.Functions)))
+	for i := range m.Functions {
+		funcs = binary.AppendUvarint(funcs, uint64(len(m.Imports)+i))
+	}
+	section(sectionFunction, funcs)
+
+	section(sectionMemory, binary.AppendUvarint([]byte{1, 0x00}, uint64(pages(m))))
+
+	if len(m.Globals) > 0 {
+		var globals []byte
+		globals = binary.AppendUvarint(globals, uint64(len(m.Globals)))
+		for _, g := range m.Globals {
+			globals = append(globals, byte(g.Type), 0x01)
+			globals = appendInstr(globals, zero(g.Type))
+			globals = append(globals, byte(OpEnd))
+		}
+		section(sectionGlobal, globals)
+	}
+
+	var exports []byte
+	n := 1
+	for _, fn := range m.Functions {
+		if fn.Exported {
+			n++
+		}
+	}
+	exports = binary.AppendUvarint(exports, uint64(n))
+	exports = appendName(exports, "memory")
+	exports = append(exports, 0x02, 0x00)
+	for i, fn := range m.Functions {
+		if fn.Exported {
+			exports = appendName(exports, fn.Name)
+			exports = append(exports, 0x00)
+			exports = binary.AppendUvarint(exports, uint64(len(m.Imports)+i))
+		}
+	}
+	section(sectionExport, exports)
+
+	var code []byte
+	code = binary.AppendUvarint(code, uint64(len(m.Functions)))
+	for _, fn := range m.Functions {
+		var body []byte
+		body = binary.AppendUvarint(body, uint64(len(fn.Locals)))
+		for _, t := range fn.Locals {
+			body = append(body, 1, byte(t))
+		}
+		for _, instr := range fn.Code {
+			body = appendInstr(body, instr)
+		}
+		code = binary.AppendUvarint(code, uint64(len(body)))
+		code = append(code, body...)
+	}
+	section(sectionCode, code)
+
+	if len(m.Data) > 0 {
+		data := []byte{1, 0x00, byte(OpI32Const), 0, byte(OpEnd)}
+		data = binary.AppendUvarint(data, uint64(len(m.Data)))
+		data = append(data, m.Data...)
+		section(sectionData, data)
+	}
+
+	return out
+}
+
+// pages returns the pages of memory the string constants of a module
+// take, at least one.
+func pages(m *Module) int {
+	return max(1, (len(m.Data)+pageSize-1)/pageSize)
+}
+
+// zero returns the constant instruction of the zero value of type t.
+func zero(t ValType) Instr {
+	switch t {
+	case I32:
+		return Instr{Op: OpI32Const}
+	case I64:
+		return Instr{Op: OpI64Const}
+	}
+	return Instr{Op: OpF64Const}
+}
+
+func appendTypes(b []byte, types []ValType) []byte {
+	b = binary.AppendUvarint(b, uint64(len(types)))
+	for _, t := range types {
+		b = append(b, byte(t))
+	}
+	return b
+}
+
+func appendName(b []byte, name string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(name)))
+	return append(b, name...)
+}
+
+// appendInstr appends an instruction and its immediate, whose integers
+// are LEB128, signed for constants.
+func appendInstr(b []byte, instr Instr) []byte {
+	b = append(b, byte(instr.Op))
+	switch instr.Op {
+	case OpBlock, OpLoop, OpIf:
+		b = append(b, byte(instr.Type))
+	case OpBr, OpBrIf, OpCall, OpLocalGet, OpLocalSet, OpLocalTee, OpGlobalGet, OpGlobalSet:
+		b = binary.AppendUvarint(b, uint64(instr.Arg))
+	case OpI32Const, OpI64Const:
+		b = appendSleb(b, instr.Arg)
+	case OpF64Const:
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(instr.Float))
+	}
+	return b
+}
+
+// appendSleb appends n as a signed LEB128 integer.
+func appendSleb(b []byte, n int64) []byte {
+	for {
+		c := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 && c&0x40 == 0 || n == -1 && c&0x40 != 0 {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
@@ -0,0 +1,70 @@
+// A host for the modules bo wasm writes, which runs one with Node:
+//
+//	node wasm/host.mjs app.wasm
+//
+// In a browser, instantiate the module with imports(print) and call its
+// main export the same way.
+
+// imports returns the functions a module imports from "bo". The values it
+// writes make up lines, which println passes to print and panic throws.
+export function imports(print) {
+  let memory;
+  let line = "";
+  const bo = {
+    write_string(offset, length) {
+      line += new TextDecoder().decode(new Uint8Array(memory.buffer, offset, length));
+    },
+    write_int(n) {
+      line += String(n);
+    },
+    write_float(f) {
+      line += formatFloat(f);
+    },
+    write_bool(b) {
+      line += b ? "true" : "false";
+    },
+    println() {
+      print(line);
+      line = "";
+    },
+    panic() {
+      throw new Error(line);
+    },
+  };
+  return {
+    bo,
+    setMemory(m) {
+      memory = m;
+    },
+  };
+}
+
+// formatFloat formats a float as Bo does: in the fewest digits that read
+// back as it, with an exponent of at least two digits below 1e-4 or from
+// 1e6.
+export function formatFloat(f) {
+  if (Number.isNaN(f)) return "NaN";
+  if (f === Infinity) return "+Inf";
+  if (f === -Infinity) return "-Inf";
+  if (f === 0) return Object.is(f, -0) ? "-0" : "0";
+
+  const [mantissa, e] = f.toExponential().split("e");
+  const exp = Number(e);
+  if (exp < -4 || exp >= 6) {
+    return mantissa + "e" + (exp < 0 ? "-" : "+") + String(Math.abs(exp)).padStart(2, "0");
+  }
+  return String(f);
+}
+
+if (typeof process !== "undefined" && process.argv[1] && import.meta.url.endsWith(process.argv[1].split("/").pop())) {
+  const { readFile } = await import("node:fs/promises");
+  const host = imports(console.log);
+  const { instance } = await WebAssembly.instantiate(await readFile(process.argv[2]), { bo: host.bo });
+  host.setMemory(instance.exports.memory);
+  try {
+    instance.exports.main();
+  } catch (err) {
+    console.error("Runtime error: " + err.message);
+    process.exit(1);
+  }
+}
@@ -0,0 +1,214 @@
+package wasm
+
+import (
+	"bo/checker"
+	"bo/ir"
+	"fmt"
+	"math"
+)
+
+const minInt64 = math.MinInt64
+
+type lowerer struct {
+	mod *Module
+
+	// The index of each function of the program, and of each of its
+	// globals
+	funcs   map[*ir.Func]int
+	globals map[*ir.Global]int
+
+	// The offset in memory of each string constant
+	strings map[string]int
+
+	// The index of each runtime function the program uses, by name
+	runtime map[string]int
+
+	// The function being lowered
+	fn *funcState
+}
+
+// funcState is a function being lowered.
+type funcState struct {
+	fn *Function
+
+	// The local each value that needs one is computed into, its
+	// parameters first, and how many values and block ends use each value
+	locals map[*ir.Value]int
+	uses   map[*ir.Value]int
+
+	// The index of each block in reverse postorder, the loop headers, which
+	// a block after them jumps back to, and the blocks more than one
+	// block before them jumps to
+	order  map[*ir.Block]int
+	loops  map[*ir.Block]bool
+	merges map[*ir.Block]bool
+
+	// The blocks, loops and ifs open around the code being emitted, each
+	// with the block a branch to its label goes to, nil for an if
+	labels []*ir.Block
+}
+
+// Lower lowers a program in SSA form, as the ir package builds it, to a
+// WebAssembly module. The module exports its memory, and a function "main"
+// that runs the top level.
+func Lower(p *ir.Program) *Module {
+	l := &lowerer{
+		mod:     &Module{Imports: imports},
+		funcs:   make(map[*ir.Func]int),
+		globals: make(map[*ir.Global]int),
+		strings: make(map[string]int),
+		runtime: make(map[string]int),
+	}
+
+	fns := make([]*Function, len(p.Funcs))
+	for i, fn := range p.Funcs {
+		fns[i] = l.function(fn.Name)
+		l.funcs[fn] = l.index(fns[i])
+	}
+	fns[0].Exported = true
+	for _, g := range p.Globals {
+		l.globals[g] = len(l.mod.Globals)
+		l.mod.Globals = append(l.mod.Globals, &Global{Name: g.Name, Type: valType(g.Type)})
+	}
+
+	for i, fn := range p.Funcs {
+		l.lower(fn, fns[i])
+	}
+	return l.mod
+}
+
+// function adds a function to the module, named name unless another one,
+// or an import, is.
+func (l *lowerer) function(name string) *Function {
+	fn := &Function{Name: unique(name, func(name string) bool {
+		for _, imp := range l.mod.Imports {
+			if imp.Name == name {
+				return true
+			}
+		}
+		for _, fn := range l.mod.Functions {
+			if fn.Name == name {
+				return true
+			}
+		}
+		return false
+	})}
+	l.mod.Functions = append(l.mod.Functions, fn)
+	return fn
+}
+
+// unique returns name, or name with a number added if it is taken.
+func unique(name string, taken func(string) bool) string {
+	u := name
+	for i := 2; taken(u); i++ {
+		u = fmt.Sprintf("%s_%d", name, i)
+	}
+	return u
+}
+
+// index returns the index of a function of the module, which comes after
+// the functions it imports.
+func (l *lowerer) index(fn *Function) int {
+	for i, f := range l.mod.Functions {
+		if f == fn {
+			return len(l.mod.Imports) + i
+		}
+	}
+	panic(fmt.Sprintf("index -> unknown function %s", fn.Name))
+}
+
+func (l *lowerer) emit(op Opcode, args ...int64) {
+	instr := Instr{Op: op}
+	if len(args) > 0 {
+		instr.Arg = args[0]
+	}
+	l.fn.fn.Code = append(l.fn.fn.Code, instr)
+}
+
+// open opens a block, loop or if that leaves no value, whose label a
+// branch to target, nil for none, goes to.
+func (l *lowerer) open(op Opcode, target *ir.Block) {
+	l.fn.fn.Code = append(l.fn.fn.Code, Instr{Op: op, Type: Void})
+	l.fn.labels = append(l.fn.labels, target)
+}
+
+// end closes the innermost block, loop or if.
+func (l *lowerer) end() {
+	l.emit(OpEnd)
+	l.fn.labels = l.fn.labels[:len(l.fn.labels)-1]
+}
+
+// br branches to the innermost label that goes to a block.
+func (l *lowerer) br(to *ir.Block) {
+	for i := len(l.fn.labels) - 1; i >= 0; i-- {
+		if l.fn.labels[i] == to {
+			l.emit(OpBr, int64(len(l.fn.labels)-1-i))
+			return
+		}
+	}
+	panic(fmt.Sprintf("br -> no label goes to %s", to))
+}
+
+func (l *lowerer) constI32(n int) {
+	l.emit(OpI32Const, int64(n))
+}
+
+func (l *lowerer) constI64(n int64) {
+	l.emit(OpI64Const, n)
+}
+
+func (l *lowerer) constF64(f float64) {
+	l.fn.fn.Code = append(l.fn.fn.Code, Instr{Op: OpF64Const, Float: f})
+}
+
+// local adds a local of type t to the function being lowered and returns
+// its index.
+func (l *lowerer) local(t ValType) int {
+	fn := l.fn.fn
+	fn.Locals = append(fn.Locals, t)
+	return len(fn.Params) + len(fn.Locals) - 1
+}
+
+// valType returns the WebAssembly type of the values of type t.
+func valType(t checker.Type) ValType {
+	switch t {
+	case checker.Int:
+		return I64
+	case checker.Float:
+		return F64
+	case checker.Bool:
+		return I32
+	}
+	panic(fmt.Sprintf("valType -> unhandled type %s", t))
+}
+
+// str returns the offset in memory of a string constant, and its length.
+func (l *lowerer) str(s string) (int, int) {
+	offset, ok := l.strings[s]
+	if !ok {
+		offset = len(l.mod.Data)
+		l.strings[s] = offset
+		l.mod.Data = append(l.mod.Data, s...)
+	}
+	return offset, len(s)
+}
+
+// writeString emits a call that writes a string constant.
+func (l *lowerer) writeString(s string) {
+	offset, n := l.str(s)
+	l.constI32(offset)
+	l.constI32(n)
+	l.emit(OpCall, importWriteString)
+}
+
+// write emits a call that writes the value on top of the stack, of type t.
+func (l *lowerer) write(t checker.Type) {
+	switch t {
+	case checker.Int:
+		l.emit(OpCall, importWriteInt)
+	case checker.Float:
+		l.emit(OpCall, importWriteFloat)
+	case checker.Bool:
+		l.emit(OpCall, importWriteBool)
+	}
+}
@@ -0,0 +1,222 @@
+// Package wasm lowers a program in SSA form, as the ir package builds it
+// from the scalar subset of the language, to a WebAssembly module:
+// functions of int, float and bool values, switches and matches on them,
+// loops over ranges, and println, which writes through functions the host
+// imports.
+//
+// Each value a function uses is computed into a local of its own, but
+// constants, which are pushed where they are used, and a phi is assigned
+// on each edge into its block.
+package wasm
+
+// ValType is the type of a WebAssembly value.
+type ValType byte
+
+const (
+	I32 ValType = 0x7f
+	I64 ValType = 0x7e
+	F64 ValType = 0x7c
+
+	// The type of a block that leaves no value
+	Void ValType = 0x40
+)
+
+func (t ValType) String() string {
+	switch t {
+	case I32:
+		return "i32"
+	case I64:
+		return "i64"
+	case F64:
+		return "f64"
+	}
+	return ""
+}
+
+// Module is a WebAssembly module: the functions the host provides, the
+// functions of the program, its globals, and its string constants, which
+// are laid out in its memory from offset 0.
+type Module struct {
+	Imports   []*Import
+	Functions []*Function
+	Globals   []*Global
+	Data      []byte
+}
+
+// Import is a function of the host, in the module "bo".
+type Import struct {
+	Name    string
+	Params  []ValType
+	Results []ValType
+}
+
+// Function is a function of the module. Exported functions are visible to
+// the host by name. Its locals are its parameters, then the variables it
+// declares.
+type Function struct {
+	Name     string
+	Exported bool
+	Params   []ValType
+	Results  []ValType
+	Locals   []ValType
+	Code     []Instr
+}
+
+// Global is a mutable global, a variable of the top level of the program.
+type Global struct {
+	Name string
+	Type ValType
+}
+
+// Instr is an instruction and its immediate: the index of a function,
+// local or global, the depth of the label a branch targets, the value of a
+// constant, or the type of the value a block leaves.
+type Instr struct {
+	Op    Opcode
+	Arg   int64
+	Float float64
+	Type  ValType
+}
+
+// Opcode is the opcode of an instruction.
+type Opcode byte
+
+const (
+	OpUnreachable Opcode = 0x00
+	OpBlock       Opcode = 0x02
+	OpLoop        Opcode = 0x03
+	OpIf          Opcode = 0x04
+	OpElse        Opcode = 0x05
+	OpEnd         Opcode = 0x0b
+	OpBr          Opcode = 0x0c
+	OpBrIf        Opcode = 0x0d
+	OpReturn      Opcode = 0x0f
+	OpCall        Opcode = 0x10
+	OpDrop        Opcode = 0x1a
+
+	OpLocalGet  Opcode = 0x20
+	OpLocalSet  Opcode = 0x21
+	OpLocalTee  Opcode = 0x22
+	OpGlobalGet Opcode = 0x23
+	OpGlobalSet Opcode = 0x24
+
+	OpI32Const Opcode = 0x41
+	OpI64Const Opcode = 0x42
+	OpF64Const Opcode = 0x44
+
+	OpI32Eqz Opcode = 0x45
+	OpI32Eq  Opcode = 0x46
+	OpI32Ne  Opcode = 0x47
+	OpI64Eqz Opcode = 0x50
+	OpI64Eq  Opcode = 0x51
+	OpI64Ne  Opcode = 0x52
+	OpI64LtS Opcode = 0x53
+	OpI64GtS Opcode = 0x55
+	OpI64LeS Opcode = 0x57
+	OpI64GeS Opcode = 0x59
+	OpF64Eq  Opcode = 0x61
+	OpF64Ne  Opcode = 0x62
+	OpF64Lt  Opcode = 0x63
+	OpF64Gt  Opcode = 0x64
+	OpF64Le  Opcode = 0x65
+	OpF64Ge  Opcode = 0x66
+
+	OpI32And  Opcode = 0x71
+	OpI32Or   Opcode = 0x72
+	OpI64Add  Opcode = 0x7c
+	OpI64Sub  Opcode = 0x7d
+	OpI64Mul  Opcode = 0x7e
+	OpI64DivS Opcode = 0x7f
+	OpI64RemS Opcode = 0x81
+	OpF64Neg  Opcode = 0x9a
+	OpF64Add  Opcode = 0xa0
+	OpF64Sub  Opcode = 0xa1
+	OpF64Mul  Opcode = 0xa2
+	OpF64Div  Opcode = 0xa3
+
+	OpI64TruncF64S  Opcode = 0xb0
+	OpF64ConvertI64 Opcode = 0xb9
+)
+
+var opNames = map[Opcode]string{
+	OpUnreachable: "unreachable",
+	OpBlock:       "block",
+	OpLoop:        "loop",
+	OpIf:          "if",
+	OpElse:        "else",
+	OpEnd:         "end",
+	OpBr:          "br",
+	OpBrIf:        "br_if",
+	OpReturn:      "return",
+	OpCall:        "call",
+	OpDrop:        "drop",
+
+	OpLocalGet:  "local.get",
+	OpLocalSet:  "local.set",
+	OpLocalTee:  "local.tee",
+	OpGlobalGet: "global.get",
+	OpGlobalSet: "global.set",
+
+	OpI32Const: "i32.const",
+	OpI64Const: "i64.const",
+	OpF64Const: "f64.const",
+
+	OpI32Eqz: "i32.eqz",
+	OpI32Eq:  "i32.eq",
+	OpI32Ne:  "i32.ne",
+	OpI64Eqz: "i64.eqz",
+	OpI64Eq:  "i64.eq",
+	OpI64Ne:  "i64.ne",
+	OpI64LtS: "i64.lt_s",
+	OpI64GtS: "i64.gt_s",
+	OpI64LeS: "i64.le_s",
+	OpI64GeS: "i64.ge_s",
+	OpF64Eq:  "f64.eq",
+	OpF64Ne:  "f64.ne",
+	OpF64Lt:  "f64.lt",
+	OpF64Gt:  "f64.gt",
+	OpF64Le:  "f64.le",
+	OpF64Ge:  "f64.ge",
+
+	OpI32And:  "i32.and",
+	OpI32Or:   "i32.or",
+	OpI64Add:  "i64.add",
+	OpI64Sub:  "i64.sub",
+	OpI64Mul:  "i64.mul",
+	OpI64DivS: "i64.div_s",
+	OpI64RemS: "i64.rem_s",
+	OpF64Neg:  "f64.neg",
+	OpF64Add:  "f64.add",
+	OpF64Sub:  "f64.sub",
+	OpF64Mul:  "f64.mul",
+	OpF64Div:  "f64.div",
+
+	OpI64TruncF64S:  "i64.trunc_f64_s",
+	OpF64ConvertI64: "f64.convert_i64_s",
+}
+
+func (op Opcode) String() string {
+	return opNames[op]
+}
+
+// The functions the host provides. The write functions add a value to the
+// line being written, which println prints and panic raises as a runtime
+// error. Strings are given by their offset in memory and their length.
+var imports = []*Import{
+	{Name: "write_string", Params: []ValType{I32, I32}},
+	{Name: "write_int", Params: []ValType{I64}},
+	{Name: "write_float", Params: []ValType{F64}},
+	{Name: "write_bool", Params: []ValType{I32}},
+	{Name: "println"},
+	{Name: "panic"},
+}
+
+// The indices of the imported functions.
+const (
+	importWriteString = iota
+	importWriteInt
+	importWriteFloat
+	importWriteBool
+	importPrintln
+	importPanic
+)
@@ -0,0 +1,154 @@
+package wasm
+
+import "bo/checker"
+
+// runtimeFunc returns the index of a function of the runtime, adding it to
+// the module the first time it is used. Int arithmetic is checked, as in
+// Bo, and a result that overflows is a runtime error with the message the
+// interpreters give.
+func (l *lowerer) runtimeFunc(name string) int {
+	if index, ok := l.runtime[name]; ok {
+		return index
+	}
+
+	fn := l.function("bo_" + name)
+	index := l.index(fn)
+	l.runtime[name] = index
+
+	enclosing := l.fn
+	l.fn = &funcState{fn: fn}
+	defer func() { l.fn = enclosing }()
+
+	fn.Params, fn.Results = []ValType{I64, I64}, []ValType{I64}
+	const a, b = 0, 1
+	get := func(local int) { l.emit(OpLocalGet, int64(local)) }
+
+	// overflow panics if the condition on top of the stack holds
+	overflow := func(op string) {
+		l.open(OpIf, nil)
+		l.writeString("Binary -> integer overflow: ")
+		get(a)
+		l.write(checker.Int)
+		l.writeString(" " + op + " ")
+		get(b)
+		l.write(checker.Int)
+		l.emit(OpCall, importPanic)
+		l.emit(OpUnreachable)
+		l.end()
+	}
+	divisionByZero := func() {
+		get(b)
+		l.emit(OpI64Eqz)
+		l.open(OpIf, nil)
+		l.writeString("Binary -> integer division by zero")
+		l.emit(OpCall, importPanic)
+		l.emit(OpUnreachable)
+		l.end()
+	}
+	// isMinusOne leaves whether b is -1 and a the smallest int
+	isMinusOne := func() {
+		get(a)
+		l.constI64(minInt64)
+		l.emit(OpI64Eq)
+		get(b)
+		l.constI64(-1)
+		l.emit(OpI64Eq)
+		l.emit(OpI32And)
+	}
+
+	switch name {
+	case "add", "sub":
+		// The sum overflowed if it moved the other way from b's sign:
+		// (c > a) != (b > 0) for a + b, (c < a) != (b > 0) for a - b
+		c := l.local(I64)
+		get(a)
+		get(b)
+		op, cmp, sym := OpI64Add, OpI64GtS, "+"
+		if name == "sub" {
+			op, cmp, sym = OpI64Sub, OpI64LtS, "-"
+		}
+		l.emit(op)
+		l.emit(OpLocalTee, int64(c))
+		get(a)
+		l.emit(cmp)
+		get(b)
+		l.constI64(0)
+		l.emit(OpI64GtS)
+		l.emit(OpI32Ne)
+		overflow(sym)
+		get(c)
+	case "mul":
+		// The product overflowed if dividing it by b does not give a back
+		c := l.local(I64)
+		get(b)
+		l.emit(OpI64Eqz)
+		l.open(OpIf, nil)
+		l.constI64(0)
+		l.emit(OpReturn)
+		l.end()
+		isMinusOne()
+		overflow("*")
+		get(a)
+		get(b)
+		l.emit(OpI64Mul)
+		l.emit(OpLocalTee, int64(c))
+		get(b)
+		l.emit(OpI64DivS)
+		get(a)
+		l.emit(OpI64Ne)
+		overflow("*")
+		get(c)
+	case "div":
+		divisionByZero()
+		isMinusOne()
+		overflow("/")
+		get(a)
+		get(b)
+		l.emit(OpI64DivS)
+	case "rem":
+		divisionByZero()
+		get(a)
+		get(b)
+		l.emit(OpI64RemS)
+	case "neg":
+		fn.Params = []ValType{I64}
+		get(a)
+		l.constI64(minInt64)
+		l.emit(OpI64Eq)
+		l.open(OpIf, nil)
+		l.writeString("Neg -> integer overflow: -(")
+		get(a)
+		l.write(checker.Int)
+		l.writeString(")")
+		l.emit(OpCall, importPanic)
+		l.emit(OpUnreachable)
+		l.end()
+		l.constI64(0)
+		get(a)
+		l.emit(OpI64Sub)
+	case "to_int":
+		// Only floats in [-2^63, 2^63) truncate to an int, which NaN is not
+		fn.Params = []ValType{F64}
+		get(a)
+		l.constF64(-(1 << 63))
+		l.emit(OpF64Ge)
+		get(a)
+		l.constF64(1 << 63)
+		l.emit(OpF64Lt)
+		l.emit(OpI32And)
+		l.emit(OpI32Eqz)
+		l.open(OpIf, nil)
+		l.writeString("convert -> value ")
+		get(a)
+		l.write(checker.Float)
+		l.writeString(" out of range for int")
+		l.emit(OpCall, importPanic)
+		l.emit(OpUnreachable)
+		l.end()
+		get(a)
+		l.emit(OpI64TruncF64S)
+	}
+	l.emit(OpEnd)
+
+	return index
+}
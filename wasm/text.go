@@ -0,0 +1,117 @@
+package wasm
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// WriteText writes the text form (WAT) of a module, which names functions
+// and globals by the names they have in the program. Branches count out
+// to the block they leave, as in the binary form.
+func WriteText(w io.Writer, m *Module) {
+	fmt.Fprintln(w, "(module")
+	for _, imp := range m.Imports {
+		fmt.Fprintf(w, "  (import \"bo\" %q (func $%s%s))\n", imp.Name, imp.Name, signature(imp.Params, imp.Results, nil))
+	}
+	fmt.Fprintf(w, "  (memory (export \"memory\") %d)\n", pages(m))
+	for _, g := range m.Globals {
+		fmt.Fprintf(w, "  (global $%s (mut %s) (%s))\n", g.Name, g.Type, text(m, zero(g.Type)))
+	}
+	for _, fn := range m.Functions {
+		fmt.Fprintf(w, "  (func $%s", fn.Name)
+		if fn.Exported {
+			fmt.Fprintf(w, " (export %q)", fn.Name)
+		}
+		fmt.Fprintln(w, signature(fn.Params, fn.Results, fn.Locals))
+
+		// The end of the body closes the function
+		depth := 2
+		for _, instr := range fn.Code[:len(fn.Code)-1] {
+			if instr.Op == OpEnd || instr.Op == OpElse {
+				depth--
+			}
+			fmt.Fprintf(w, "%s%s\n", strings.Repeat("  ", depth), text(m, instr))
+			switch instr.Op {
+			case OpBlock, OpLoop, OpIf, OpElse:
+				depth++
+			}
+		}
+		fmt.Fprintln(w, "  )")
+	}
+	if len(m.Data) > 0 {
+		fmt.Fprintf(w, "  (data (i32.const 0) %s)\n", quote(m.Data))
+	}
+	fmt.Fprintln(w, ")")
+}
+
+func signature(params, results, locals []ValType) string {
+	var b strings.Builder
+	list := func(kind string, types []ValType) {
+		if len(types) == 0 {
+			return
+		}
+		b.WriteString(" (" + kind)
+		for _, t := range types {
+			b.WriteString(" " + t.String())
+		}
+		b.WriteString(")")
+	}
+	list("param", params)
+	list("result", results)
+	list("local", locals)
+	return b.String()
+}
+
+// text returns the text form of an instruction.
+func text(m *Module, instr Instr) string {
+	name := instr.Op.String()
+	switch instr.Op {
+	case OpBlock, OpLoop, OpIf:
+		if instr.Type != Void {
+			return name + " (result " + instr.Type.String() + ")"
+		}
+	case OpCall:
+		if i := int(instr.Arg); i < len(m.Imports) {
+			return name + " $" + m.Imports[i].Name
+		}
+		return name + " $" + m.Functions[int(instr.Arg)-len(m.Imports)].Name
+	case OpGlobalGet, OpGlobalSet:
+		return name + " $" + m.Globals[instr.Arg].Name
+	case OpBr, OpBrIf, OpLocalGet, OpLocalSet, OpLocalTee, OpI32Const, OpI64Const:
+		return name + " " + strconv.FormatInt(instr.Arg, 10)
+	case OpF64Const:
+		return name + " " + floatText(instr.Float)
+	}
+	return name
+}
+
+// floatText returns the text form of a float constant.
+func floatText(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "nan"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// quote returns the text form of the bytes of a data segment.
+func quote(data []byte) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, c := range data {
+		if c >= 0x20 && c < 0x7f && c != '"' && c != '\\' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "\\%02x", c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
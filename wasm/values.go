@@ -0,0 +1,140 @@
+package wasm
+
+import (
+	"bo/checker"
+	"bo/ir"
+	"fmt"
+)
+
+// The instructions of the operations on ints, floats and bools. Checked
+// int arithmetic calls the functions of the runtime instead.
+var (
+	intOps = map[ir.Op]Opcode{
+		ir.OpAddWrap: OpI64Add, ir.OpSubWrap: OpI64Sub, ir.OpMulWrap: OpI64Mul,
+		ir.OpEq: OpI64Eq, ir.OpNe: OpI64Ne, ir.OpLt: OpI64LtS, ir.OpLe: OpI64LeS, ir.OpGt: OpI64GtS, ir.OpGe: OpI64GeS,
+	}
+	floatOps = map[ir.Op]Opcode{
+		ir.OpAdd: OpF64Add, ir.OpSub: OpF64Sub, ir.OpMul: OpF64Mul, ir.OpDiv: OpF64Div, ir.OpNeg: OpF64Neg,
+		ir.OpEq: OpF64Eq, ir.OpNe: OpF64Ne, ir.OpLt: OpF64Lt, ir.OpLe: OpF64Le, ir.OpGt: OpF64Gt, ir.OpGe: OpF64Ge,
+	}
+	boolOps    = map[ir.Op]Opcode{ir.OpEq: OpI32Eq, ir.OpNe: OpI32Ne, ir.OpNot: OpI32Eqz}
+	checkedOps = map[ir.Op]string{
+		ir.OpAdd: "add", ir.OpSub: "sub", ir.OpMul: "mul", ir.OpDiv: "div", ir.OpRem: "rem", ir.OpNeg: "neg",
+	}
+)
+
+// value emits the code that computes a value into its local, if it needs
+// any: not if it is a constant, a parameter or a phi, or its result, which
+// nothing uses, is all it computes.
+func (l *lowerer) value(v *ir.Value) {
+	switch {
+	case v.Op == ir.OpConst || v.Op == ir.OpParam || v.Op == ir.OpPhi:
+		return
+	case v.Type != nil && l.fn.uses[v] == 0 && !effect(v):
+		return
+	}
+
+	args := func() {
+		for _, arg := range v.Args {
+			l.get(arg)
+		}
+	}
+	switch v.Op {
+	case ir.OpCall:
+		args()
+		l.emit(OpCall, int64(l.funcs[v.Aux.(*ir.Func)]))
+	case ir.OpLoad:
+		l.emit(OpGlobalGet, int64(l.globals[v.Aux.(*ir.Global)]))
+	case ir.OpStore:
+		args()
+		l.emit(OpGlobalSet, int64(l.globals[v.Aux.(*ir.Global)]))
+	case ir.OpIntToFloat:
+		args()
+		l.emit(OpF64ConvertI64)
+	case ir.OpWrite:
+		args()
+		l.write(v.Args[0].Type)
+	case ir.OpWriteString:
+		l.writeString(v.Aux.(string))
+	case ir.OpPrintln:
+		l.emit(OpCall, importPrintln)
+	case ir.OpNoMatch:
+		l.writeString("match -> no arm matched ")
+		args()
+		l.write(v.Args[0].Type)
+		l.emit(OpCall, importPanic)
+	default:
+		args()
+		l.operation(v)
+	}
+
+	switch {
+	case v.Type == nil:
+	case l.fn.uses[v] > 0:
+		l.emit(OpLocalSet, int64(l.fn.locals[v]))
+	default:
+		l.emit(OpDrop)
+	}
+}
+
+// operation emits the instruction of an operation on ints, floats or
+// bools, whose operands are on the stack.
+func (l *lowerer) operation(v *ir.Value) {
+	var op Opcode
+	var ok bool
+	switch v.Args[0].Type {
+	case checker.Int:
+		if name, checked := checkedOps[v.Op]; checked {
+			l.emit(OpCall, int64(l.runtimeFunc(name)))
+			return
+		}
+		op, ok = intOps[v.Op]
+	case checker.Float:
+		if v.Op == ir.OpFloatToInt {
+			l.emit(OpCall, int64(l.runtimeFunc("to_int")))
+			return
+		}
+		op, ok = floatOps[v.Op]
+	case checker.Bool:
+		op, ok = boolOps[v.Op]
+	}
+	if !ok {
+		panic(fmt.Sprintf("operation -> unhandled operation %s of %s values", v.Op, v.Args[0].Type))
+	}
+	l.emit(op)
+}
+
+// effect reports whether computing a value may do more than give its
+// result: call a function or panic.
+func effect(v *ir.Value) bool {
+	switch v.Op {
+	case ir.OpCall, ir.OpFloatToInt:
+		return true
+	case ir.OpAdd, ir.OpSub, ir.OpMul, ir.OpDiv, ir.OpRem, ir.OpNeg:
+		return v.Type == checker.Int
+	}
+	return false
+}
+
+// get pushes a value used by another: a constant, or the local that holds
+// it.
+func (l *lowerer) get(v *ir.Value) {
+	if v.Op != ir.OpConst {
+		l.emit(OpLocalGet, int64(l.fn.locals[v]))
+		return
+	}
+	switch c := v.Aux.(type) {
+	case bool:
+		n := 0
+		if c {
+			n = 1
+		}
+		l.constI32(n)
+	case int64:
+		l.constI64(c)
+	case float64:
+		l.constF64(c)
+	default:
+		panic(fmt.Sprintf("get -> unhandled constant %v", c))
+	}
+}
@@ -0,0 +1,87 @@
+package wasm_test
+
+import (
+	"bo/internal/botest"
+	"bo/ir"
+	"bo/value"
+	"bo/wasm"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// TestLower lowers the SSA form of each program of the scalar subset,
+// which it verifies first, and runs the module with wazero, providing the imports as wasm/host.mjs does, and checks that
+// it prints what the tree engine prints. The programs the subset leaves out
+// are skipped.
+func TestLower(t *testing.T) {
+	lowered := 0
+	for _, p := range botest.Programs(t, "testdata", filepath.Join("..", "cgen", "testdata")) {
+		prog, err := ir.Build(p.Prog, p.Info)
+		if err != nil {
+			if !botest.Unsupported(err) {
+				t.Errorf("%s: %v", p.Name, err)
+			}
+			continue
+		}
+		if err := ir.Verify(prog); err != nil {
+			t.Errorf("%s: %v", p.Name, err)
+			continue
+		}
+		lowered++
+		t.Run(p.Name, func(t *testing.T) {
+			botest.Compare(t, run(t, wasm.Encode(wasm.Lower(prog))), botest.Run(t, p.File, "--engine=tree"))
+		})
+	}
+	if lowered == 0 {
+		t.Error("no program lowered")
+	}
+}
+
+// run runs a module and returns what it printed, and the message of the
+// runtime error it raised, if any.
+func run(t *testing.T, module []byte) botest.Result {
+	ctx := context.Background()
+	r := wazero.NewRuntime(ctx)
+	defer r.Close(ctx)
+
+	var out, line strings.Builder
+	var msg string
+	write := func(s string) { line.WriteString(s) }
+	_, err := r.NewHostModuleBuilder("bo").
+		NewFunctionBuilder().WithFunc(func(_ context.Context, m api.Module, offset, length uint32) {
+		b, ok := m.Memory().Read(offset, length)
+		if !ok {
+			t.Fatalf("write_string: %d bytes at %d are out of memory", length, offset)
+		}
+		write(string(b))
+	}).Export("write_string").
+		NewFunctionBuilder().WithFunc(func(n int64) { write(value.ToString(n)) }).Export("write_int").
+		NewFunctionBuilder().WithFunc(func(f float64) { write(value.ToString(f)) }).Export("write_float").
+		NewFunctionBuilder().WithFunc(func(b int32) { write(value.ToString(b != 0)) }).Export("write_bool").
+		NewFunctionBuilder().WithFunc(func() {
+		out.WriteString(line.String() + "\n")
+		line.Reset()
+	}).Export("println").
+		NewFunctionBuilder().WithFunc(func() {
+		msg = line.String()
+		panic(msg)
+	}).Export("panic").
+		Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mod, err := r.Instantiate(ctx, module)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mod.ExportedFunction("main").Call(ctx); err != nil && msg == "" {
+		t.Fatal(err)
+	}
+	return botest.Result{Output: out.String(), Panic: msg}
+}